@@ -4,9 +4,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"strconv"
 	"time"
 
+	"github.com/devldavydov/myfood/internal/common/flags"
 	bot "github.com/devldavydov/myfood/internal/myfoodbot"
 )
 
@@ -17,30 +17,17 @@ const (
 	_defaultLogLevel    = "INFO"
 	_defaultTZ          = "Europe/Moscow"
 	_defaultDebugMode   = false
+	_defaultWebURL      = ""
 )
 
-type IDList []int64
-
-func (r *IDList) String() string {
-	return ""
-}
-
-func (r *IDList) Set(v string) error {
-	iv, err := strconv.ParseInt(v, 10, 64)
-	if err != nil {
-		return err
-	}
-	*r = append(*r, iv)
-	return nil
-}
-
 type Config struct {
 	Token          string
 	PollTimeOut    time.Duration
 	DBFilePath     string
 	LogLevel       string
 	TZ             string
-	AllowedUserIDs IDList
+	AllowedUserIDs flags.IDList
+	WebURL         string
 	DebugMode      bool
 }
 
@@ -53,6 +40,7 @@ func LoadConfig(flagSet flag.FlagSet, flags []string) (*Config, error) {
 	flagSet.StringVar(&config.TZ, "z", _defaultTZ, "Timezone")
 	flagSet.DurationVar(&config.PollTimeOut, "p", _defaultPollTimeout, "Telegram API poll timeout")
 	flagSet.Var(&config.AllowedUserIDs, "u", "Allowed User ID")
+	flagSet.StringVar(&config.WebURL, "w", _defaultWebURL, "Web UI URL for login links")
 	flagSet.BoolVar(&config.DebugMode, "b", _defaultDebugMode, "Debug mode")
 
 	flagSet.Usage = func() {
@@ -83,6 +71,7 @@ func ServiceSettingsAdapt(config *Config, buildCommit string) (*bot.ServiceSetti
 		config.DBFilePath,
 		config.AllowedUserIDs,
 		config.TZ,
+		config.WebURL,
 		buildCommit,
 		config.DebugMode)
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/devldavydov/myfood/internal/common/flags"
 	srv "github.com/devldavydov/myfood/internal/myfoodserver"
 )
 
@@ -14,6 +15,8 @@ const (
 	_defaultShutdownTimeout = 15 * time.Second
 	_defaultLogLevel        = "INFO"
 	_defaultDBFilePath      = ""
+	_defaultToken           = ""
+	_defaultSecureCookie    = true
 )
 
 type Config struct {
@@ -21,6 +24,9 @@ type Config struct {
 	ShutdownTimeout time.Duration
 	DBFilePath      string
 	LogLevel        string
+	Token           string
+	AllowedUserIDs  flags.IDList
+	SecureCookie    bool
 }
 
 func LoadConfig(flagSet flag.FlagSet, flags []string) (*Config, error) {
@@ -30,6 +36,9 @@ func LoadConfig(flagSet flag.FlagSet, flags []string) (*Config, error) {
 	flagSet.StringVar(&config.DBFilePath, "d", _defaultDBFilePath, "DB file path")
 	flagSet.StringVar(&config.LogLevel, "l", _defaultLogLevel, "Log level")
 	flagSet.DurationVar(&config.ShutdownTimeout, "t", _defaultShutdownTimeout, "Server shutdown timeout")
+	flagSet.StringVar(&config.Token, "k", _defaultToken, "Telegram bot API token (required)")
+	flagSet.Var(&config.AllowedUserIDs, "u", "Allowed User ID")
+	flagSet.BoolVar(&config.SecureCookie, "s", _defaultSecureCookie, "Send session cookie over HTTPS only")
 
 	flagSet.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
@@ -45,6 +54,10 @@ func LoadConfig(flagSet flag.FlagSet, flags []string) (*Config, error) {
 		return nil, fmt.Errorf("invalid DB file path")
 	}
 
+	if config.Token == _defaultToken {
+		return nil, fmt.Errorf("invalid token")
+	}
+
 	return config, nil
 }
 
@@ -52,5 +65,8 @@ func ServiceSettingsAdapt(config *Config) (*srv.ServerSettings, error) {
 	return srv.NewServerSettings(
 		config.RunAddress,
 		config.DBFilePath,
-		config.ShutdownTimeout)
+		config.ShutdownTimeout,
+		config.Token,
+		config.AllowedUserIDs,
+		config.SecureCookie)
 }
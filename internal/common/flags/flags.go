@@ -0,0 +1,19 @@
+package flags
+
+import "strconv"
+
+// IDList is repeatable command line flag of user IDs.
+type IDList []int64
+
+func (r *IDList) String() string {
+	return ""
+}
+
+func (r *IDList) Set(v string) error {
+	iv, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return err
+	}
+	*r = append(*r, iv)
+	return nil
+}
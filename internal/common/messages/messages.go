@@ -6,20 +6,60 @@ const (
 	MsgErrInvalidCommand = "Неправильная команда"
 	MsgErrEmptyList      = "Пустой результат"
 	MsgErrBadRequest     = "Неправильный запрос"
+	MsgErrUnauthorized   = "Требуется авторизация"
 
-	MsgErrFoodNotFound = "Еда не найдена в базе данных"
-	MsgErrFoodIsUsed   = "Еда уже используется в журнале приема пищи или бандле"
+	MsgErrArgMissing  = "Не указан аргумент %d «%s», ожидается: %s"
+	MsgErrArgBadValue = "Неправильный аргумент %d «%s»: %q, ожидается: %s"
+	MsgErrArgTooMany  = "Лишние аргументы начиная с %d: %q"
+	MsgCmdUsage       = "Формат команды: %s"
+
+	MsgErrExprToken   = "Ошибка в выражении %s: неожиданный символ %q в позиции %d"
+	MsgErrExprEnd     = "Ошибка в выражении %s: неожиданный конец выражения"
+	MsgErrExprDivZero = "Ошибка в выражении %s: деление на ноль в позиции %d"
+
+	MsgSuggest           = "Возможно, имелось в виду:"
+	MsgErrSuggestExpired = "Исходная команда не найдена, повторите ее"
+
+	MsgErrFoodNotFound        = "Еда не найдена в базе данных"
+	MsgErrFoodIsUsed          = "Еда уже используется в журнале приема пищи, бандле или рецепте"
+	MsgErrFoodExists          = "Еда с таким ключом уже существует"
+	MsgErrFoodMerge           = "Нельзя объединить еду"
+	MsgErrFoodIsRecipe        = "Еда рассчитывается по рецепту, измените рецепт"
+	MsgErrFoodPortionNotFound = "Порция еды не найдена"
 
 	MsgErrBundleDepBundleNotFound  = "Зависимый бандл не найден в базе данных"
 	MsgErrBundleDepFoodNotFound    = "Зависимая еда не найдена в базе данных"
 	MsgErrBundleDepBundleRecursive = "Зависимый бандл не может быть рекурсивным"
 	MsgErrBundleNotFound           = "Бандл не найден в базе данных"
 	MsgErrBundleIsUsed             = "Бандл уже используется в другом бандле"
+	MsgErrBundleExists             = "Бандл с таким ключом уже существует"
+
+	MsgErrRecipeNotFound            = "Рецепт не найден в базе данных"
+	MsgErrRecipeIngredientNotFound  = "Ингредиент рецепта не найден в базе данных"
+	MsgErrRecipeIngredientRecursive = "Рецепт не может быть ингредиентом самого себя"
 
 	MsgErrUserSettingsNotFound = "Не найдены пользовательские настройки"
 
+	MsgErrUserMealNotFound = "Прием пищи не найден"
+	MsgErrUserMealConflict = "Наименование или псевдоним уже используется другим приемом пищи"
+	MsgErrUserMealIsUsed   = "Прием пищи уже используется в журнале"
+	MsgErrUserMealIsLast   = "Нельзя удалить последний прием пищи"
+
 	MsgErrJournalCopy = "Не пустое назначение копирования"
 	MsgJournalCopied  = "Скопировано записей: %d"
+	MsgJournalRecalc  = "Пересчитано записей: %d"
+
+	MsgErrRestoreNoFile = "Не приложен файл бэкапа"
+	MsgErrRestoreFormat = "Неправильный формат файла бэкапа"
+	MsgErrRestoreMeal   = "Журнал бэкапа содержит неизвестный прием пищи"
+
+	MsgErrWebLoginNoURL = "Не задан адрес веб-интерфейса"
+	MsgWebLogin         = "Ссылка для входа (действует %d мин.): %s"
+
+	MsgBatchDone     = "Выполнено команд: %d"
+	MsgBatchRollback = "Ошибка в строке %d, изменения всех команд отменены"
+	MsgBatchSkipped  = "Не выполнено"
+	MsgBatchSeeBelow = "Результат в следующих сообщениях"
 
 	MsgOK = "OK"
 )
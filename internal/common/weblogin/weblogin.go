@@ -0,0 +1,93 @@
+// Package weblogin implements one-time login tokens, issued by bot
+// and redeemed by web server. Both sides sign tokens with a key
+// derived from Telegram bot token.
+package weblogin
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const TokenTTL = 5 * time.Minute
+
+var ErrTokenInvalid = errors.New("invalid login token")
+
+type Token struct {
+	UserID  int64
+	Nonce   string
+	Expires time.Time
+}
+
+// Issued returns token issue time with second precision.
+func (t *Token) Issued() time.Time {
+	return t.Expires.Add(-TokenTTL)
+}
+
+// NewToken returns signed token string for user.
+func NewToken(botToken string, userID int64) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	payload := fmt.Sprintf("%d.%d.%s",
+		userID,
+		time.Now().Add(TokenTTL).Unix(),
+		hex.EncodeToString(nonce))
+
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(payload + "." + sign(botToken, payload))), nil
+}
+
+// ParseToken checks token signature and expiration.
+// Caller is responsible for checking that nonce was not used.
+func ParseToken(botToken, token string) (*Token, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	parts := strings.Split(string(data), ".")
+	if len(parts) != 4 {
+		return nil, ErrTokenInvalid
+	}
+
+	payload := strings.Join(parts[:3], ".")
+	if !hmac.Equal([]byte(parts[3]), []byte(sign(botToken, payload))) {
+		return nil, ErrTokenInvalid
+	}
+
+	userID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	t := &Token{UserID: userID, Nonce: parts[2], Expires: time.Unix(expires, 0)}
+	if time.Now().After(t.Expires) {
+		return nil, ErrTokenInvalid
+	}
+
+	return t, nil
+}
+
+func sign(botToken, payload string) string {
+	key := hmac.New(sha256.New, []byte(botToken))
+	key.Write([]byte("weblogin"))
+
+	mac := hmac.New(sha256.New, key.Sum(nil))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
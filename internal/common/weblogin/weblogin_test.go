@@ -0,0 +1,67 @@
+package weblogin
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const _testBotToken = "123456:token"
+
+type WebLoginTestSuite struct {
+	suite.Suite
+}
+
+func (r *WebLoginTestSuite) TestRoundTrip() {
+	token, err := NewToken(_testBotToken, 1)
+	r.NoError(err)
+
+	t, err := ParseToken(_testBotToken, token)
+	r.NoError(err)
+	r.Equal(int64(1), t.UserID)
+	r.Len(t.Nonce, 32)
+	r.WithinDuration(time.Now().Add(TokenTTL), t.Expires, time.Minute)
+
+	other, err := NewToken(_testBotToken, 1)
+	r.NoError(err)
+	r.NotEqual(token, other)
+}
+
+func (r *WebLoginTestSuite) TestWrongBotToken() {
+	token, err := NewToken("654321:other", 1)
+	r.NoError(err)
+
+	_, err = ParseToken(_testBotToken, token)
+	r.ErrorIs(err, ErrTokenInvalid)
+}
+
+func (r *WebLoginTestSuite) TestExpiredToken() {
+	payload := fmt.Sprintf("%d.%d.%s", 1, time.Now().Add(-time.Minute).Unix(), "00")
+	token := base64.RawURLEncoding.EncodeToString([]byte(payload + "." + sign(_testBotToken, payload)))
+
+	_, err := ParseToken(_testBotToken, token)
+	r.ErrorIs(err, ErrTokenInvalid)
+}
+
+func (r *WebLoginTestSuite) TestMalformedToken() {
+	valid, err := NewToken(_testBotToken, 1)
+	r.NoError(err)
+
+	for _, token := range []string{
+		"",
+		"!!!",
+		base64.RawURLEncoding.EncodeToString([]byte("1.2.3")),
+		base64.RawURLEncoding.EncodeToString([]byte("a.b.c.d")),
+		valid[:len(valid)-2],
+	} {
+		_, err := ParseToken(_testBotToken, token)
+		r.ErrorIs(err, ErrTokenInvalid, token)
+	}
+}
+
+func TestWebLogin(t *testing.T) {
+	suite.Run(t, new(WebLoginTestSuite))
+}
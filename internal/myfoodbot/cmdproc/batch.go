@@ -0,0 +1,171 @@
+package cmdproc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/storage"
+	"go.uber.org/zap"
+	tele "gopkg.in/telebot.v3"
+)
+
+// Telegram limit of message length.
+const _maxMessageLen = 4096
+
+// batchError stops batch on failed command, line is 1-based.
+type batchError struct {
+	line int
+}
+
+func (e *batchError) Error() string {
+	return fmt.Sprintf("batch command failed at line %d", e.line)
+}
+
+// processBatch runs commands, one per line, in single storage transaction.
+// Batch stops on first failed command and changes of all commands are
+// rolled back. Result is one message with result of each line.
+// Transaction timeout is storage operation timeout for each line, commands
+// run with batch context, so timeout and cancellation apply to them.
+func (r *CmdProcessor) processBatch(c tele.Context, lines []string, userID int64) []CmdResponse {
+	results := make([][]CmdResponse, 0, len(lines))
+
+	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*time.Duration(len(lines)))
+	defer cancel()
+
+	err := r.stg.Batch(ctx, func(stg storage.Storage) error {
+		br := *r
+		br.stg = stg
+		br.batch = true
+
+		for i, line := range lines {
+			resp := br.dispatch(ctx, c, splitCmd(line), userID)
+			results = append(results, resp)
+			if isFailed(resp) {
+				return &batchError{line: i + 1}
+			}
+		}
+		return nil
+	})
+
+	var bErr *batchError
+	if err != nil && !errors.As(err, &bErr) {
+		r.logger.Error(
+			"batch command DB error",
+			zap.Strings("command", lines),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return batchResponse(lines, results, bErr)
+}
+
+// batchResponse returns per-line results split to messages of allowed
+// length. Documents and too long results are sent in separate messages.
+func batchResponse(lines []string, results [][]CmdResponse, bErr *batchError) []CmdResponse {
+	entries := make([]string, 0, len(lines)+1)
+	var extra []CmdResponse
+
+	for i, line := range lines {
+		entry := fmt.Sprintf("<b>%d.</b> <code>%s</code>\n", i+1, html.EscapeString(line))
+
+		if i >= len(results) {
+			entries = append(entries, entry+messages.MsgBatchSkipped)
+			continue
+		}
+
+		body, ok := responseText(results[i])
+		if !ok || utf8.RuneCountInString(entry+body) > _maxMessageLen {
+			entries = append(entries, entry+messages.MsgBatchSeeBelow)
+			extra = append(extra, results[i]...)
+			continue
+		}
+		entries = append(entries, entry+body)
+	}
+
+	if bErr != nil {
+		entries = append(entries, fmt.Sprintf(messages.MsgBatchRollback, bErr.line))
+	} else {
+		entries = append(entries, fmt.Sprintf(messages.MsgBatchDone, len(lines)))
+	}
+
+	// Join entries to messages.
+	resp := make([]CmdResponse, 0)
+	var sb strings.Builder
+	for _, entry := range entries {
+		if sb.Len() > 0 && utf8.RuneCountInString(sb.String())+utf8.RuneCountInString(entry)+2 > _maxMessageLen {
+			resp = append(resp, CmdResponse{what: sb.String(), opts: []any{optsHTML}, err: bErr != nil})
+			sb.Reset()
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(entry)
+	}
+	resp = append(resp, CmdResponse{what: sb.String(), opts: []any{optsHTML}, err: bErr != nil})
+
+	return append(resp, extra...)
+}
+
+// responseText returns text of command responses as HTML, false if
+// there are not text responses.
+func responseText(resp []CmdResponse) (string, bool) {
+	texts := make([]string, 0, len(resp))
+	for _, rItem := range resp {
+		s, ok := rItem.what.(string)
+		if !ok {
+			return "", false
+		}
+
+		if !isHTML(rItem.opts) {
+			s = html.EscapeString(s)
+		}
+		texts = append(texts, s)
+	}
+
+	return strings.Join(texts, "\n"), true
+}
+
+func isHTML(opts []any) bool {
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case *tele.SendOptions:
+			if o.ParseMode == tele.ModeHTML {
+				return true
+			}
+		case tele.ParseMode:
+			if o == tele.ModeHTML {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isFailed reports whether command failed. Informational responses like
+// empty result are not failures.
+func isFailed(resp []CmdResponse) bool {
+	for _, rItem := range resp {
+		if rItem.err {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCmd splits command line to parts by comma.
+func splitCmd(line string) []string {
+	cmdParts := []string{}
+	for _, part := range strings.Split(line, ",") {
+		cmdParts = append(cmdParts, strings.Trim(part, " "))
+	}
+	return cmdParts
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/devldavydov/myfood/internal/common/html"
 	"github.com/devldavydov/myfood/internal/common/messages"
@@ -14,134 +13,37 @@ import (
 	tele "gopkg.in/telebot.v3"
 )
 
-func (r *CmdProcessor) processActivity(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) == 0 {
-		r.logger.Error(
-			"invalid activity command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "set":
-		resp = r.activitySetCommand(cmdParts[1:], userID)
-	case "list":
-		resp = r.activityListCommand(cmdParts[1:], userID)
-	case "del":
-		resp = r.activityDelCommand(cmdParts[1:], userID)
-	default:
-		r.logger.Error(
-			"invalid activity command",
-			zap.String("reason", "unknown command"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	return resp
-}
-
-func (r *CmdProcessor) activitySetCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid activity set command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse timestamp
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid activity set command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse activeCal
-	activeCal, err := strconv.ParseFloat(cmdParts[1], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid activity set command",
-			zap.String("reason", "activeCal format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) activitySetCommand(args *cmdArgs, userID int64) []CmdResponse {
+	ts := args.Date(0)
+	activeCal := args.Float(1)
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	if err := r.stg.SetActivity(ctx, userID, &storage.Activity{Timestamp: ts, ActiveCal: activeCal}); err != nil {
 		if errors.Is(err, storage.ErrActivityInvalid) {
-			return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+			return args.Invalid(argCheck{1, activeCal > 0, _expectPositive})
 		}
 
 		r.logger.Error(
 			"activity set command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) activityListCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid activity list command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse timestamp
-	tsFrom, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid activity list command",
-			zap.String("reason", "ts from format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	tsTo, err := r.parseTimestamp(cmdParts[1])
-	if err != nil {
-		r.logger.Error(
-			"invalid activity list command",
-			zap.String("reason", "ts to format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) activityListCommand(args *cmdArgs, userID int64) []CmdResponse {
+	tsFrom, tsTo := args.DateRange(0)
 
 	// List from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	lst, err := r.stg.GetActivityList(ctx, userID, tsFrom, tsTo)
@@ -152,12 +54,12 @@ func (r *CmdProcessor) activityListCommand(cmdParts []string, userID int64) []Cm
 
 		r.logger.Error(
 			"activity list command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Report table
@@ -210,12 +112,12 @@ func (r *CmdProcessor) activityListCommand(cmdParts []string, userID int64) []Cm
 	if err != nil {
 		r.logger.Error(
 			"activity list command chart error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Doc
@@ -236,42 +138,22 @@ func (r *CmdProcessor) activityListCommand(cmdParts []string, userID int64) []Cm
 	})
 }
 
-func (r *CmdProcessor) activityDelCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
-		r.logger.Error(
-			"invalid activity del command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse timestamp
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid activity del command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) activityDelCommand(args *cmdArgs, userID int64) []CmdResponse {
+	ts := args.Date(0)
 
 	// Delete from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	if err := r.stg.DeleteActivity(ctx, userID, ts); err != nil {
 		r.logger.Error(
 			"activity del command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
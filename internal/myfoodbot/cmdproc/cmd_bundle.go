@@ -15,135 +15,113 @@ import (
 	tele "gopkg.in/telebot.v3"
 )
 
-func (r *CmdProcessor) processBundle(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) == 0 {
-		r.logger.Error(
-			"invalid bundle command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "set":
-		resp = r.bundleSetCommand(cmdParts[1:], userID)
-	case "st":
-		resp = r.bundleSetTemplateCommand(cmdParts[1:], userID)
-	case "list":
-		resp = r.bundleListCommand(cmdParts[1:], userID)
-	case "del":
-		resp = r.bundleDelCommand(cmdParts[1:], userID)
-	default:
-		r.logger.Error(
-			"invalid bundle command",
-			zap.String("reason", "unknown command"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	return resp
-}
-
-func (r *CmdProcessor) bundleSetCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) < 1 {
-		r.logger.Error(
-			"invalid bundle set command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	bndlKey := cmdParts[0]
+func (r *CmdProcessor) bundleSetCommand(args *cmdArgs, userID int64) []CmdResponse {
+	bndlKey := args.String(0)
 	bndlData := make(map[string]float64)
 
-	for _, cmdPart := range cmdParts[1:] {
-		if strings.Contains(cmdPart, ":") {
-			// Add dependant food
-			parts := strings.Split(cmdPart, ":")
-			if len(parts) > 2 {
-				return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-			}
-
-			weight, err := strconv.ParseFloat(parts[1], 64)
-			if err != nil {
-				return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-			}
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
 
-			bndlData[parts[0]] = weight
-		} else {
+	for j, item := range args.KeyQuantities(1) {
+		if item.key == "" {
+			return args.BadItem(1, j, _expectNonEmpty)
+		}
+		if !item.hasQuantity {
 			// Add dependant bundle key.
-			bndlData[cmdPart] = 0
+			bndlData[item.key] = 0
+			continue
+		}
+
+		// Add dependant food, bundle stores weight, convert portions to grams.
+		val := item.val
+		if item.portion != "" {
+			weight, resp := r.foodPortionWeight(ctx, userID, item.key, item.portion)
+			if resp != nil {
+				return resp
+			}
+			val *= weight
+		}
+		if val < 0 {
+			return args.BadItem(1, j, "вес не меньше 0")
 		}
+
+		bndlData[item.key] = val
 	}
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
-	defer cancel()
 
 	if err := r.stg.SetBundle(ctx, userID, &storage.Bundle{Key: bndlKey, Data: bndlData}); err != nil {
 		if errors.Is(err, storage.ErrBundleInvalid) {
-			return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+			return args.Invalid(argCheck{0, bndlKey != "", _expectNonEmpty})
 		}
 		if errors.Is(err, storage.ErrBundleDepBundleNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrBundleDepBundleNotFound)
+			return NewErrorCmdResponse(messages.MsgErrBundleDepBundleNotFound)
 		}
 		if errors.Is(err, storage.ErrBundleDepRecursive) {
-			return NewSingleCmdResponse(messages.MsgErrBundleDepBundleRecursive)
+			return NewErrorCmdResponse(messages.MsgErrBundleDepBundleRecursive)
 		}
 		if errors.Is(err, storage.ErrBundleDepFoodNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrBundleDepFoodNotFound)
+			return NewErrorCmdResponse(messages.MsgErrBundleDepFoodNotFound)
 		}
 
 		r.logger.Error(
 			"bundle set command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) bundleSetTemplateCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
+// foodPortionWeight returns food portion weight or error response.
+func (r *CmdProcessor) foodPortionWeight(ctx context.Context, userID int64, foodKey, portion string) (float64, []CmdResponse) {
+	food, err := r.stg.GetFood(ctx, userID, foodKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrFoodNotFound) {
+			return 0, NewErrorCmdResponse(messages.MsgErrBundleDepFoodNotFound)
+		}
+
 		r.logger.Error(
-			"invalid bundle set template command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
+			"food portion DB error",
+			zap.String("food", foodKey),
 			zap.Int64("userid", userID),
+			zap.Error(err),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+
+		return 0, NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
+	weight, ok := food.Portions[portion]
+	if !ok {
+		return 0, NewErrorCmdResponse(messages.MsgErrFoodPortionNotFound)
+	}
+
+	return weight, nil
+}
+
+func (r *CmdProcessor) bundleSetTemplateCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	bndl, err := r.stg.GetBundle(ctx, userID, cmdParts[0])
+	bndl, err := r.stg.GetBundle(ctx, userID, args.String(0))
 	if err != nil {
 		if errors.Is(err, storage.ErrBundleNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrBundleNotFound)
+			return NewErrorCmdResponse(messages.MsgErrBundleNotFound)
 		}
 
 		r.logger.Error(
 			"bundle set template command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	var sb strings.Builder
@@ -160,9 +138,9 @@ func (r *CmdProcessor) bundleSetTemplateCommand(cmdParts []string, userID int64)
 	return NewSingleCmdResponse(sb.String())
 }
 
-func (r *CmdProcessor) bundleListCommand(cmdParts []string, userID int64) []CmdResponse {
+func (r *CmdProcessor) bundleListCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	lst, err := r.stg.GetBundleList(ctx, userID)
@@ -173,12 +151,12 @@ func (r *CmdProcessor) bundleListCommand(cmdParts []string, userID int64) []CmdR
 
 		r.logger.Error(
 			"bundle list command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Build html
@@ -226,35 +204,139 @@ func (r *CmdProcessor) bundleListCommand(cmdParts []string, userID int64) []CmdR
 	})
 }
 
-func (r *CmdProcessor) bundleDelCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
+func (r *CmdProcessor) bundleDelCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Get in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.DeleteBundle(ctx, userID, args.String(0)); err != nil {
+		if errors.Is(err, storage.ErrBundleIsUsed) {
+			return NewErrorCmdResponse(messages.MsgErrBundleIsUsed)
+		}
+
 		r.logger.Error(
-			"invalid bundle del command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
+			"bundle del command DB error",
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
+			zap.Error(err),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
-	// Get in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) bundleRenameCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.DeleteBundle(ctx, userID, cmdParts[0]); err != nil {
-		if errors.Is(err, storage.ErrBundleIsUsed) {
-			return NewSingleCmdResponse(messages.MsgErrBundleIsUsed)
+	if err := r.stg.RenameBundle(ctx, userID, args.String(0), args.String(1)); err != nil {
+		if errors.Is(err, storage.ErrBundleInvalid) {
+			return args.Invalid(argCheck{1, args.String(1) != "", _expectNonEmpty})
+		}
+
+		if errors.Is(err, storage.ErrBundleNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrBundleNotFound)
+		}
+
+		if errors.Is(err, storage.ErrBundleExists) {
+			return NewErrorCmdResponse(messages.MsgErrBundleExists)
 		}
 
 		r.logger.Error(
-			"bundle del command DB error",
-			zap.Strings("command", cmdParts),
+			"bundle mv command DB error",
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
+
+func (r *CmdProcessor) bundleTreeCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Get in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	tree, err := r.stg.GetBundleTree(ctx, userID, args.String(0))
+	if err != nil {
+		if errors.Is(err, storage.ErrBundleNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrBundleNotFound)
+		}
+		if errors.Is(err, storage.ErrBundleDepBundleNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrBundleDepBundleNotFound)
+		}
+		if errors.Is(err, storage.ErrBundleDepFoodNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrBundleDepFoodNotFound)
+		}
+		if errors.Is(err, storage.ErrBundleDepRecursive) {
+			return NewErrorCmdResponse(messages.MsgErrBundleDepBundleRecursive)
+		}
+
+		r.logger.Error(
+			"bundle tree command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	// Build html
+	htmlBuilder := html.NewBuilder("Дерево бандла")
+
+	// Table
+	tbl := html.NewTable([]string{
+		"Бандл/Еда", "Вес, г.", "ККал", "Белки", "Жиры", "Углеводы",
+	})
+	addBundleTreeRows(tbl, tree, 0)
+
+	// Doc
+	htmlBuilder.Add(
+		html.NewContainer().Add(
+			html.NewH(
+				fmt.Sprintf("Дерево бандла %s", tree.Key),
+				5,
+				html.Attrs{"align": "center"},
+			),
+			tbl))
+
+	// Response
+	return NewSingleCmdResponse(&tele.Document{
+		File:     tele.FromReader(bytes.NewBufferString(htmlBuilder.Build())),
+		MIME:     "text/html",
+		FileName: fmt.Sprintf("bundle_%s.html", tree.Key),
+	})
+}
+
+func addBundleTreeRows(tbl *html.Table, node *storage.BundleNode, depth int) {
+	var lbl html.IELement
+	if node.IsBundle {
+		lbl = html.NewB(node.Key, nil)
+	} else {
+		foodLbl := node.Name
+		if node.Brand != "" {
+			foodLbl = fmt.Sprintf("%s - %s", foodLbl, node.Brand)
+		}
+		lbl = html.NewS(fmt.Sprintf("%s [%s]", foodLbl, node.Key))
+	}
+
+	tbl.AddRow(
+		html.NewTr(nil).
+			AddTd(html.NewTd(lbl, html.Attrs{"style": fmt.Sprintf("padding-left: %dem", depth*2)})).
+			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.1f", node.Weight)), nil)).
+			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", node.Cal)), nil)).
+			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", node.Prot)), nil)).
+			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", node.Fat)), nil)).
+			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", node.Carb)), nil)))
+
+	for i := range node.Children {
+		addBundleTreeRows(tbl, &node.Children[i], depth+1)
+	}
+}
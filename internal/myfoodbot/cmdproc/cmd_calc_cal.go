@@ -2,35 +2,16 @@ package cmdproc
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
-
-	"github.com/devldavydov/myfood/internal/common/messages"
 )
 
-func (r *CmdProcessor) calcCalCommand(cmdParts []string) []CmdResponse {
-	if len(cmdParts) != 4 {
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	gender := cmdParts[0]
-	if !(gender == "m" || gender == "f") {
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	weight, err := strconv.ParseFloat(cmdParts[1], 64)
-	if err != nil || weight <= 0 {
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	height, err := strconv.ParseFloat(cmdParts[2], 64)
-	if err != nil || height <= 0 {
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	age, err := strconv.ParseFloat(cmdParts[3], 64)
-	if err != nil || age <= 0 {
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+func (r *CmdProcessor) calcCalCommand(args *cmdArgs, _ int64) []CmdResponse {
+	gender := args.String(0)
+	weight, height, age := args.Float(1), args.Float(2), args.Float(3)
+	for i, val := range []float64{weight, height, age} {
+		if val <= 0 {
+			return args.BadValue(i+1, _expectPositive)
+		}
 	}
 
 	ubm := 10*weight + 6.25*height - 5*age
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -15,206 +16,154 @@ import (
 	tele "gopkg.in/telebot.v3"
 )
 
-func (r *CmdProcessor) processFood(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) == 0 {
-		r.logger.Error(
-			"invalid food command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "set":
-		resp = r.foodSetCommand(cmdParts[1:], userID)
-	case "sc":
-		resp = r.foodSetCommentCommand(cmdParts[1:], userID)
-	case "st":
-		resp = r.foodSetTemplateCommand(cmdParts[1:], userID)
-	case "find":
-		resp = r.foodFindCommand(cmdParts[1:], userID)
-	case "calc":
-		resp = r.foodCalcCommand(cmdParts[1:], userID)
-	case "list":
-		resp = r.foodListCommand(userID)
-	case "del":
-		resp = r.foodDelCommand(cmdParts[1:], userID)
-	default:
-		r.logger.Error(
-			"invalid food command",
-			zap.String("reason", "unknown command"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+// Default count of foods in f,find result.
+const _foodFindLimit = 10
 
-	return resp
-}
-
-func (r *CmdProcessor) foodSetCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 8 {
-		r.logger.Error(
-			"invalid food set command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse fields
+func (r *CmdProcessor) foodSetCommand(args *cmdArgs, userID int64, private bool) []CmdResponse {
 	food := &storage.Food{
-		Key:     cmdParts[0],
-		Name:    cmdParts[1],
-		Brand:   cmdParts[2],
-		Comment: cmdParts[7],
+		Key:     args.String(0),
+		Name:    args.String(1),
+		Brand:   args.String(2),
+		Cal100:  args.Float(3),
+		Prot100: args.Float(4),
+		Fat100:  args.Float(5),
+		Carb100: args.Float(6),
+		Comment: args.String(7),
+		// Extended nutrients are optional, empty value means unknown.
+		Fiber100:  args.OptFloat(8),
+		Sugar100:  args.OptFloat(9),
+		SatFat100: args.OptFloat(10),
+		Salt100:   args.OptFloat(11),
+		Private:   private,
 	}
 
-	cal100, err := strconv.ParseFloat(cmdParts[3], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid food set command",
-			zap.String("reason", "cal100 format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-	food.Cal100 = cal100
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
 
-	prot100, err := strconv.ParseFloat(cmdParts[4], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid food set command",
-			zap.String("reason", "prot100 format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-	food.Prot100 = prot100
+	if err := r.stg.SetFood(ctx, userID, food); err != nil {
+		if errors.Is(err, storage.ErrFoodInvalid) {
+			return args.Invalid(
+				argCheck{0, food.Key != "", _expectNonEmpty},
+				argCheck{1, food.Name != "", _expectNonEmpty},
+				argCheck{3, food.Cal100 >= 0, _expectNonNegative},
+				argCheck{4, food.Prot100 >= 0, _expectNonNegative},
+				argCheck{5, food.Fat100 >= 0, _expectNonNegative},
+				argCheck{6, food.Carb100 >= 0, _expectNonNegative},
+				argCheck{8, food.Fiber100 == nil || *food.Fiber100 >= 0, _expectNonNegative},
+				argCheck{9, food.Sugar100 == nil || *food.Sugar100 >= 0, _expectNonNegative},
+				argCheck{10, food.SatFat100 == nil || *food.SatFat100 >= 0, _expectNonNegative},
+				argCheck{11, food.Salt100 == nil || *food.Salt100 >= 0, _expectNonNegative},
+			)
+		}
+		if errors.Is(err, storage.ErrFoodIsRecipe) {
+			return NewErrorCmdResponse(messages.MsgErrFoodIsRecipe)
+		}
 
-	fat100, err := strconv.ParseFloat(cmdParts[5], 64)
-	if err != nil {
 		r.logger.Error(
-			"invalid food set command",
-			zap.String("reason", "fat100 format"),
-			zap.Strings("command", cmdParts),
+			"food set command DB error",
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-	food.Fat100 = fat100
 
-	carb100, err := strconv.ParseFloat(cmdParts[6], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid food set command",
-			zap.String("reason", "carb100 format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
-	food.Carb100 = carb100
 
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) foodSetCommentCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.SetFood(ctx, food); err != nil {
-		if errors.Is(err, storage.ErrFoodInvalid) {
-			return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+	if err := r.stg.SetFoodComment(ctx, userID, args.String(0), args.String(1)); err != nil {
+		if errors.Is(err, storage.ErrFoodNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
 		}
 
 		r.logger.Error(
-			"food set command DB error",
-			zap.Strings("command", cmdParts),
+			"food set comment command DB error",
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) foodSetCommentCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid food set comment command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+func (r *CmdProcessor) foodSetPortionsCommand(args *cmdArgs, userID int64) []CmdResponse {
+	items := args.KeyQuantities(1)
+	portions := make(map[string]float64, len(items))
+	for j, item := range items {
+		if !item.hasQuantity || item.portion != "" {
+			return args.BadItem(1, j, "порция:вес в граммах, например шт:55")
+		}
+		if item.key == "" || item.val <= 0 {
+			return args.BadItem(1, j, "непустая порция и вес больше 0")
+		}
+
+		portions[item.key] = item.val
 	}
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.SetFoodComment(ctx, cmdParts[0], cmdParts[1]); err != nil {
+	if err := r.stg.SetFoodPortions(ctx, userID, args.String(0), portions); err != nil {
+		if errors.Is(err, storage.ErrFoodInvalid) {
+			return NewErrorCmdResponse(messages.MsgErrInvalidCommand)
+		}
 		if errors.Is(err, storage.ErrFoodNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrFoodNotFound)
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
 		}
 
 		r.logger.Error(
-			"food set comment command DB error",
-			zap.Strings("command", cmdParts),
+			"food set portions command DB error",
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) foodSetTemplateCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
-		r.logger.Error(
-			"invalid food set template command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
+func (r *CmdProcessor) foodSetTemplateCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get food from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	food, err := r.stg.GetFood(ctx, cmdParts[0])
+	food, err := r.stg.GetFood(ctx, userID, args.String(0))
 	if err != nil {
 		if errors.Is(err, storage.ErrFoodNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrFoodNotFound)
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
 		}
 
 		r.logger.Error(
 			"food set template command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	setCmd := "set"
+	if food.Private {
+		setCmd = "setp"
 	}
 
 	foodSetTemplate := fmt.Sprintf(
-		"f,set,%s,%s,%s,%.2f,%.2f,%.2f,%.2f,%s",
+		"f,%s,%s,%s,%s,%.2f,%.2f,%.2f,%.2f,%s",
+		setCmd,
 		food.Key,
 		food.Name,
 		food.Brand,
@@ -224,25 +173,50 @@ func (r *CmdProcessor) foodSetTemplateCommand(cmdParts []string, userID int64) [
 		food.Carb100,
 		food.Comment,
 	)
-	return NewSingleCmdResponse(foodSetTemplate, optsHTML)
-}
-
-func (r *CmdProcessor) foodFindCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
-		r.logger.Error(
-			"invalid food find command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
+	if food.Fiber100 != nil || food.Sugar100 != nil || food.SatFat100 != nil || food.Salt100 != nil {
+		foodSetTemplate += fmt.Sprintf(
+			",%s,%s,%s,%s",
+			formatOptNutrient(food.Fiber100),
+			formatOptNutrient(food.Sugar100),
+			formatOptNutrient(food.SatFat100),
+			formatOptNutrient(food.Salt100),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
 	}
 
+	resp := NewSingleCmdResponse(foodSetTemplate, optsHTML)
+	if len(food.Portions) > 0 {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("f,ps,%s", food.Key))
+		for _, k := range foodPortionNames(food) {
+			sb.WriteString(fmt.Sprintf(",%s:%s", k, strconv.FormatFloat(food.Portions[k], 'f', -1, 64)))
+		}
+		resp = append(resp, NewCmdResponse(sb.String(), optsHTML))
+	}
+
+	return resp
+}
+
+func (r *CmdProcessor) foodFindCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	foodLst, err := r.stg.FindFood(ctx, cmdParts[0])
+	limit, offset := _foodFindLimit, 0
+	if args.Has(1) {
+		limit = int(args.Int(1))
+		if limit <= 0 {
+			return args.BadValue(1, _expectPositive)
+		}
+	}
+	if args.Has(2) {
+		offset = int(args.Int(2))
+		if offset < 0 {
+			return args.BadValue(2, "целое число не меньше 0")
+		}
+	}
+
+	// One more food to know whether next page exists.
+	foodLst, err := r.stg.FindFood(ctx, userID, args.String(0), limit+1, offset)
 	if err != nil {
 		if errors.Is(err, storage.ErrFoodEmptyList) {
 			return NewSingleCmdResponse(messages.MsgErrEmptyList)
@@ -250,12 +224,17 @@ func (r *CmdProcessor) foodFindCommand(cmdParts []string, userID int64) []CmdRes
 
 		r.logger.Error(
 			"food find command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	hasMore := len(foodLst) > limit
+	if hasMore {
+		foodLst = foodLst[:limit]
 	}
 
 	var sb strings.Builder
@@ -268,57 +247,55 @@ func (r *CmdProcessor) foodFindCommand(cmdParts []string, userID int64) []CmdRes
 		sb.WriteString(fmt.Sprintf("<b>Бел100:</b> %.2f\n", food.Prot100))
 		sb.WriteString(fmt.Sprintf("<b>Жир100:</b> %.2f\n", food.Fat100))
 		sb.WriteString(fmt.Sprintf("<b>Угл100:</b> %.2f\n", food.Carb100))
+		writeOptNutrients(&sb, "100", food.Fiber100, food.Sugar100, food.SatFat100, food.Salt100)
+		if len(food.Portions) > 0 {
+			portions := make([]string, 0, len(food.Portions))
+			for _, k := range foodPortionNames(&food) {
+				portions = append(portions, fmt.Sprintf("%s - %.1f г.", k, food.Portions[k]))
+			}
+			sb.WriteString(fmt.Sprintf("<b>Порции:</b> %s\n", strings.Join(portions, ", ")))
+		}
 		sb.WriteString(fmt.Sprintf("<b>Комментарий:</b> %s\n", food.Comment))
+		if food.Private {
+			sb.WriteString("<b>Личная:</b> да\n")
+		}
 
 		if i != len(foodLst)-1 {
 			sb.WriteString("\n")
 		}
 	}
 
+	if hasMore {
+		sb.WriteString(fmt.Sprintf(
+			"\n<b>Следующие записи:</b> <code>f,find,%s,%d,%d</code>",
+			args.String(0), limit, offset+limit,
+		))
+	}
+
 	return NewSingleCmdResponse(sb.String(), optsHTML)
 }
 
-func (r *CmdProcessor) foodCalcCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid food calc command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	foodWeight, err := strconv.ParseFloat(cmdParts[1], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid food calc command",
-			zap.String("reason", "weight format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) foodCalcCommand(args *cmdArgs, userID int64) []CmdResponse {
+	foodWeight := args.Float(1)
 
 	// Get in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	food, err := r.stg.GetFood(ctx, cmdParts[0])
+	food, err := r.stg.GetFood(ctx, userID, args.String(0))
 	if err != nil {
 		if errors.Is(err, storage.ErrFoodNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrFoodNotFound)
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
 		}
 
 		r.logger.Error(
 			"food calc command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	var sb strings.Builder
@@ -329,49 +306,67 @@ func (r *CmdProcessor) foodCalcCommand(cmdParts []string, userID int64) []CmdRes
 	sb.WriteString(fmt.Sprintf("<b>Бел:</b> %.2f\n", foodWeight/100*food.Prot100))
 	sb.WriteString(fmt.Sprintf("<b>Жир:</b> %.2f\n", foodWeight/100*food.Fat100))
 	sb.WriteString(fmt.Sprintf("<b>Угл:</b> %.2f\n", foodWeight/100*food.Carb100))
+	writeOptNutrients(
+		&sb,
+		"",
+		scaleOptNutrient(food.Fiber100, foodWeight),
+		scaleOptNutrient(food.Sugar100, foodWeight),
+		scaleOptNutrient(food.SatFat100, foodWeight),
+		scaleOptNutrient(food.Salt100, foodWeight),
+	)
 
 	return NewSingleCmdResponse(sb.String(), optsHTML)
 }
 
-func (r *CmdProcessor) foodDelCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
-		r.logger.Error(
-			"invalid food del command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+// writeOptNutrients writes known extended nutrients, suffix added to labels.
+func writeOptNutrients(sb *strings.Builder, suffix string, fiber, sugar, satFat, salt *float64) {
+	for _, n := range []struct {
+		label string
+		val   *float64
+	}{
+		{"Клетч", fiber},
+		{"Сахар", sugar},
+		{"НасЖир", satFat},
+		{"Соль", salt},
+	} {
+		if n.val != nil {
+			sb.WriteString(fmt.Sprintf("<b>%s%s:</b> %.2f\n", n.label, suffix, *n.val))
+		}
 	}
+}
 
+func (r *CmdProcessor) foodDelCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Delete from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.DeleteFood(ctx, cmdParts[0]); err != nil {
+	if err := r.stg.DeleteFood(ctx, userID, args.String(0)); err != nil {
 		if errors.Is(err, storage.ErrFoodIsUsed) {
-			return NewSingleCmdResponse(messages.MsgErrFoodIsUsed)
+			return NewErrorCmdResponse(messages.MsgErrFoodIsUsed)
+		}
+		if errors.Is(err, storage.ErrFoodIsRecipe) {
+			return NewErrorCmdResponse(messages.MsgErrFoodIsRecipe)
 		}
 
 		r.logger.Error(
 			"food del command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) foodListCommand(userID int64) []CmdResponse {
+func (r *CmdProcessor) foodListCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	foodList, err := r.stg.GetFoodList(ctx)
+	foodList, err := r.stg.GetFoodList(ctx, userID)
 	if err != nil {
 		if errors.Is(err, storage.ErrFoodEmptyList) {
 			return NewSingleCmdResponse(messages.MsgErrEmptyList)
@@ -383,7 +378,7 @@ func (r *CmdProcessor) foodListCommand(userID int64) []CmdResponse {
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Build html
@@ -392,7 +387,8 @@ func (r *CmdProcessor) foodListCommand(userID int64) []CmdResponse {
 	// Table
 	tbl := html.NewTable([]string{
 		"Ключ", "Наименование", "Бренд", "ККал в 100г.", "Белки в 100г.",
-		"Жиры в 100г.", "Углеводы в 100г.", "Комментарий",
+		"Жиры в 100г.", "Углеводы в 100г.", "Клетчатка в 100г.", "Сахар в 100г.",
+		"Насыщ. жиры в 100г.", "Соль в 100г.", "Комментарий", "Личная",
 	})
 
 	for _, item := range foodList {
@@ -405,7 +401,12 @@ func (r *CmdProcessor) foodListCommand(userID int64) []CmdResponse {
 			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", item.Prot100)), nil)).
 			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", item.Fat100)), nil)).
 			AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", item.Carb100)), nil)).
-			AddTd(html.NewTd(html.NewS(item.Comment), nil))
+			AddTd(html.NewTd(html.NewS(formatOptNutrient(item.Fiber100)), nil)).
+			AddTd(html.NewTd(html.NewS(formatOptNutrient(item.Sugar100)), nil)).
+			AddTd(html.NewTd(html.NewS(formatOptNutrient(item.SatFat100)), nil)).
+			AddTd(html.NewTd(html.NewS(formatOptNutrient(item.Salt100)), nil)).
+			AddTd(html.NewTd(html.NewS(item.Comment), nil)).
+			AddTd(html.NewTd(html.NewS(foodPrivateString(item.Private)), nil))
 		tbl.AddRow(tr)
 	}
 
@@ -426,3 +427,78 @@ func (r *CmdProcessor) foodListCommand(userID int64) []CmdResponse {
 		FileName: "food.html",
 	})
 }
+
+func foodPortionNames(food *storage.Food) []string {
+	names := make([]string, 0, len(food.Portions))
+	for k := range food.Portions {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func foodPrivateString(private bool) string {
+	if private {
+		return "да"
+	}
+	return ""
+}
+
+func (r *CmdProcessor) foodRenameCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.RenameFood(ctx, userID, args.String(0), args.String(1)); err != nil {
+		if errors.Is(err, storage.ErrFoodInvalid) {
+			return args.Invalid(argCheck{1, args.String(1) != "", _expectNonEmpty})
+		}
+
+		if errors.Is(err, storage.ErrFoodNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
+		}
+
+		if errors.Is(err, storage.ErrFoodExists) {
+			return NewErrorCmdResponse(messages.MsgErrFoodExists)
+		}
+
+		r.logger.Error(
+			"food mv command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) foodMergeCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.MergeFoods(ctx, userID, args.String(0), args.String(1)); err != nil {
+		if errors.Is(err, storage.ErrFoodNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
+		}
+
+		if errors.Is(err, storage.ErrFoodMerge) {
+			return NewErrorCmdResponse(messages.MsgErrFoodMerge)
+		}
+
+		r.logger.Error(
+			"food merge command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
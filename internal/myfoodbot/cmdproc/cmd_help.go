@@ -1,26 +1,197 @@
 package cmdproc
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/devldavydov/myfood/internal/common/html"
 	"github.com/devldavydov/myfood/internal/common/messages"
-	"github.com/devldavydov/myfood/internal/myfoodbot/helpdoc"
 	"go.uber.org/zap"
 	tele "gopkg.in/telebot.v3"
 )
 
-func (r *CmdProcessor) helpCommand(c tele.Context) []CmdResponse {
-	docRd, err := helpdoc.GetHelpDocument("help")
-	if err != nil {
+var helpIntro = []string{
+	"Бот MyFoodBot предназначен для учета веса и потребляемых калорий",
+	"Взаимодействие с ботом осуществляется посредством команд",
+	"Бот работает в таймзоне Europe/Moscow, настроек таймзоны под пользователя на данный момент нет",
+	"Команды задаются в формате значений, разделенных запятой: <code>command,subcommand,arg1,arg2,...</code>",
+	"Если после или до запятых есть пробелы, то они удаляются",
+	"Команды и подкоманды задаются в нижнем регистре, у команд есть полные синонимы, например <code>weight</code> для <code>w</code>",
+	"Аргументы в квадратных скобках необязательные",
+	"Несколько команд можно отправить одним сообщением, по одной команде в строке. Команды выполняются по порядку в одной транзакции: если команда завершилась ошибкой, то следующие команды не выполняются, а изменения всех команд отменяются",
+	"Справка по отдельной команде: <code>h,&lt;Команда&gt;[,&lt;Подкоманда&gt;]</code>",
+}
+
+var helpDates = []string{
+	"<code>DD.MM.YYYY</code> - полная дата",
+	"<code>DD.MM</code> - последняя такая дата, включая сегодня",
+	"пустое значение, <code>сегодня</code> или <code>today</code> - текущая дата",
+	"<code>вчера</code>, <code>yesterday</code>, <code>позавчера</code>",
+	"<code>-N</code> - N дней назад, например <code>-7</code>",
+	"день недели (<code>пн</code>, <code>вторник</code>, <code>fri</code>) - последний такой день, включая текущий",
+}
+
+func (r *CmdProcessor) helpCommand(args *cmdArgs, userID int64) []CmdResponse {
+	if !args.Has(0) {
+		return NewSingleCmdResponse(&tele.Document{
+			File:     tele.FromReader(bytes.NewBufferString(r.helpDocument())),
+			MIME:     "text/html",
+			FileName: "help.html",
+		})
+	}
+
+	spec := findCmdSpec(r.cmds, args.String(0))
+	if spec == nil {
+		r.logger.Error(
+			"invalid help command",
+			zap.String("reason", "unknown command"),
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+		)
+		return NewErrorCmdResponse(messages.MsgErrInvalidCommand)
+	}
+
+	if !args.Has(1) {
+		return NewSingleCmdResponse(cmdHelpText(spec), optsHTML)
+	}
+
+	subSpec := findCmdSpec(spec.subcmds, args.String(1))
+	if subSpec == nil {
 		r.logger.Error(
-			"help command error",
-			zap.Int64("userid", c.Sender().ID),
-			zap.Error(err),
+			"invalid help command",
+			zap.String("reason", "unknown subcommand"),
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
 		)
+		return NewErrorCmdResponse(messages.MsgErrInvalidCommand)
+	}
+
+	return NewSingleCmdResponse(subCmdHelpText(spec.name, subSpec), optsHTML)
+}
+
+// cmdHelpText returns command help with list of subcommands.
+func cmdHelpText(spec *cmdSpec) string {
+	if spec.subcmds == nil {
+		return subCmdHelpText("", spec)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<b>%s</b>\n", cmdHeader(spec)))
+	for _, note := range spec.notes {
+		sb.WriteString(note + "\n")
+	}
+	for _, sub := range spec.subcmds {
+		sb.WriteString(fmt.Sprintf("\n%s\n<code>%s</code>\n", sub.title, cmdUsage(spec.name, sub)))
+	}
+	sb.WriteString(fmt.Sprintf("\nПодробнее: <code>h,%s,&lt;Подкоманда&gt;</code>", spec.name))
+
+	return sb.String()
+}
+
+// subCmdHelpText returns detailed subcommand help, parent is empty for
+// commands without subcommands.
+func subCmdHelpText(parent string, spec *cmdSpec) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<b>%s</b>\n<code>%s</code>\n", spec.title, cmdUsage(parent, spec)))
+	if len(spec.notes) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, note := range spec.notes {
+		sb.WriteString(note + "\n")
+	}
+
+	return sb.String()
+}
+
+// helpDocument generates HTML help document from command registry.
+func (r *CmdProcessor) helpDocument() string {
+	htmlBuilder := html.NewBuilder("Руководство MyFoodBot")
+	accordion := html.NewAccordion("accordionHelp")
+
+	// General description
+	intro := html.NewDiv("")
+	for _, note := range helpIntro {
+		intro.Add(html.NewS(fmt.Sprintf("<p>%s</p>", note)))
+	}
+	intro.Add(
+		html.NewDiv("alert alert-primary").Add(html.NewS("Формат дат")),
+		html.NewS("<p>Дата в командах может быть задана в следующих форматах:</p>"),
+		html.NewS(fmt.Sprintf("<ul><li>%s</li></ul>", strings.Join(helpDates, "</li><li>"))),
+		html.NewS("<p>"+_noteRange+"</p>"),
+	)
+	accordion.AddItem(html.HewAccordionItem("collapseMain", "Общее описание", intro))
+
+	// Commands
+	for _, spec := range r.cmds {
+		body := html.NewDiv("")
+		for _, note := range spec.notes {
+			body.Add(html.NewS(fmt.Sprintf("<p>%s</p>", note)))
+		}
+
+		if spec.subcmds == nil {
+			body.Add(html.NewS(fmt.Sprintf("<p>Команда: <code>%s</code></p>", cmdUsage("", spec))))
+		}
+
+		for _, sub := range spec.subcmds {
+			body.Add(
+				html.NewDiv("alert alert-primary").Add(html.NewS(sub.title)),
+				html.NewS(fmt.Sprintf("<p>Команда: <code>%s</code></p>", cmdUsage(spec.name, sub))),
+			)
+			for _, note := range sub.notes {
+				body.Add(html.NewS(fmt.Sprintf("<p>%s</p>", note)))
+			}
+		}
+
+		accordion.AddItem(html.HewAccordionItem("collapse_"+spec.name, cmdHeader(spec), body))
+	}
+
+	htmlBuilder.Add(
+		html.NewContainer().Add(accordion),
+		html.NewScript(_jsBootstrapURL),
+	)
+
+	return htmlBuilder.Build()
+}
+
+func cmdHeader(spec *cmdSpec) string {
+	return fmt.Sprintf("%s (%s)", spec.title, strings.Join(append([]string{spec.name}, spec.aliases...), ", "))
+}
+
+// cmdUsage returns command syntax like "j,set,<Дата>,...[,<Время HH:MM>]".
+func cmdUsage(parent string, spec *cmdSpec) string {
+	var sb strings.Builder
+	if parent != "" {
+		sb.WriteString(parent + ",")
+	}
+	sb.WriteString(spec.name)
+
+	// Trailing optional arguments are nested in brackets.
+	firstOpt := len(spec.args)
+	for firstOpt > 0 && spec.args[firstOpt-1].optional {
+		firstOpt--
+	}
+
+	for i, arg := range spec.args {
+		if i >= firstOpt {
+			sb.WriteString("[")
+		}
+		sb.WriteString("," + argUsage(&arg))
+	}
+	sb.WriteString(strings.Repeat("]", len(spec.args)-firstOpt))
+
+	return sb.String()
+}
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+func argUsage(arg *argSpec) string {
+	switch {
+	case arg.typ == argDateRange:
+		return "&lt;Дата С&gt;[,&lt;Дата По&gt;]"
+	case arg.typ == argChoice:
+		return fmt.Sprintf("&lt;%s %s&gt;", arg.name, strings.Join(arg.choices, "|"))
+	case arg.variadic:
+		return fmt.Sprintf("&lt;%s&gt;,...", arg.name)
+	default:
+		return fmt.Sprintf("&lt;%s&gt;", arg.name)
 	}
-	return NewSingleCmdResponse(&tele.Document{
-		File:     tele.FromReader(docRd),
-		MIME:     "text/html",
-		FileName: "help.html",
-	})
 }
@@ -16,362 +16,238 @@ import (
 	tele "gopkg.in/telebot.v3"
 )
 
-func (r *CmdProcessor) processJournal(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) == 0 {
-		r.logger.Error(
-			"invalid journal command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "set":
-		resp = r.journalSetCommand(cmdParts[1:], userID)
-	case "sb":
-		resp = r.journalSetBundleCommand(cmdParts[1:], userID)
-	case "del":
-		resp = r.journalDelCommand(cmdParts[1:], userID)
-	case "dm":
-		resp = r.journalDelMealCommand(cmdParts[1:], userID)
-	case "cp":
-		resp = r.journalCopyCommand(cmdParts[1:], userID)
-	case "rd":
-		resp = r.journalReportDayCommand(cmdParts[1:], userID)
-	case "rw":
-		resp = r.journalReportWeekCommand(cmdParts[1:], userID)
-	case "rr":
-		resp = r.journalReportRangeCommand(cmdParts[1:], userID)
-	case "tm":
-		resp = r.journalTemplateMealCommand(cmdParts[1:], userID)
-	case "fa":
-		resp = r.journalFoodAvgWeightCommand(cmdParts[1:], userID)
-	default:
-		r.logger.Error(
-			"invalid journal command",
-			zap.String("reason", "unknown command"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	return resp
-}
-
-func (r *CmdProcessor) journalSetCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 4 {
-		r.logger.Error(
-			"invalid journal set command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
+func (r *CmdProcessor) journalSetCommand(args *cmdArgs, userID int64, mode storage.JournalSetMode) []CmdResponse {
 	jrnl := &storage.Journal{
-		Meal:    storage.NewMealFromString(cmdParts[1]),
-		FoodKey: cmdParts[2],
+		Timestamp: args.Date(0),
+		FoodKey:   args.String(2),
+		DayTime:   args.Time(4),
 	}
 
-	// Parse timestamp
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal set command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+	// Optional time of day, current time if not set
+	if !args.Has(4) {
+		jrnl.DayTime = r.currentDayTime()
 	}
-	jrnl.Timestamp = ts
 
-	// Parse weight
-	weight, err := strconv.ParseFloat(cmdParts[3], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid weight set command",
-			zap.String("reason", "val format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+	// Weight or portions
+	if q := args.Quantity(3); q.portion == "" {
+		jrnl.FoodWeight = q.val
+	} else {
+		jrnl.Portion = q.portion
+		jrnl.PortionCount = q.val
 	}
-	jrnl.FoodWeight = weight
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.SetJournal(ctx, userID, jrnl); err != nil {
+	meal, resp := r.mealArg(ctx, args, 1, userID)
+	if resp != nil {
+		return resp
+	}
+	jrnl.Meal = meal
+
+	if err := r.stg.SetJournal(ctx, userID, jrnl, mode); err != nil {
 		if errors.Is(err, storage.ErrJournalInvalid) {
-			return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+			return args.Invalid(
+				argCheck{2, jrnl.FoodKey != "", _expectNonEmpty},
+				argCheck{3, jrnl.FoodWeight > 0 || jrnl.PortionCount > 0, _expectPositive},
+			)
 		}
 
 		if errors.Is(err, storage.ErrJournalInvalidFood) {
-			return NewSingleCmdResponse(messages.MsgErrFoodNotFound)
+			return r.suggestResponse(args, 2, messages.MsgErrFoodNotFound, r.suggestFood(ctx, userID, jrnl.FoodKey))
+		}
+
+		if errors.Is(err, storage.ErrFoodPortionNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrFoodPortionNotFound)
 		}
 
 		r.logger.Error(
 			"journal set command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) journalSetBundleCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 3 {
-		r.logger.Error(
-			"invalid journal set bundle command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalSetBundleCommand(args *cmdArgs, userID int64, mode storage.JournalSetMode) []CmdResponse {
+	ts := args.Date(0)
+	bndlKey := args.String(2)
 
-	// Parse timestamp
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal set bundle command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+	dayTime := r.currentDayTime()
 
-	meal := storage.NewMealFromString(cmdParts[1])
-	bndlKey := cmdParts[2]
+	// Optional multiplier, empty means full bundle
+	multiplier := 1.0
+	if args.Has(3) {
+		multiplier = args.Float(3)
+		if multiplier <= 0 {
+			return args.BadValue(3, _expectPositive)
+		}
+	}
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.SetJournalBundle(ctx, userID, ts, meal, bndlKey); err != nil {
+	meal, resp := r.mealArg(ctx, args, 1, userID)
+	if resp != nil {
+		return resp
+	}
+
+	if err := r.stg.SetJournalBundle(ctx, userID, ts, dayTime, meal, bndlKey, multiplier, mode); err != nil {
 		if errors.Is(err, storage.ErrJournalInvalidFood) {
-			return NewSingleCmdResponse(messages.MsgErrFoodNotFound)
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
 		}
 		if errors.Is(err, storage.ErrBundleNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrBundleNotFound)
+			return r.suggestResponse(args, 2, messages.MsgErrBundleNotFound, r.suggestBundle(ctx, userID, bndlKey))
+		}
+		if errors.Is(err, storage.ErrBundleDepRecursive) {
+			return NewErrorCmdResponse(messages.MsgErrBundleDepBundleRecursive)
 		}
 
 		r.logger.Error(
 			"journal set bundle command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) journalDelCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 3 {
-		r.logger.Error(
-			"invalid journal del command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse timestamp
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal del command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalDelCommand(args *cmdArgs, userID int64) []CmdResponse {
+	ts := args.Date(0)
 
 	// Delete from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.DeleteJournal(ctx, userID, ts, storage.NewMealFromString(cmdParts[1]), cmdParts[2]); err != nil {
+	meal, resp := r.mealArg(ctx, args, 1, userID)
+	if resp != nil {
+		return resp
+	}
+
+	// Without time delete all entries of food in meal
+	var err error
+	if !args.Has(3) {
+		err = r.stg.DeleteJournal(ctx, userID, ts, meal, args.String(2))
+	} else {
+		dayTime := args.Time(3)
+		err = r.stg.DeleteJournalEntry(ctx, userID, ts, &dayTime, meal, args.String(2))
+	}
+
+	if err != nil {
 		r.logger.Error(
 			"journal del command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) journalDelMealCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid journal dm command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	// Parse timestamp
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal dm command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalDelMealCommand(args *cmdArgs, userID int64) []CmdResponse {
+	ts := args.Date(0)
 
 	// Delete from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
-	if err := r.stg.DeleteJournalMeal(ctx, userID, ts, storage.NewMealFromString(cmdParts[1])); err != nil {
+	meal, resp := r.mealArg(ctx, args, 1, userID)
+	if resp != nil {
+		return resp
+	}
+
+	if err := r.stg.DeleteJournalMeal(ctx, userID, ts, meal); err != nil {
 		r.logger.Error(
 			"journal dm command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) journalCopyCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 4 {
-		r.logger.Error(
-			"invalid journal copy command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalCopyCommand(args *cmdArgs, userID int64) []CmdResponse {
+	tsFrom := args.Date(0)
 
-	// Parse timestamp
-	tsFrom, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal copy command",
-			zap.String("reason", "ts from format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	tsTo, err := r.parseTimestamp(cmdParts[2])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal copy command",
-			zap.String("reason", "ts to format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+	tsTo := args.Date(2)
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
+	mealFrom, resp := r.mealArg(ctx, args, 1, userID)
+	if resp != nil {
+		return resp
+	}
+
+	mealTo, resp := r.mealArg(ctx, args, 3, userID)
+	if resp != nil {
+		return resp
+	}
+
 	cnt, err := r.stg.CopyJournal(ctx,
 		userID,
 		tsFrom,
-		storage.NewMealFromString(cmdParts[1]),
+		mealFrom,
 		tsTo,
-		storage.NewMealFromString(cmdParts[3]))
+		mealTo)
 
 	if err != nil {
 		if errors.Is(err, storage.ErrCopyToNotEmpty) {
-			return NewSingleCmdResponse(messages.MsgErrJournalCopy)
+			return NewErrorCmdResponse(messages.MsgErrJournalCopy)
 		}
 
 		r.logger.Error(
 			"journal copy command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(fmt.Sprintf(messages.MsgJournalCopied, cnt))
 }
 
-func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
-		r.logger.Error(
-			"invalid journal rd command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal rd command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalReportDayCommand(args *cmdArgs, userID int64) []CmdResponse {
+	ts := args.Date(0)
 	tsStr := formatTimestamp(ts)
 
 	// Get list from DB, user settings and activity
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*2)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*2)
 	defer cancel()
 
 	var us *storage.UserSettings
-	us, err = r.stg.GetUserSettings(ctx, userID)
+	us, err := r.stg.GetUserSettings(ctx, userID)
 	if err != nil {
 		if !errors.Is(err, storage.ErrUserSettingsNotFound) {
 			r.logger.Error(
 				"journal rd command DB error for user settings",
-				zap.Strings("command", cmdParts),
+				zap.Strings("command", args.raw),
 				zap.Int64("userid", userID),
 				zap.Error(err),
 			)
 
-			return NewSingleCmdResponse(messages.MsgErrInternal)
+			return NewErrorCmdResponse(messages.MsgErrInternal)
 		}
 	}
 
@@ -381,15 +257,27 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 		if !errors.Is(err, storage.ErrActivityNotFound) {
 			r.logger.Error(
 				"journal rd command DB error for activity",
-				zap.Strings("command", cmdParts),
+				zap.Strings("command", args.raw),
 				zap.Int64("userid", userID),
 				zap.Error(err),
 			)
 
-			return NewSingleCmdResponse(messages.MsgErrInternal)
+			return NewErrorCmdResponse(messages.MsgErrInternal)
 		}
 	}
 
+	meals, err := r.stg.GetUserMeals(ctx, userID)
+	if err != nil {
+		r.logger.Error(
+			"journal rd command DB error for user meals",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
 	lst, err := r.stg.GetJournalReport(ctx, userID, ts, ts)
 	if err != nil {
 		if errors.Is(err, storage.ErrJournalReportEmpty) {
@@ -398,12 +286,12 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 
 		r.logger.Error(
 			"journal rd command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Report table
@@ -413,6 +301,7 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 	})
 
 	var totalCal, totalProt, totalFat, totalCarb float64
+	var totalExt extNutrients
 	var subTotalCal, subTotalProt, subTotalFat, subTotalCarb float64
 	lastMeal := storage.Meal(-1)
 	for i := 0; i < len(lst); i++ {
@@ -423,7 +312,7 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 			tbl.AddRow(
 				html.NewTr(html.Attrs{"class": "table-active"}).
 					AddTd(html.NewTd(
-						html.NewB(j.Meal.ToString(), nil),
+						html.NewB(meals.Name(j.Meal), nil),
 						html.Attrs{"colspan": "6", "align": "center"},
 					)),
 			)
@@ -436,11 +325,14 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 			foodLbl = fmt.Sprintf("%s - %s", foodLbl, j.FoodBrand)
 		}
 		foodLbl = fmt.Sprintf("%s [%s]", foodLbl, j.FoodKey)
+		if j.DayTime != nil {
+			foodLbl = fmt.Sprintf("%s %s", formatDayTime(*j.DayTime), foodLbl)
+		}
 
 		tbl.AddRow(
 			html.NewTr(nil).
 				AddTd(html.NewTd(html.NewS(foodLbl), nil)).
-				AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.1f", j.FoodWeight)), nil)).
+				AddTd(html.NewTd(html.NewS(formatQuantity(j.FoodWeight, j.Portion, j.PortionCount)), nil)).
 				AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", j.Cal)), nil)).
 				AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", j.Prot)), nil)).
 				AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.2f", j.Fat)), nil)).
@@ -450,6 +342,7 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 		totalProt += j.Prot
 		totalFat += j.Fat
 		totalCarb += j.Carb
+		totalExt.add(j.Fiber, j.Sugar, j.SatFat, j.Salt)
 
 		subTotalCal += j.Cal
 		subTotalProt += j.Prot
@@ -539,6 +432,7 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 						pfcSnippet(totalCarb, totalPFC),
 					),
 					html.Attrs{"colspan": "6"})))
+	totalExt.addFooter(tbl, "Всего", false, "6")
 
 	// Doc
 	htmlBuilder.Add(
@@ -560,30 +454,8 @@ func (r *CmdProcessor) journalReportDayCommand(cmdParts []string, userID int64)
 	})
 }
 
-func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
-		r.logger.Error(
-			"invalid journal rw command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	tsStart, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal rw command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	tsStart = getStartOfWeek(tsStart)
+func (r *CmdProcessor) journalReportWeekCommand(args *cmdArgs, userID int64) []CmdResponse {
+	tsStart := getStartOfWeek(args.Date(0))
 	tsStartUnix := tsStart.Unix()
 	tsStartStr := formatTimestamp(tsStart)
 
@@ -595,21 +467,21 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 	tsEndStr := formatTimestamp(tsEnd)
 
 	// Get list from DB, user settings and activities
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*2)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*2)
 	defer cancel()
 
 	var us *storage.UserSettings
-	us, err = r.stg.GetUserSettings(ctx, userID)
+	us, err := r.stg.GetUserSettings(ctx, userID)
 	if err != nil {
 		if !errors.Is(err, storage.ErrUserSettingsNotFound) {
 			r.logger.Error(
 				"journal rw command DB error for user settings",
-				zap.Strings("command", cmdParts),
+				zap.Strings("command", args.raw),
 				zap.Int64("userid", userID),
 				zap.Error(err),
 			)
 
-			return NewSingleCmdResponse(messages.MsgErrInternal)
+			return NewErrorCmdResponse(messages.MsgErrInternal)
 		}
 	}
 
@@ -618,12 +490,12 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 		if !errors.Is(err, storage.ErrActivityEmptyList) {
 			r.logger.Error(
 				"journal rw command DB error for user activities",
-				zap.Strings("command", cmdParts),
+				zap.Strings("command", args.raw),
 				zap.Int64("userid", userID),
 				zap.Error(err),
 			)
 
-			return NewSingleCmdResponse(messages.MsgErrInternal)
+			return NewErrorCmdResponse(messages.MsgErrInternal)
 		}
 	}
 
@@ -635,12 +507,12 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 
 		r.logger.Error(
 			"journal rw command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Get activity map
@@ -658,6 +530,7 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 	})
 
 	var totalCal, totalProt, totalFat, totalCarb float64
+	var totalExt extNutrients
 	dataRange := make([]float64, 7)
 	for _, j := range lst {
 		tbl.AddRow(
@@ -672,6 +545,8 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 		totalProt += j.TotalProt
 		totalFat += j.TotalFat
 		totalCarb += j.TotalCarb
+		totalExt.add(j.TotalFiber, j.TotalSugar, j.TotalSatFat, j.TotalSalt)
+		totalExt.addUnknown(j.FiberUnknown, j.SugarUnknown, j.SatFatUnknown, j.SaltUnknown)
 
 		dataRange[(j.Timestamp.Unix()-tsStartUnix)/24/3600%7] = j.TotalCal
 	}
@@ -714,6 +589,7 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 						pfcSnippet(avgCarb, totalAvgPFC),
 					),
 					html.Attrs{"colspan": "5"})))
+	totalExt.addFooter(tbl, "Среднее", true, "5")
 
 	// Doc
 	htmlBuilder.Add(
@@ -734,57 +610,25 @@ func (r *CmdProcessor) journalReportWeekCommand(cmdParts []string, userID int64)
 	})
 }
 
-func (r *CmdProcessor) journalReportRangeCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid journal rr command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	tsStart, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal rr command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	tsEnd, err := r.parseTimestamp(cmdParts[1])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal rr command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalReportRangeCommand(args *cmdArgs, userID int64) []CmdResponse {
+	tsStart, tsEnd := args.DateRange(0)
 
 	// Get list from DB, user settings and activities
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*2)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*2)
 	defer cancel()
 
 	var us *storage.UserSettings
-	us, err = r.stg.GetUserSettings(ctx, userID)
+	us, err := r.stg.GetUserSettings(ctx, userID)
 	if err != nil {
 		if !errors.Is(err, storage.ErrUserSettingsNotFound) {
 			r.logger.Error(
 				"journal rr command DB error for user settings",
-				zap.Strings("command", cmdParts),
+				zap.Strings("command", args.raw),
 				zap.Int64("userid", userID),
 				zap.Error(err),
 			)
 
-			return NewSingleCmdResponse(messages.MsgErrInternal)
+			return NewErrorCmdResponse(messages.MsgErrInternal)
 		}
 	}
 
@@ -793,12 +637,12 @@ func (r *CmdProcessor) journalReportRangeCommand(cmdParts []string, userID int64
 		if !errors.Is(err, storage.ErrActivityEmptyList) {
 			r.logger.Error(
 				"journal rr command DB error for user activities",
-				zap.Strings("command", cmdParts),
+				zap.Strings("command", args.raw),
 				zap.Int64("userid", userID),
 				zap.Error(err),
 			)
 
-			return NewSingleCmdResponse(messages.MsgErrInternal)
+			return NewErrorCmdResponse(messages.MsgErrInternal)
 		}
 	}
 
@@ -810,12 +654,12 @@ func (r *CmdProcessor) journalReportRangeCommand(cmdParts []string, userID int64
 
 		r.logger.Error(
 			"journal rr command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Get activity map
@@ -876,12 +720,12 @@ func (r *CmdProcessor) journalReportRangeCommand(cmdParts []string, userID int64
 	if err != nil {
 		r.logger.Error(
 			"weight list command chart error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Doc
@@ -909,35 +753,21 @@ func (r *CmdProcessor) journalReportRangeCommand(cmdParts []string, userID int64
 	})
 }
 
-func (r *CmdProcessor) journalTemplateMealCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid journal tm command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	ts, err := r.parseTimestamp(cmdParts[0])
-	if err != nil {
-		r.logger.Error(
-			"invalid journal tm command",
-			zap.String("reason", "ts format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+func (r *CmdProcessor) journalTemplateMealCommand(args *cmdArgs, userID int64) []CmdResponse {
+	ts := args.Date(0)
 
 	// Get list from DB and user settings
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*2)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*2)
 	defer cancel()
 
-	mealStr := cmdParts[1]
-	rep, err := r.stg.GetJournalMealReport(ctx, userID, ts, storage.NewMealFromString(mealStr))
+	meal, resp := r.mealArg(ctx, args, 1, userID)
+	if resp != nil {
+		return resp
+	}
+	// Templates use meal as user entered it, it is resolved the same way.
+	mealStr := args.String(1)
+
+	rep, err := r.stg.GetJournalMealReport(ctx, userID, ts, meal)
 	if err != nil {
 		if errors.Is(err, storage.ErrJournalMealReportEmpty) {
 			return NewSingleCmdResponse(messages.MsgErrEmptyList)
@@ -945,65 +775,93 @@ func (r *CmdProcessor) journalTemplateMealCommand(cmdParts []string, userID int6
 
 		r.logger.Error(
 			"journal tm command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	tsStr := formatTimestamp(ts)
-	resp := make([]CmdResponse, 0)
+	resp = make([]CmdResponse, 0)
 
 	resp = append(resp, NewCmdResponse("<b>Изменение еды</b>", optsHTML))
 	for _, item := range rep.Items {
 		resp = append(resp, NewCmdResponse(
-			fmt.Sprintf("j,set,%s,%s,%s,%.1f", tsStr, mealStr, item.FoodKey, item.FoodWeight),
+			fmt.Sprintf("j,set,%s,%s,%s,%s%s", tsStr, mealStr, item.FoodKey, quantityArg(&item), dayTimeArg(item.DayTime)),
 		))
 	}
 	resp = append(resp, NewCmdResponse("<b>Удаление еды</b>", optsHTML))
 	for _, item := range rep.Items {
 		resp = append(resp, NewCmdResponse(
-			fmt.Sprintf("j,del,%s,%s,%s", tsStr, mealStr, item.FoodKey),
+			fmt.Sprintf("j,del,%s,%s,%s%s", tsStr, mealStr, item.FoodKey, dayTimeArg(item.DayTime)),
 		))
 	}
 
 	return resp
 }
 
-func (r *CmdProcessor) journalFoodAvgWeightCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 1 {
+func (r *CmdProcessor) journalRecalcCommand(args *cmdArgs, userID int64) []CmdResponse {
+	tsStart, tsEnd := args.DateRange(0)
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	cnt, err := r.stg.RecalcJournal(ctx, userID, tsStart, tsEnd)
+	if err != nil {
 		r.logger.Error(
-			"invalid journal fa command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
+			"journal rc command DB error",
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
+			zap.Error(err),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(fmt.Sprintf(messages.MsgJournalRecalc, cnt))
+}
+
+// quantityArg returns journal set command quantity, original portions if set.
+func quantityArg(item *storage.JournalMealItem) string {
+	if item.Portion == "" {
+		return fmt.Sprintf("%.1f", item.FoodWeight)
+	}
+	return strconv.FormatFloat(item.PortionCount, 'f', -1, 64) + item.Portion
+}
+
+// dayTimeArg returns optional time argument for command template.
+func dayTimeArg(d *time.Duration) string {
+	if d == nil {
+		return ""
 	}
+	return "," + formatDayTime(*d)
+}
 
+func (r *CmdProcessor) journalFoodAvgWeightCommand(args *cmdArgs, userID int64) []CmdResponse {
 	tsTo := time.Now()
 	tsFrom := tsTo.AddDate(-1, 0, 0)
 
 	// Get data from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*2)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*2)
 	defer cancel()
 
-	avgW, err := r.stg.GetJournalFoodAvgWeight(ctx, userID, tsFrom, tsTo, cmdParts[0])
+	avgW, err := r.stg.GetJournalFoodAvgWeight(ctx, userID, tsFrom, tsTo, args.String(0))
 	if err != nil {
 		if errors.Is(err, storage.ErrJournalInvalidFood) {
-			return NewSingleCmdResponse(messages.MsgErrFoodNotFound)
+			return NewErrorCmdResponse(messages.MsgErrFoodNotFound)
 		}
 
 		r.logger.Error(
 			"journal fa command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(fmt.Sprintf("Средний вес прима пищи за год: %.1fг.", avgW))
@@ -1054,6 +912,63 @@ func calDiffSnippet2(diff float64) html.IELement {
 	}
 }
 
+// extNutrients accumulates extended nutrients: fiber, sugar, saturated fat
+// and salt. Unknown values are skipped and mark total as partial.
+type extNutrients struct {
+	total   [4]float64
+	cnt     [4]int
+	partial [4]bool
+}
+
+func (r *extNutrients) add(vals ...*float64) {
+	for i, v := range vals {
+		if v != nil {
+			r.total[i] += *v
+			r.cnt[i]++
+		} else {
+			r.partial[i] = true
+		}
+	}
+}
+
+// addUnknown marks totals partial by counts of unknown values.
+func (r *extNutrients) addUnknown(cnts ...int) {
+	for i, c := range cnts {
+		if c > 0 {
+			r.partial[i] = true
+		}
+	}
+}
+
+// addFooter adds footer rows for known nutrients, avg is average by values count.
+// Partial totals are marked with "≥".
+func (r *extNutrients) addFooter(tbl *html.Table, prefix string, avg bool, colspan string) {
+	for i, name := range []string{"клетчатка", "сахар", "насыщ. жиры", "соль"} {
+		if r.cnt[i] == 0 {
+			continue
+		}
+
+		val := r.total[i]
+		if avg {
+			val /= float64(r.cnt[i])
+		}
+
+		valStr := fmt.Sprintf("%.2f", val)
+		if r.partial[i] {
+			valStr = "≥" + valStr
+		}
+
+		tbl.AddFooterElement(
+			html.NewTr(nil).
+				AddTd(html.NewTd(
+					html.NewSpan(
+						html.NewB(fmt.Sprintf("%s, %s, г: ", prefix, name), nil),
+						html.NewS(valStr),
+					),
+					html.Attrs{"colspan": colspan})))
+	}
+}
+
 func pfcSnippet(val, totalVal float64) html.IELement {
 	var s string
 
@@ -5,47 +5,22 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/common/weblogin"
 	"github.com/devldavydov/myfood/internal/storage"
 	"go.uber.org/zap"
 	tele "gopkg.in/telebot.v3"
 )
 
-func (r *CmdProcessor) processMaintenance(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) == 0 {
-		r.logger.Error(
-			"invalid maintenance command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "backup":
-		resp = r.backupCommand(userID)
-	default:
-		r.logger.Error(
-			"invalid maintenance command",
-			zap.String("reason", "unknown command"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	return resp
-}
-
-func (r *CmdProcessor) backupCommand(userID int64) []CmdResponse {
+func (r *CmdProcessor) backupCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get backup from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout*10)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*10)
 	defer cancel()
 
 	backup, err := r.stg.Backup(ctx)
@@ -56,7 +31,7 @@ func (r *CmdProcessor) backupCommand(userID int64) []CmdResponse {
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	// Generate response.
@@ -68,7 +43,7 @@ func (r *CmdProcessor) backupCommand(userID int64) []CmdResponse {
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	if err := zw.Close(); err != nil {
@@ -77,7 +52,7 @@ func (r *CmdProcessor) backupCommand(userID int64) []CmdResponse {
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(&tele.Document{
@@ -86,3 +61,101 @@ func (r *CmdProcessor) backupCommand(userID int64) []CmdResponse {
 		FileName: fmt.Sprintf("backup_%s.json.gz", formatTimestamp(time.Now().In(r.tz))),
 	})
 }
+
+func (r *CmdProcessor) restoreCommand(args *cmdArgs, userID int64) []CmdResponse {
+	c := args.c
+
+	// Parse mode, empty means replace
+	mode := storage.RestoreModeReplace
+	if args.String(0) == "merge" {
+		mode = storage.RestoreModeMerge
+	}
+
+	// Get backup file
+	doc := c.Message().Document
+	if doc == nil {
+		return NewErrorCmdResponse(messages.MsgErrRestoreNoFile)
+	}
+
+	rd, err := c.Bot().File(&doc.File)
+	if err != nil {
+		r.logger.Error(
+			"restore command file download error",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+	defer rd.Close()
+
+	zr, err := gzip.NewReader(rd)
+	if err != nil {
+		r.logger.Error(
+			"restore command gzip error",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+		return NewErrorCmdResponse(messages.MsgErrRestoreFormat)
+	}
+	defer zr.Close()
+
+	backup := &storage.Backup{}
+	if err := json.NewDecoder(zr).Decode(backup); err != nil {
+		r.logger.Error(
+			"restore command json error",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+		return NewErrorCmdResponse(messages.MsgErrRestoreFormat)
+	}
+
+	// Restore in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout*10)
+	defer cancel()
+
+	if err := r.stg.Restore(ctx, backup, mode); err != nil {
+		if errors.Is(err, storage.ErrBackupInvalid) ||
+			errors.Is(err, storage.ErrJournalInvalidFood) {
+			return NewErrorCmdResponse(messages.MsgErrRestoreFormat)
+		}
+		if errors.Is(err, storage.ErrUserMealNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrRestoreMeal)
+		}
+
+		r.logger.Error(
+			"restore command DB error",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) webLoginCommand(args *cmdArgs, userID int64) []CmdResponse {
+	c := args.c
+
+	if r.webURL == "" {
+		return NewErrorCmdResponse(messages.MsgErrWebLoginNoURL)
+	}
+
+	token, err := weblogin.NewToken(c.Bot().Token, userID)
+	if err != nil {
+		r.logger.Error(
+			"weblogin token err",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	link := fmt.Sprintf("%s/api/auth/weblogin?token=%s",
+		strings.TrimRight(r.webURL, "/"),
+		url.QueryEscape(token))
+
+	return NewSingleCmdResponse(
+		fmt.Sprintf(messages.MsgWebLogin, int(weblogin.TokenTTL.Minutes()), link),
+		&tele.SendOptions{DisableWebPagePreview: true})
+}
@@ -0,0 +1,205 @@
+package cmdproc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devldavydov/myfood/internal/common/html"
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/storage"
+	"go.uber.org/zap"
+	tele "gopkg.in/telebot.v3"
+)
+
+func (r *CmdProcessor) recipeSetCommand(args *cmdArgs, userID int64) []CmdResponse {
+	rcp := &storage.Recipe{
+		Key:          args.String(0),
+		Name:         args.String(1),
+		Ingredients:  make(map[string]float64),
+		CookedWeight: args.Float(2),
+		// Tare is optional.
+		Tare: args.Float(3),
+	}
+
+	for j, item := range args.KeyQuantities(4) {
+		if !item.hasQuantity || item.portion != "" {
+			return args.BadItem(4, j, "ключ еды:вес в граммах, например яйцо:55")
+		}
+		if item.key == "" || item.val <= 0 {
+			return args.BadItem(4, j, "ключ еды и вес больше 0")
+		}
+
+		rcp.Ingredients[item.key] += item.val
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetRecipe(ctx, userID, rcp); err != nil {
+		if errors.Is(err, storage.ErrRecipeInvalid) {
+			return args.Invalid(
+				argCheck{0, rcp.Key != "", _expectNonEmpty},
+				argCheck{1, rcp.Name != "", _expectNonEmpty},
+				argCheck{3, rcp.Tare >= 0, _expectNonNegative},
+				argCheck{2, rcp.CookedWeight > rcp.Tare, "вес больше веса тары"},
+			)
+		}
+		if errors.Is(err, storage.ErrRecipeIngredientNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrRecipeIngredientNotFound)
+		}
+		if errors.Is(err, storage.ErrRecipeIngredientRecursive) {
+			return NewErrorCmdResponse(messages.MsgErrRecipeIngredientRecursive)
+		}
+		if errors.Is(err, storage.ErrFoodExists) {
+			return NewErrorCmdResponse(messages.MsgErrFoodExists)
+		}
+
+		r.logger.Error(
+			"recipe set command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) recipeSetTemplateCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Get in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	rcp, err := r.stg.GetRecipe(ctx, userID, args.String(0))
+	if err != nil {
+		if errors.Is(err, storage.ErrRecipeNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrRecipeNotFound)
+		}
+
+		r.logger.Error(
+			"recipe set template command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("r,set,%s,%s,%s,", rcp.Key, rcp.Name, formatRecipeWeight(rcp.CookedWeight)))
+	if rcp.Tare > 0 {
+		sb.WriteString(formatRecipeWeight(rcp.Tare))
+	}
+	for _, k := range recipeIngredientKeys(rcp) {
+		sb.WriteString(fmt.Sprintf(",%s:%s", k, formatRecipeWeight(rcp.Ingredients[k])))
+	}
+
+	return NewSingleCmdResponse(sb.String())
+}
+
+func (r *CmdProcessor) recipeListCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Get in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	lst, err := r.stg.GetRecipeList(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRecipeEmptyList) {
+			return NewSingleCmdResponse(messages.MsgErrEmptyList)
+		}
+
+		r.logger.Error(
+			"recipe list command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	// Build html
+	htmlBuilder := html.NewBuilder("Список рецептов")
+
+	// Table
+	tbl := html.NewTable([]string{
+		"Ключ", "Наименование", "Вес готового, г.", "Тара, г.", "Ингредиент", "Вес, г.",
+	})
+
+	for _, rcp := range lst {
+		rowSpan := html.Attrs{"rowspan": strconv.Itoa(len(rcp.Ingredients))}
+		for i, k := range recipeIngredientKeys(&rcp) {
+			tr := html.NewTr(nil)
+			if i == 0 {
+				tr.
+					AddTd(html.NewTd(html.NewS(rcp.Key), rowSpan)).
+					AddTd(html.NewTd(html.NewS(rcp.Name), rowSpan)).
+					AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.1f", rcp.CookedWeight)), rowSpan)).
+					AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.1f", rcp.Tare)), rowSpan))
+			}
+			tr.
+				AddTd(html.NewTd(html.NewS(k), nil)).
+				AddTd(html.NewTd(html.NewS(fmt.Sprintf("%.1f", rcp.Ingredients[k])), nil))
+			tbl.AddRow(tr)
+		}
+	}
+
+	// Doc
+	htmlBuilder.Add(
+		html.NewContainer().Add(
+			html.NewH(
+				"Список рецептов",
+				5,
+				html.Attrs{"align": "center"},
+			),
+			tbl))
+
+	// Response
+	return NewSingleCmdResponse(&tele.Document{
+		File:     tele.FromReader(bytes.NewBufferString(htmlBuilder.Build())),
+		MIME:     "text/html",
+		FileName: "recipes.html",
+	})
+}
+
+func (r *CmdProcessor) recipeDelCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Delete from DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.DeleteRecipe(ctx, userID, args.String(0)); err != nil {
+		r.logger.Error(
+			"recipe del command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func recipeIngredientKeys(rcp *storage.Recipe) []string {
+	keys := make([]string, 0, len(rcp.Ingredients))
+	for k := range rcp.Ingredients {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func formatRecipeWeight(w float64) string {
+	return strconv.FormatFloat(w, 'f', -1, 64)
+}
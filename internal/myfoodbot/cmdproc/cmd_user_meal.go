@@ -0,0 +1,141 @@
+package cmdproc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/storage"
+	"go.uber.org/zap"
+)
+
+func (r *CmdProcessor) userMealSetCommand(args *cmdArgs, userID int64) []CmdResponse {
+	um := &storage.UserMeal{
+		Meal:    storage.Meal(args.Int(0)),
+		Name:    args.String(1),
+		Aliases: args.Strings(2),
+	}
+
+	for j, alias := range um.Aliases {
+		if alias == "" {
+			return args.BadItem(2, j, _expectNonEmpty)
+		}
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetUserMeal(ctx, userID, um); err != nil {
+		if errors.Is(err, storage.ErrUserMealInvalid) {
+			return args.Invalid(
+				argCheck{0, um.Meal >= 0, _expectNonNegative},
+				argCheck{1, um.Name != "", _expectNonEmpty},
+			)
+		}
+
+		if errors.Is(err, storage.ErrUserMealConflict) {
+			return NewErrorCmdResponse(messages.MsgErrUserMealConflict)
+		}
+
+		r.logger.Error(
+			"user meal set command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) userMealDelCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Delete from DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	meal, resp := r.mealArg(ctx, args, 0, userID)
+	if resp != nil {
+		return resp
+	}
+
+	if err := r.stg.DeleteUserMeal(ctx, userID, meal); err != nil {
+		if errors.Is(err, storage.ErrUserMealNotFound) {
+			return NewErrorCmdResponse(messages.MsgErrUserMealNotFound)
+		}
+
+		if errors.Is(err, storage.ErrUserMealIsUsed) {
+			return NewErrorCmdResponse(messages.MsgErrUserMealIsUsed)
+		}
+
+		if errors.Is(err, storage.ErrUserMealIsLast) {
+			return NewErrorCmdResponse(messages.MsgErrUserMealIsLast)
+		}
+
+		r.logger.Error(
+			"user meal del command DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	return NewSingleCmdResponse(messages.MsgOK)
+}
+
+func (r *CmdProcessor) userMealListCommand(args *cmdArgs, userID int64) []CmdResponse {
+	// Get from DB
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
+	defer cancel()
+
+	meals, err := r.stg.GetUserMeals(ctx, userID)
+	if err != nil {
+		r.logger.Error(
+			"user meal list command DB error",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	var sb strings.Builder
+	for _, m := range meals {
+		sb.WriteString(fmt.Sprintf("<b>%d:</b> %s", m.Meal, m.Name))
+		if len(m.Aliases) != 0 {
+			sb.WriteString(fmt.Sprintf(" (%s)", strings.Join(m.Aliases, ", ")))
+		}
+		sb.WriteString("\n")
+	}
+
+	return NewSingleCmdResponse(sb.String(), optsHTML)
+}
+
+// mealArg resolves i-th argument to user meal by name or alias, on error
+// returns response for user.
+func (r *CmdProcessor) mealArg(ctx context.Context, args *cmdArgs, i int, userID int64) (storage.Meal, []CmdResponse) {
+	meals, err := r.stg.GetUserMeals(ctx, userID)
+	if err != nil {
+		r.logger.Error(
+			"user meals DB error",
+			zap.Strings("command", args.raw),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+
+		return 0, NewErrorCmdResponse(messages.MsgErrInternal)
+	}
+
+	meal, ok := meals.Resolve(args.String(i))
+	if !ok {
+		return 0, NewErrorCmdResponse(messages.MsgErrUserMealNotFound)
+	}
+
+	return meal, nil
+}
@@ -4,84 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/devldavydov/myfood/internal/common/messages"
 	"github.com/devldavydov/myfood/internal/storage"
 	"go.uber.org/zap"
 )
 
-func (r *CmdProcessor) processUserSettings(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) == 0 {
-		r.logger.Error(
-			"invalid user settings command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "set":
-		resp = r.userSettingsSetCommand(cmdParts[1:], userID)
-	case "get":
-		resp = r.userSettingsGetCommand(userID)
-	case "st":
-		resp = r.userSettingsSetTemplateCommand(userID)
-	default:
-		r.logger.Error(
-			"invalid user settings command",
-			zap.String("reason", "unknown command"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	return resp
-}
-
-func (r *CmdProcessor) userSettingsSetCommand(cmdParts []string, userID int64) []CmdResponse {
-	if len(cmdParts) != 2 {
-		r.logger.Error(
-			"invalid user settings set command",
-			zap.String("reason", "len parts"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
+func (r *CmdProcessor) userSettingsSetCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// parse
-	calLimit, err := strconv.ParseFloat(cmdParts[0], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid user settings set command",
-			zap.String("reason", "cal limit format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
-
-	defaultActiveCal, err := strconv.ParseFloat(cmdParts[1], 64)
-	if err != nil {
-		r.logger.Error(
-			"invalid user settings set command",
-			zap.String("reason", "default active cal format"),
-			zap.Strings("command", cmdParts),
-			zap.Int64("userid", userID),
-			zap.Error(err),
-		)
-		return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
-	}
+	calLimit := args.Float(0)
+	defaultActiveCal := args.Float(1)
 
 	// Save in DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	if err := r.stg.SetUserSettings(
@@ -92,31 +27,34 @@ func (r *CmdProcessor) userSettingsSetCommand(cmdParts []string, userID int64) [
 			DefaultActiveCal: defaultActiveCal,
 		}); err != nil {
 		if errors.Is(err, storage.ErrUserSettingsInvalid) {
-			return NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+			return args.Invalid(
+				argCheck{0, calLimit > 0, _expectPositive},
+				argCheck{1, defaultActiveCal > 0, _expectPositive},
+			)
 		}
 
 		r.logger.Error(
 			"user settings set command DB error",
-			zap.Strings("command", cmdParts),
+			zap.Strings("command", args.raw),
 			zap.Int64("userid", userID),
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(messages.MsgOK)
 }
 
-func (r *CmdProcessor) userSettingsGetCommand(userID int64) []CmdResponse {
+func (r *CmdProcessor) userSettingsGetCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	stgs, err := r.stg.GetUserSettings(ctx, userID)
 	if err != nil {
 		if errors.Is(err, storage.ErrUserSettingsNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrUserSettingsNotFound)
+			return NewErrorCmdResponse(messages.MsgErrUserSettingsNotFound)
 		}
 
 		r.logger.Error(
@@ -125,21 +63,21 @@ func (r *CmdProcessor) userSettingsGetCommand(userID int64) []CmdResponse {
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	return NewSingleCmdResponse(fmt.Sprintf("УБМ: %.2f\nАктивные ккал по-умолчанию: %.2f", stgs.CalLimit, stgs.DefaultActiveCal))
 }
 
-func (r *CmdProcessor) userSettingsSetTemplateCommand(userID int64) []CmdResponse {
+func (r *CmdProcessor) userSettingsSetTemplateCommand(args *cmdArgs, userID int64) []CmdResponse {
 	// Get from DB
-	ctx, cancel := context.WithTimeout(context.Background(), storage.StorageOperationTimeout)
+	ctx, cancel := context.WithTimeout(args.ctx, storage.StorageOperationTimeout)
 	defer cancel()
 
 	stgs, err := r.stg.GetUserSettings(ctx, userID)
 	if err != nil {
 		if errors.Is(err, storage.ErrUserSettingsNotFound) {
-			return NewSingleCmdResponse(messages.MsgErrUserSettingsNotFound)
+			return NewErrorCmdResponse(messages.MsgErrUserSettingsNotFound)
 		}
 
 		r.logger.Error(
@@ -148,7 +86,7 @@ func (r *CmdProcessor) userSettingsSetTemplateCommand(userID int64) []CmdRespons
 			zap.Error(err),
 		)
 
-		return NewSingleCmdResponse(messages.MsgErrInternal)
+		return NewErrorCmdResponse(messages.MsgErrInternal)
 	}
 
 	usSetTemplate := fmt.Sprintf(
@@ -1,6 +1,7 @@
 package cmdproc
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,21 +14,40 @@ import (
 type CmdProcessor struct {
 	stg       storage.Storage
 	tz        *time.Location
+	webURL    string
 	logger    *zap.Logger
 	debugMode bool
+	cmds      []*cmdSpec
+	// batch is true for commands of multi-line message.
+	batch bool
 }
 
-func NewCmdProcessor(stg storage.Storage, tz *time.Location, debugMode bool, logger *zap.Logger) *CmdProcessor {
-	return &CmdProcessor{stg: stg, tz: tz, debugMode: debugMode, logger: logger}
+func NewCmdProcessor(
+	stg storage.Storage,
+	tz *time.Location,
+	webURL string,
+	debugMode bool,
+	logger *zap.Logger) *CmdProcessor {
+	return &CmdProcessor{
+		stg:       stg,
+		tz:        tz,
+		webURL:    webURL,
+		debugMode: debugMode,
+		logger:    logger,
+		cmds:      newCommands(),
+	}
 }
 
+// Process runs command, message of several lines is batch of commands.
 func (r *CmdProcessor) Process(c tele.Context, cmd string, userID int64) error {
-	cmdParts := []string{}
-	for _, part := range strings.Split(cmd, ",") {
-		cmdParts = append(cmdParts, strings.Trim(part, " "))
+	lines := []string{}
+	for _, line := range strings.Split(cmd, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
 	}
 
-	if len(cmdParts) == 0 {
+	if len(lines) == 0 {
 		r.logger.Error(
 			"invalid command",
 			zap.String("reason", "empty command"),
@@ -38,34 +58,10 @@ func (r *CmdProcessor) Process(c tele.Context, cmd string, userID int64) error {
 	}
 
 	var resp []CmdResponse
-
-	switch cmdParts[0] {
-	case "h":
-		resp = r.helpCommand(c)
-	case "w":
-		resp = r.processWeight(cmdParts[1:], userID)
-	case "f":
-		resp = r.processFood(cmdParts[1:], userID)
-	case "j":
-		resp = r.processJournal(cmdParts[1:], userID)
-	case "b":
-		resp = r.processBundle(cmdParts[1:], userID)
-	case "cc":
-		resp = r.calcCalCommand(cmdParts[1:])
-	case "us":
-		resp = r.processUserSettings(cmdParts[1:], userID)
-	case "a":
-		resp = r.processActivity(cmdParts[1:], userID)
-	case "m":
-		resp = r.processMaintenance(cmdParts[1:], userID)
-	default:
-		r.logger.Error(
-			"invalid command",
-			zap.String("reason", "unknown command"),
-			zap.String("command", cmd),
-			zap.Int64("userid", userID),
-		)
-		resp = NewSingleCmdResponse(messages.MsgErrInvalidCommand)
+	if len(lines) == 1 {
+		resp = r.dispatch(context.Background(), c, splitCmd(lines[0]), userID)
+	} else {
+		resp = r.processBatch(c, lines, userID)
 	}
 
 	if r.debugMode {
@@ -92,6 +88,8 @@ func (r *CmdProcessor) Stop() {
 type CmdResponse struct {
 	what any
 	opts []any
+	// err marks failed command.
+	err bool
 }
 
 func NewCmdResponse(what any, opts ...any) CmdResponse {
@@ -103,3 +101,10 @@ func NewSingleCmdResponse(what any, opts ...any) []CmdResponse {
 		{what: what, opts: opts},
 	}
 }
+
+// NewErrorCmdResponse returns response of failed command.
+func NewErrorCmdResponse(what any, opts ...any) []CmdResponse {
+	return []CmdResponse{
+		{what: what, opts: opts, err: true},
+	}
+}
@@ -0,0 +1,658 @@
+package cmdproc
+
+import "github.com/devldavydov/myfood/internal/storage"
+
+const (
+	_noteDate     = "Дата может быть задана в любом формате из общего описания, если дата пустая, то подразумевается текущая дата"
+	_noteMeal     = "Прием пищи - наименование или псевдоним из списка приемов пищи (команда <code>ml,list</code>), если прием пищи не найден, то будет ошибка"
+	_noteFoodKey  = "Ключ еды - значение ключа из списка еды"
+	_noteQuantity = "Вместо веса можно указать количество порций еды (команда <code>f,ps</code>), например <code>2шт</code> или <code>1ст.л</code>"
+	_noteExpr     = "Вес или количество порций можно указать выражением с операциями + - * / и скобками, например <code>350-120</code> или <code>30+25+40</code>"
+	_noteSuggest  = "Если ключ не найден, то бот предложит похожие ключи кнопками, нажатие на кнопку повторяет команду с выбранным ключом"
+	_noteRange    = "Период задается двумя датами или одним аргументом: <code>01.02-07.02</code>, <code>-7--1</code> или одной датой. Одна дата <code>-N</code> - последние N дней по сегодня, например <code>-7</code>. Начало периода не может быть позже конца"
+)
+
+var (
+	_argDate      = argSpec{name: "Дата", typ: argDate}
+	_argDateRange = argSpec{name: "Период", typ: argDateRange}
+	_argMeal      = argSpec{name: "Прием пищи", typ: argString}
+	_argFoodKey   = argSpec{name: "Ключ еды", typ: argString}
+	_argBundleKey = argSpec{name: "Ключ бандла", typ: argString}
+	_argTime      = argSpec{name: "Время HH:MM", typ: argTime, optional: true}
+)
+
+// foodSetArgs are arguments of f,set and f,setp commands.
+var foodSetArgs = []argSpec{
+	{name: "Ключ", typ: argString},
+	{name: "Наименование", typ: argString},
+	{name: "Бренд", typ: argString},
+	{name: "ККал100", typ: argFloat},
+	{name: "Бел100", typ: argFloat},
+	{name: "Жир100", typ: argFloat},
+	{name: "Угл100", typ: argFloat},
+	{name: "Комментарий", typ: argString},
+	{name: "Клетч100", typ: argFloat, optional: true},
+	{name: "Сахар100", typ: argFloat, optional: true},
+	{name: "НасЖир100", typ: argFloat, optional: true},
+	{name: "Соль100", typ: argFloat, optional: true},
+}
+
+// newCommands returns registry of all bot commands.
+func newCommands() []*cmdSpec {
+	return []*cmdSpec{
+		{
+			name:    "h",
+			aliases: []string{"help"},
+			title:   "Помощь",
+			args: []argSpec{
+				{name: "Команда", typ: argString, optional: true},
+				{name: "Подкоманда", typ: argString, optional: true},
+			},
+			notes: []string{
+				"Без аргументов бот присылает полное руководство, с командой - справку по команде, например <code>h,j</code> или <code>h,j,set</code>",
+			},
+			handler: (*CmdProcessor).helpCommand,
+		},
+		{
+			name:    "cc",
+			aliases: []string{"calc"},
+			title:   "Расчет нормы калорий",
+			args: []argSpec{
+				{name: "Пол", typ: argChoice, choices: []string{"m", "f"}},
+				{name: "Вес (кг.)", typ: argFloat},
+				{name: "Рост (см.)", typ: argFloat},
+				{name: "Возраст (лет)", typ: argFloat},
+			},
+			notes: []string{
+				"Значения веса, роста, возраста &gt; 0",
+				"Рассчитывается Уровень Базального Метаболизма (УБМ) - то количество ккал, которые тратит организм в покое для обеспечения жизнедеятельности",
+				"Также рассчитываются усредненные ккал по активностям:",
+				"<b>Сидячая: </b>если нет физических нагрузок и сидячая работа",
+				"<b>Легкая: </b>если есть небольшие пробежки или легкая гимнастика 1–3 раза в неделю",
+				"<b>Средняя: </b>если есть занятия спортом со средними нагрузками 3–5 раз в неделю",
+				"<b>Полноценная: </b>если есть тренировки 6–7 раз в неделю",
+				"<b>Супер: </b>если работа связана с физическим трудом, или есть тренировки 2 раза в день с силовыми упражнениями",
+			},
+			handler: (*CmdProcessor).calcCalCommand,
+		},
+		{
+			name:    "us",
+			aliases: []string{"settings"},
+			title:   "Пользовательские настройки",
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка настроек",
+					args: []argSpec{
+						{name: "УБМ", typ: argFloat},
+						{name: "Активные ккал по-умолчанию", typ: argFloat},
+					},
+					notes: []string{
+						"Значение УБМ, Активных ккал по-умолчанию &gt; 0. Берутся из команды расчета нормы ккал <code>cc</code>",
+						"Активные ккал по умолчанию будут использоваться для расчета дефицита/профицита ккал за день, если за этот день не заданы фактические активные калории (команда <code>a</code>)",
+					},
+					handler: (*CmdProcessor).userSettingsSetCommand,
+				},
+				{
+					name:    "get",
+					title:   "Получение настроек",
+					handler: (*CmdProcessor).userSettingsGetCommand,
+				},
+				{
+					name:    "st",
+					title:   "Шаблон установки настроек",
+					handler: (*CmdProcessor).userSettingsSetTemplateCommand,
+				},
+			},
+		},
+		{
+			name:    "ml",
+			aliases: []string{"meal"},
+			title:   "Приемы пищи",
+			notes: []string{
+				"По умолчанию используются приемы пищи: Завтрак, До обеда, Обед, Полдник, До ужина, Ужин, Перекус",
+			},
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка приема пищи",
+					args: []argSpec{
+						{name: "Номер", typ: argInt},
+						{name: "Наименование", typ: argString},
+						{name: "Псевдоним", typ: argString, optional: true, variadic: true},
+					},
+					notes: []string{
+						"Номер - целое число &gt;= 0, задает порядок приемов пищи в отчетах",
+						"Псевдонимы - необязательные короткие имена приема пищи",
+						"Наименования и псевдонимы не зависят от регистра и не должны совпадать с другими приемами пищи",
+					},
+					handler: (*CmdProcessor).userMealSetCommand,
+				},
+				{
+					name:    "del",
+					title:   "Удаление приема пищи",
+					args:    []argSpec{_argMeal},
+					notes:   []string{"Нельзя удалить прием пищи, который используется в журнале"},
+					handler: (*CmdProcessor).userMealDelCommand,
+				},
+				{
+					name:    "list",
+					title:   "Список приемов пищи",
+					handler: (*CmdProcessor).userMealListCommand,
+				},
+			},
+		},
+		{
+			name:    "w",
+			aliases: []string{"weight"},
+			title:   "Управление весом",
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка веса",
+					args: []argSpec{
+						_argDate,
+						{name: "Значение", typ: argFloat},
+					},
+					notes:   []string{"Значение веса &gt; 0", _noteDate},
+					handler: (*CmdProcessor).weightSetCommand,
+				},
+				{
+					name:    "del",
+					title:   "Удаление веса",
+					args:    []argSpec{_argDate},
+					notes:   []string{_noteDate},
+					handler: (*CmdProcessor).weightDelCommand,
+				},
+				{
+					name:    "list",
+					title:   "Вес за период",
+					args:    []argSpec{_argDateRange},
+					notes:   []string{_noteRange},
+					handler: (*CmdProcessor).weightListCommand,
+				},
+			},
+		},
+		{
+			name:    "a",
+			aliases: []string{"activity"},
+			title:   "Активность",
+			notes: []string{
+				"Фактические активные ккал за день используются в отчетах вместо активных ккал по-умолчанию из пользовательских настроек",
+			},
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка активных ккал",
+					args: []argSpec{
+						_argDate,
+						{name: "Активные ккал", typ: argFloat},
+					},
+					notes:   []string{_noteDate},
+					handler: (*CmdProcessor).activitySetCommand,
+				},
+				{
+					name:    "del",
+					title:   "Удаление активных ккал",
+					args:    []argSpec{_argDate},
+					notes:   []string{_noteDate},
+					handler: (*CmdProcessor).activityDelCommand,
+				},
+				{
+					name:    "list",
+					title:   "Активные ккал за период",
+					args:    []argSpec{_argDateRange},
+					notes:   []string{_noteRange},
+					handler: (*CmdProcessor).activityListCommand,
+				},
+			},
+		},
+		{
+			name:    "f",
+			aliases: []string{"food"},
+			title:   "Управление едой",
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка еды",
+					args:  foodSetArgs,
+					notes: []string{
+						"Ключ - уникальная строка для данной записи",
+						"Наименование - название еды",
+						"Бренд - производитель еды (необязательное поле)",
+						"ККал100 - значение ккал в 100г.",
+						"Бел100 - значение белков в 100г.",
+						"Жир100 - значение жиров в 100г.",
+						"Угл100 - значение углеводов в 100г.",
+						"Комментарий (необязательное поле)",
+						"Клетч100, Сахар100, НасЖир100, Соль100 - значения клетчатки, сахаров, насыщенных жиров и соли в 100г. (необязательные поля, пустое значение - неизвестно). Соль = натрий × 2.5",
+						"Известные значения суммируются в отчетах журнала",
+					},
+					handler: func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse {
+						return r.foodSetCommand(args, userID, false)
+					},
+				},
+				{
+					name:  "setp",
+					title: "Установка личной еды",
+					args:  foodSetArgs,
+					notes: []string{
+						"Параметры аналогичны команде <code>f,set</code>",
+						"Личная еда видна только вам и заменяет общую еду с тем же ключом в журнале, бандлах и поиске",
+					},
+					handler: func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse {
+						return r.foodSetCommand(args, userID, true)
+					},
+				},
+				{
+					name:  "sc",
+					title: "Установка комментария для еды",
+					args: []argSpec{
+						{name: "Ключ", typ: argString},
+						{name: "Комментарий", typ: argString},
+					},
+					handler: (*CmdProcessor).foodSetCommentCommand,
+				},
+				{
+					name:  "ps",
+					title: "Установка порций еды",
+					args: []argSpec{
+						{name: "Ключ", typ: argString},
+						{name: "Порция:вес гр.", typ: argKeyQuantity, optional: true, variadic: true},
+					},
+					notes: []string{
+						"Порция - наименование единицы еды (шт, ст.л, чашка), вес - вес одной порции в граммах. Список порций заменяется целиком, без порций - порции удаляются",
+						"Порции можно использовать вместо веса в журнале и бандлах, например <code>2шт</code> или <code>1.5ст.л</code>",
+					},
+					handler: (*CmdProcessor).foodSetPortionsCommand,
+				},
+				{
+					name:    "st",
+					title:   "Шаблон для установки параметров еды",
+					args:    []argSpec{{name: "Ключ", typ: argString}},
+					handler: (*CmdProcessor).foodSetTemplateCommand,
+				},
+				{
+					name:  "find",
+					title: "Поиск еды",
+					args: []argSpec{
+						{name: "Шаблон", typ: argString},
+						{name: "Лимит", typ: argInt, optional: true},
+						{name: "Смещение", typ: argInt, optional: true},
+					},
+					notes: []string{
+						"Осуществляется поиск записей еды по словам шаблона в полях Ключ, Наименование, Бренд и Комментарий, должны совпасть все слова",
+						"Слова ищутся по началу слов, результаты упорядочены по релевантности: совпадения в ключе и наименовании выше. Если ничего не найдено, то слова ищутся как подстроки",
+						"Лимит - количество записей, по умолчанию 10. Смещение - сколько первых записей пропустить, по умолчанию 0",
+						"Если найдено больше записей, то выводится команда для следующей страницы",
+					},
+					handler: (*CmdProcessor).foodFindCommand,
+				},
+				{
+					name:  "calc",
+					title: "Расчет энергетической ценности еды",
+					args: []argSpec{
+						{name: "Ключ", typ: argString},
+						{name: "Вес еды в гр.", typ: argExpr},
+					},
+					notes:   []string{"Вес можно указать выражением, например <code>350-120</code>"},
+					handler: (*CmdProcessor).foodCalcCommand,
+				},
+				{
+					name:    "list",
+					title:   "Весь список еды",
+					handler: (*CmdProcessor).foodListCommand,
+				},
+				{
+					name:  "del",
+					title: "Удаление еды",
+					args:  []argSpec{{name: "Ключ", typ: argString}},
+					notes: []string{
+						"Нельзя удалить еду, которая уже используется в журнале приема пищи, бандле или рецепте",
+						"Если есть личная еда с таким ключом, удаляется она",
+					},
+					handler: (*CmdProcessor).foodDelCommand,
+				},
+				{
+					name:  "mv",
+					title: "Переименование ключа еды",
+					args: []argSpec{
+						{name: "Старый ключ", typ: argString},
+						{name: "Новый ключ", typ: argString},
+					},
+					notes: []string{
+						"Записи журнала приема пищи сохраняются, ключ еды обновляется во всех бандлах и рецептах",
+						"Новый ключ не должен использоваться другой едой",
+					},
+					handler: (*CmdProcessor).foodRenameCommand,
+				},
+				{
+					name:  "merge",
+					title: "Объединение дубликатов еды",
+					args: []argSpec{
+						{name: "Ключ дубликата", typ: argString},
+						{name: "Ключ основной еды", typ: argString},
+					},
+					notes: []string{
+						"Записи журнала приема пищи, бандлы и рецепты переводятся на основную еду, после чего дубликат удаляется",
+						"Если обе еды есть в одном приеме пищи, то вес суммируется, ККал и БЖУ на 100 гр. усредняются по весу",
+						"Общую еду нельзя объединить с личной",
+					},
+					handler: (*CmdProcessor).foodMergeCommand,
+				},
+			},
+		},
+		{
+			name:    "b",
+			aliases: []string{"bundle"},
+			title:   "Бандлы",
+			notes: []string{
+				"Бандл - это группировка еды и других бандлов для быстрого добавления записей в журнал еды",
+			},
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка бандла",
+					args: []argSpec{
+						_argBundleKey,
+						{name: "Ключ еды:вес или ключ дочернего бандла", typ: argKeyQuantity, variadic: true},
+					},
+					notes: []string{
+						"В бандл можно добавлять как еду так и другие бандлы, кроме самого себя и бандлов, которые от него зависят",
+						"В случае если указывается ключ еды, то вес &gt; 0",
+						"Вместо веса можно указать количество порций еды, например <code>яйцо:2шт</code>, оно переводится в граммы при установке",
+						"Вес можно указать выражением, например <code>сыр:30+25</code>",
+					},
+					handler: (*CmdProcessor).bundleSetCommand,
+				},
+				{
+					name:    "st",
+					title:   "Шаблон для установки параметров бандла",
+					args:    []argSpec{_argBundleKey},
+					handler: (*CmdProcessor).bundleSetTemplateCommand,
+				},
+				{
+					name:    "list",
+					title:   "Список бандлов",
+					handler: (*CmdProcessor).bundleListCommand,
+				},
+				{
+					name:    "del",
+					title:   "Удаление бандла",
+					args:    []argSpec{_argBundleKey},
+					notes:   []string{"Нельзя удалить бандл, который является дочерним для другого бандла"},
+					handler: (*CmdProcessor).bundleDelCommand,
+				},
+				{
+					name:  "mv",
+					title: "Переименование ключа бандла",
+					args: []argSpec{
+						{name: "Старый ключ", typ: argString},
+						{name: "Новый ключ", typ: argString},
+					},
+					notes:   []string{"Ключ бандла обновляется во всех родительских бандлах"},
+					handler: (*CmdProcessor).bundleRenameCommand,
+				},
+				{
+					name:    "tree",
+					title:   "Дерево бандла",
+					args:    []argSpec{_argBundleKey},
+					notes:   []string{"Выводит иерархию бандла с весом, ККал и БЖУ каждой еды и итогами по каждому бандлу"},
+					handler: (*CmdProcessor).bundleTreeCommand,
+				},
+			},
+		},
+		{
+			name:    "r",
+			aliases: []string{"recipe"},
+			title:   "Рецепты",
+			notes: []string{
+				"Рецепт - это блюдо, приготовленное из сырых ингредиентов. По рецепту создается личная еда с тем же ключом, ККал и БЖУ которой рассчитываются на 100 г. готового блюда",
+			},
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка рецепта",
+					args: []argSpec{
+						{name: "Ключ", typ: argString},
+						{name: "Наименование", typ: argString},
+						{name: "Вес готового блюда", typ: argExpr},
+						{name: "Вес тары", typ: argExpr, optional: true},
+						{name: "Ключ еды:вес", typ: argKeyQuantity, variadic: true},
+					},
+					notes: []string{
+						"Вес готового блюда указывается вместе с тарой (кастрюлей), вес тары можно не указывать",
+						"Еда рецепта пересчитывается при изменении любого ингредиента, ингредиентом может быть еда другого рецепта",
+					},
+					handler: (*CmdProcessor).recipeSetCommand,
+				},
+				{
+					name:    "st",
+					title:   "Шаблон для установки параметров рецепта",
+					args:    []argSpec{{name: "Ключ", typ: argString}},
+					handler: (*CmdProcessor).recipeSetTemplateCommand,
+				},
+				{
+					name:    "list",
+					title:   "Список рецептов",
+					handler: (*CmdProcessor).recipeListCommand,
+				},
+				{
+					name:    "del",
+					title:   "Удаление рецепта",
+					args:    []argSpec{{name: "Ключ", typ: argString}},
+					notes:   []string{"Еда рецепта остается как обычная личная еда"},
+					handler: (*CmdProcessor).recipeDelCommand,
+				},
+			},
+		},
+		{
+			name:    "j",
+			aliases: []string{"journal"},
+			title:   "Управление журналом приема пищи",
+			subcmds: []*cmdSpec{
+				{
+					name:  "set",
+					title: "Установка записи приема пищи",
+					args: []argSpec{
+						_argDate,
+						_argMeal,
+						_argFoodKey,
+						{name: "Вес гр.", typ: argQuantity},
+						_argTime,
+					},
+					notes: []string{
+						"Время - необязательное время приема еды, если не указано или пустое, то подразумевается текущее время",
+						"Записи одной и той же еды с разным временем хранятся отдельно, с тем же временем - запись перезаписывается",
+						_noteMeal,
+						_noteFoodKey,
+						_noteSuggest,
+						_noteQuantity,
+						_noteExpr,
+						_noteDate,
+					},
+					handler: func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse {
+						return r.journalSetCommand(args, userID, storage.JournalSetModeReplace)
+					},
+				},
+				{
+					name:  "add",
+					title: "Добавление веса к записи приема пищи",
+					args: []argSpec{
+						_argDate,
+						_argMeal,
+						_argFoodKey,
+						{name: "Вес гр.", typ: argQuantity},
+						_argTime,
+					},
+					notes: []string{
+						"Аргументы как в <code>j,set</code>, но вес добавляется к последней записи этой еды в приеме пищи, независимо от ее времени. Если записи нет, то она создается с указанным временем",
+					},
+					handler: func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse {
+						return r.journalSetCommand(args, userID, storage.JournalSetModeAdd)
+					},
+				},
+				{
+					name:  "sb",
+					title: "Установка бандла для записи приема пищи",
+					args: []argSpec{
+						_argDate,
+						_argMeal,
+						_argBundleKey,
+						{name: "Множитель", typ: argFloat, optional: true},
+					},
+					notes: []string{
+						"Множитель - необязательный коэффициент веса еды из бандла, например 0.5 для половины порции. Если пустой, то 1",
+						"Время приема еды - текущее время",
+						"Если одна и та же еда входит в бандл через несколько дочерних бандлов, то ее вес суммируется",
+						"Ключ бандла - значение ключа из списка бандлов",
+						_noteSuggest,
+						_noteMeal,
+						_noteDate,
+					},
+					handler: func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse {
+						return r.journalSetBundleCommand(args, userID, storage.JournalSetModeReplace)
+					},
+				},
+				{
+					name:  "ab",
+					title: "Добавление бандла к записям приема пищи",
+					args: []argSpec{
+						_argDate,
+						_argMeal,
+						_argBundleKey,
+						{name: "Множитель", typ: argFloat, optional: true},
+					},
+					notes: []string{
+						"Аргументы как в <code>j,sb</code>, но вес еды из бандла добавляется к последней записи этой еды в приеме пищи, как в <code>j,add</code>",
+					},
+					handler: func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse {
+						return r.journalSetBundleCommand(args, userID, storage.JournalSetModeAdd)
+					},
+				},
+				{
+					name:  "del",
+					title: "Удаление записи приема пищи",
+					args:  []argSpec{_argDate, _argMeal, _argFoodKey, _argTime},
+					notes: []string{
+						"Если время не указано, то удаляются все записи еды в приеме пищи, иначе только запись с указанным временем",
+						_noteMeal,
+						_noteFoodKey,
+						_noteDate,
+					},
+					handler: (*CmdProcessor).journalDelCommand,
+				},
+				{
+					name:    "dm",
+					title:   "Удаление приема пищи",
+					args:    []argSpec{_argDate, _argMeal},
+					notes:   []string{_noteMeal, _noteDate},
+					handler: (*CmdProcessor).journalDelMealCommand,
+				},
+				{
+					name:  "cp",
+					title: "Копирование приема пищи",
+					args: []argSpec{
+						{name: "Дата откуда", typ: argDate},
+						_argMeal,
+						{name: "Дата куда", typ: argDate},
+						_argMeal,
+					},
+					notes: []string{
+						"Для копирования указывается дата и прием пищи откуда копировать и дата и прием пищи куда копировать",
+						"Если в дате назначения уже есть записи у указанного приема пищи, то будет ошибка копирования",
+						"Приемы пищи источника и назначения могут быть разными",
+						_noteDate,
+					},
+					handler: (*CmdProcessor).journalCopyCommand,
+				},
+				{
+					name:    "rd",
+					title:   "Ежедневный отчет",
+					args:    []argSpec{_argDate},
+					notes:   []string{_noteDate},
+					handler: (*CmdProcessor).journalReportDayCommand,
+				},
+				{
+					name:  "rw",
+					title: "Еженедельный отчет",
+					args:  []argSpec{_argDate},
+					notes: []string{
+						"По дате определяется начало недели, относительного которого формируется отчет",
+						_noteDate,
+					},
+					handler: (*CmdProcessor).journalReportWeekCommand,
+				},
+				{
+					name:    "rr",
+					title:   "Отчет по ККал за период",
+					args:    []argSpec{_argDateRange},
+					notes:   []string{_noteRange},
+					handler: (*CmdProcessor).journalReportRangeCommand,
+				},
+				{
+					name:    "tm",
+					title:   "Шаблоны команд по приему пищи за дату",
+					args:    []argSpec{_argDate, _argMeal},
+					notes:   []string{_noteMeal, _noteDate},
+					handler: (*CmdProcessor).journalTemplateMealCommand,
+				},
+				{
+					name:  "fa",
+					title: "Средний вес приема пищи за год",
+					args:  []argSpec{_argFoodKey},
+					notes: []string{
+						_noteFoodKey,
+						"Год рассчитывается как &lt;текущая дата - 1 год, текущая дата&gt;",
+					},
+					handler: (*CmdProcessor).journalFoodAvgWeightCommand,
+				},
+				{
+					name:  "rc",
+					title: "Пересчет журнала по текущим значениям еды",
+					args:  []argSpec{_argDateRange},
+					notes: []string{
+						"Записи журнала хранят ККал, БЖУ и дополнительные нутриенты еды на момент записи, поэтому изменение еды не меняет прошлые отчеты. Команда обновляет эти значения из текущей еды за период",
+						_noteRange,
+					},
+					handler: (*CmdProcessor).journalRecalcCommand,
+				},
+			},
+		},
+		{
+			name:    "m",
+			aliases: []string{"maintenance"},
+			title:   "Обслуживание",
+			subcmds: []*cmdSpec{
+				{
+					name:    "backup",
+					title:   "Резервная копия базы данных",
+					notes:   []string{"Бот присылает файл резервной копии в формате .json.gz"},
+					handler: (*CmdProcessor).backupCommand,
+				},
+				{
+					name:  "restore",
+					title: "Восстановление из резервной копии",
+					args: []argSpec{
+						{name: "Режим", typ: argChoice, choices: []string{"replace", "merge"}, optional: true},
+					},
+					notes: []string{
+						"Команда указывается в подписи к отправляемому файлу резервной копии .json.gz",
+						"Режимы: replace - существующие данные пользователей из копии удаляются и заменяются данными из копии, данные других пользователей и общая еда сохраняются, merge - данные из копии добавляются к существующим, совпадающие записи обновляются",
+						"Если режим пустой, то подразумевается replace",
+						"Поддерживаются резервные копии предыдущих версий, они автоматически преобразуются при восстановлении",
+					},
+					handler: (*CmdProcessor).restoreCommand,
+				},
+				{
+					name:  "weblogin",
+					title: "Вход в веб-интерфейс",
+					notes: []string{
+						"Бот присылает одноразовую ссылку для входа в веб-интерфейс, ссылка действует 5 минут",
+					},
+					handler: (*CmdProcessor).webLoginCommand,
+				},
+			},
+		},
+	}
+}
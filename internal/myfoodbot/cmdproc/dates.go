@@ -0,0 +1,131 @@
+package cmdproc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"пн": time.Monday, "понедельник": time.Monday, "mon": time.Monday, "monday": time.Monday,
+	"вт": time.Tuesday, "вторник": time.Tuesday, "tue": time.Tuesday, "tuesday": time.Tuesday,
+	"ср": time.Wednesday, "среда": time.Wednesday, "wed": time.Wednesday, "wednesday": time.Wednesday,
+	"чт": time.Thursday, "четверг": time.Thursday, "thu": time.Thursday, "thursday": time.Thursday,
+	"пт": time.Friday, "пятница": time.Friday, "fri": time.Friday, "friday": time.Friday,
+	"сб": time.Saturday, "суббота": time.Saturday, "sat": time.Saturday, "saturday": time.Saturday,
+	"вс": time.Sunday, "воскресенье": time.Sunday, "sun": time.Sunday, "sunday": time.Sunday,
+}
+
+// parseTimestamp parses date argument relative to current date in user timezone.
+func (r *CmdProcessor) parseTimestamp(sTimestamp string) (time.Time, error) {
+	return parseDate(sTimestamp, time.Now().In(r.tz))
+}
+
+// parseTimestampRange parses date range arguments: either two dates or
+// one argument with range like "01.02-07.02" or single date. Range must not
+// be reversed.
+func (r *CmdProcessor) parseTimestampRange(args []string) (time.Time, time.Time, error) {
+	now := time.Now().In(r.tz)
+
+	var sFrom, sTo string
+	switch len(args) {
+	case 1:
+		sFrom, sTo = splitDateRange(args[0])
+	case 2:
+		sFrom, sTo = args[0], args[1]
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range args count %d", len(args))
+	}
+
+	tsFrom, err := parseDate(sFrom, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	tsTo, err := parseDate(sTo, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if tsFrom.After(tsTo) {
+		return time.Time{}, time.Time{}, fmt.Errorf("reversed date range %s-%s", formatTimestamp(tsFrom), formatTimestamp(tsTo))
+	}
+
+	return tsFrom, tsTo, nil
+}
+
+// splitDateRange splits "from-to" range, leading minus belongs to relative date,
+// so "-7--1" is a range too. Single relative date "-N" is range of last N days
+// to today, other single date is returned as both bounds.
+func splitDateRange(s string) (string, string) {
+	if len(s) > 1 {
+		if idx := strings.Index(s[1:], "-"); idx != -1 {
+			return s[:idx+1], s[idx+2:]
+		}
+		if _, err := strconv.Atoi(s[1:]); err == nil && s[0] == '-' {
+			return s, ""
+		}
+	}
+	return s, s
+}
+
+// parseDate parses date in forms:
+//   - empty string or "сегодня"/"today" - current date;
+//   - "вчера"/"yesterday", "позавчера" - days before current date;
+//   - "-N" - N days before current date;
+//   - weekday name like "пн" or "monday" - last such day, current date included;
+//   - "DD.MM" - last such date, current date included;
+//   - "DD.MM.YYYY" - full date.
+func parseDate(s string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	switch s {
+	case "", "сегодня", "today":
+		return today, nil
+	case "вчера", "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "позавчера":
+		return today.AddDate(0, 0, -2), nil
+	}
+
+	if strings.HasPrefix(s, "-") {
+		days, err := strconv.Atoi(s[1:])
+		if err != nil || days < 0 {
+			return time.Time{}, fmt.Errorf("invalid relative date %q", s)
+		}
+		return today.AddDate(0, 0, -days), nil
+	}
+
+	if wd, ok := weekdayNames[s]; ok {
+		diff := (int(today.Weekday()) - int(wd) + 7) % 7
+		return today.AddDate(0, 0, -diff), nil
+	}
+
+	if t, err := time.Parse("02.01.2006", s); err == nil {
+		return t, nil
+	}
+
+	// Parse in leap year to accept 29.02, then check day in target year.
+	t, err := time.Parse("02.01.2006", s+".2000")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	year := today.Year()
+	if t.Month() > today.Month() || (t.Month() == today.Month() && t.Day() > today.Day()) {
+		year--
+	}
+
+	t, err = time.Parse("02.01.2006", fmt.Sprintf("%s.%04d", s, year))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q for year %d", s, year)
+	}
+
+	return t, nil
+}
+
+func formatTimestamp(ts time.Time) string {
+	return ts.Format("02.01.2006")
+}
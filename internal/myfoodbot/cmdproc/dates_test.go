@@ -0,0 +1,118 @@
+package cmdproc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DatesTestSuite struct {
+	suite.Suite
+}
+
+func (r *DatesTestSuite) TestParseDate() {
+	// Wednesday.
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name string
+		s    string
+		want time.Time
+	}{
+		{name: "empty", s: "", want: r.date(2025, 1, 15)},
+		{name: "today", s: "Сегодня", want: r.date(2025, 1, 15)},
+		{name: "yesterday", s: "yesterday", want: r.date(2025, 1, 14)},
+		{name: "day before yesterday", s: "позавчера", want: r.date(2025, 1, 13)},
+		{name: "relative", s: "-20", want: r.date(2024, 12, 26)},
+		{name: "relative zero", s: "-0", want: r.date(2025, 1, 15)},
+		{name: "weekday today", s: "ср", want: r.date(2025, 1, 15)},
+		{name: "weekday before", s: "monday", want: r.date(2025, 1, 13)},
+		{name: "weekday last week", s: "чт", want: r.date(2025, 1, 9)},
+		{name: "full date", s: "29.02.2024", want: r.date(2024, 2, 29)},
+		{name: "day and month", s: "10.01", want: r.date(2025, 1, 10)},
+		{name: "day and month today", s: "15.01", want: r.date(2025, 1, 15)},
+		{name: "day and month last year", s: "28.12", want: r.date(2024, 12, 28)},
+		{name: "leap day last year", s: "29.02", want: r.date(2024, 2, 29)},
+	} {
+		r.Run(tt.name, func() {
+			ts, err := parseDate(tt.s, now)
+			r.NoError(err)
+			r.Equal(tt.want, ts)
+		})
+	}
+
+	r.Run("leap day in non leap year", func() {
+		_, err := parseDate("29.02", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+		r.Error(err)
+	})
+
+	r.Run("invalid dates", func() {
+		for _, s := range []string{"abc", "-x", "--1", "32.01", "31.04", "1.2.3", "29.02.2025"} {
+			_, err := parseDate(s, now)
+			r.Error(err, s)
+		}
+	})
+}
+
+func (r *DatesTestSuite) TestSplitDateRange() {
+	for _, tt := range []struct {
+		s        string
+		from, to string
+	}{
+		{s: "", from: "", to: ""},
+		{s: "-", from: "-", to: "-"},
+		{s: "01.02", from: "01.02", to: "01.02"},
+		{s: "-7", from: "-7", to: ""},
+		{s: "-x", from: "-x", to: "-x"},
+		{s: "01.02-07.02", from: "01.02", to: "07.02"},
+		{s: "-7--1", from: "-7", to: "-1"},
+		{s: "-7-вчера", from: "-7", to: "вчера"},
+		{s: "пн-", from: "пн", to: ""},
+	} {
+		from, to := splitDateRange(tt.s)
+		r.Equal(tt.from, from, tt.s)
+		r.Equal(tt.to, to, tt.s)
+	}
+}
+
+func (r *DatesTestSuite) TestParseTimestampRange() {
+	proc := &CmdProcessor{tz: time.UTC}
+	today := time.Now().UTC()
+	today = r.date(today.Year(), today.Month(), today.Day())
+
+	for _, tt := range []struct {
+		args     []string
+		from, to time.Time
+	}{
+		{args: []string{"-7"}, from: today.AddDate(0, 0, -7), to: today},
+		{args: []string{"-7--1"}, from: today.AddDate(0, 0, -7), to: today.AddDate(0, 0, -1)},
+		{args: []string{"вчера"}, from: today.AddDate(0, 0, -1), to: today.AddDate(0, 0, -1)},
+		{args: []string{"01.02.2025-07.02.2025"}, from: r.date(2025, 2, 1), to: r.date(2025, 2, 7)},
+		{args: []string{"01.02.2025", "01.02.2025"}, from: r.date(2025, 2, 1), to: r.date(2025, 2, 1)},
+	} {
+		from, to, err := proc.parseTimestampRange(tt.args)
+		r.NoError(err, tt.args)
+		r.Equal(tt.from, from, tt.args)
+		r.Equal(tt.to, to, tt.args)
+	}
+
+	r.Run("reversed range", func() {
+		for _, args := range [][]string{
+			{"07.02.2025-01.02.2025"},
+			{"07.02.2025", "01.02.2025"},
+			{"-1--7"},
+		} {
+			_, _, err := proc.parseTimestampRange(args)
+			r.Error(err, args)
+		}
+	})
+}
+
+func (r *DatesTestSuite) date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDates(t *testing.T) {
+	suite.Run(t, new(DatesTestSuite))
+}
@@ -0,0 +1,195 @@
+package cmdproc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+)
+
+type exprErrorKind int
+
+const (
+	exprErrToken exprErrorKind = iota
+	exprErrEnd
+	exprErrDivZero
+)
+
+// exprError points at the bad token of weight expression, position is 1-based.
+type exprError struct {
+	Kind  exprErrorKind
+	Expr  string
+	Pos   int
+	Token string
+}
+
+func (e *exprError) Error() string {
+	switch e.Kind {
+	case exprErrEnd:
+		return fmt.Sprintf("expression %q: unexpected end", e.Expr)
+	case exprErrDivZero:
+		return fmt.Sprintf("expression %q: division by zero at position %d", e.Expr, e.Pos)
+	default:
+		return fmt.Sprintf("expression %q: unexpected token %q at position %d", e.Expr, e.Token, e.Pos)
+	}
+}
+
+// evalExpr evaluates arithmetic expression with numbers, + - * / and parentheses,
+// like "350-120" or "30+25+40".
+func evalExpr(s string) (float64, error) {
+	p := &exprParser{src: []rune(s)}
+
+	val, err := p.parseSum()
+	if err != nil {
+		return 0, err
+	}
+
+	p.skipSpaces()
+	if !p.atEnd() {
+		return 0, p.tokenError(string(p.src[p.pos]))
+	}
+
+	return val, nil
+}
+
+type exprParser struct {
+	src []rune
+	pos int
+}
+
+func (p *exprParser) parseSum() (float64, error) {
+	val, err := p.parseProduct()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		p.skipSpaces()
+		if p.atEnd() || (p.src[p.pos] != '+' && p.src[p.pos] != '-') {
+			return val, nil
+		}
+
+		op := p.src[p.pos]
+		p.pos++
+
+		rhs, err := p.parseProduct()
+		if err != nil {
+			return 0, err
+		}
+
+		if op == '+' {
+			val += rhs
+		} else {
+			val -= rhs
+		}
+	}
+}
+
+func (p *exprParser) parseProduct() (float64, error) {
+	val, err := p.parseFactor()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		p.skipSpaces()
+		if p.atEnd() || (p.src[p.pos] != '*' && p.src[p.pos] != '/') {
+			return val, nil
+		}
+
+		op, opPos := p.src[p.pos], p.pos
+		p.pos++
+
+		rhs, err := p.parseFactor()
+		if err != nil {
+			return 0, err
+		}
+
+		if op == '*' {
+			val *= rhs
+		} else {
+			if rhs == 0 {
+				return 0, &exprError{Kind: exprErrDivZero, Expr: string(p.src), Pos: opPos + 1, Token: "/"}
+			}
+			val /= rhs
+		}
+	}
+}
+
+func (p *exprParser) parseFactor() (float64, error) {
+	p.skipSpaces()
+	if p.atEnd() {
+		return 0, &exprError{Kind: exprErrEnd, Expr: string(p.src), Pos: p.pos + 1}
+	}
+
+	if p.src[p.pos] == '(' {
+		p.pos++
+
+		val, err := p.parseSum()
+		if err != nil {
+			return 0, err
+		}
+
+		p.skipSpaces()
+		if p.atEnd() {
+			return 0, &exprError{Kind: exprErrEnd, Expr: string(p.src), Pos: p.pos + 1}
+		}
+		if p.src[p.pos] != ')' {
+			return 0, p.tokenError(string(p.src[p.pos]))
+		}
+		p.pos++
+
+		return val, nil
+	}
+
+	start := p.pos
+	for !p.atEnd() && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, p.tokenError(string(p.src[p.pos]))
+	}
+
+	num := string(p.src[start:p.pos])
+	val, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		p.pos = start
+		return 0, p.tokenError(num)
+	}
+
+	return val, nil
+}
+
+func (p *exprParser) skipSpaces() {
+	for !p.atEnd() && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *exprParser) atEnd() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *exprParser) tokenError(token string) *exprError {
+	return &exprError{Kind: exprErrToken, Expr: string(p.src), Pos: p.pos + 1, Token: token}
+}
+
+// exprErrorText returns message pointing at the bad token of weight expression,
+// false if err is not expression error.
+func exprErrorText(err error) (string, bool) {
+	var exprErr *exprError
+	if !errors.As(err, &exprErr) {
+		return "", false
+	}
+
+	switch exprErr.Kind {
+	case exprErrEnd:
+		return fmt.Sprintf(messages.MsgErrExprEnd, exprErr.Expr), true
+	case exprErrDivZero:
+		return fmt.Sprintf(messages.MsgErrExprDivZero, exprErr.Expr, exprErr.Pos), true
+	default:
+		return fmt.Sprintf(messages.MsgErrExprToken, exprErr.Expr, exprErr.Token, exprErr.Pos), true
+	}
+}
@@ -0,0 +1,62 @@
+package cmdproc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ExprTestSuite struct {
+	suite.Suite
+}
+
+func (r *ExprTestSuite) TestEval() {
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{s: "42", want: 42},
+		{s: "1.5", want: 1.5},
+		{s: " 350 - 120 ", want: 230},
+		{s: "30+25+40", want: 95},
+		{s: "10-4-3", want: 3},
+		{s: "2+3*4", want: 14},
+		{s: "(2+3)*4", want: 20},
+		{s: "100/4/5", want: 5},
+		{s: "((1+1))*(2+(3-1))", want: 8},
+	} {
+		val, err := evalExpr(tt.s)
+		r.NoError(err, tt.s)
+		r.Equal(tt.want, val, tt.s)
+	}
+}
+
+func (r *ExprTestSuite) TestEvalErrors() {
+	for _, tt := range []struct {
+		s    string
+		want exprError
+	}{
+		{s: "", want: exprError{Kind: exprErrEnd, Pos: 1}},
+		{s: "1+", want: exprError{Kind: exprErrEnd, Pos: 3}},
+		{s: "(1+2", want: exprError{Kind: exprErrEnd, Pos: 5}},
+		{s: "10/(5-5)", want: exprError{Kind: exprErrDivZero, Pos: 3, Token: "/"}},
+		{s: "1+2/0*3", want: exprError{Kind: exprErrDivZero, Pos: 4, Token: "/"}},
+		{s: "1+2x", want: exprError{Kind: exprErrToken, Pos: 4, Token: "x"}},
+		{s: "(1+2))", want: exprError{Kind: exprErrToken, Pos: 6, Token: ")"}},
+		{s: "1 2", want: exprError{Kind: exprErrToken, Pos: 3, Token: "2"}},
+		{s: "*2", want: exprError{Kind: exprErrToken, Pos: 1, Token: "*"}},
+		{s: "(1 2)", want: exprError{Kind: exprErrToken, Pos: 4, Token: "2"}},
+		{s: "1+1..2", want: exprError{Kind: exprErrToken, Pos: 3, Token: "1..2"}},
+	} {
+		_, err := evalExpr(tt.s)
+
+		var exprErr *exprError
+		r.ErrorAs(err, &exprErr, tt.s)
+		tt.want.Expr = tt.s
+		r.Equal(&tt.want, exprErr, tt.s)
+	}
+}
+
+func TestExpr(t *testing.T) {
+	suite.Run(t, new(ExprTestSuite))
+}
@@ -1,7 +1,11 @@
 package cmdproc
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -14,24 +18,72 @@ const (
 
 var optsHTML = &tele.SendOptions{ParseMode: tele.ModeHTML}
 
-func (r *CmdProcessor) parseTimestamp(sTimestamp string) (time.Time, error) {
-	var t time.Time
-	var err error
+// parseDayTime parses HH:MM time of day.
+func parseDayTime(sDayTime string) (time.Duration, error) {
+	t, err := time.Parse("15:04", sDayTime)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// currentDayTime returns current time of day with minute precision.
+func (r *CmdProcessor) currentDayTime() time.Duration {
+	t := time.Now().In(r.tz)
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
+
+func formatDayTime(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// parseQuantity parses food quantity: weight in grams like "150" or "150г",
+// or count of food portions like "2шт" or "1ст.л". Value may be an arithmetic
+// expression like "350-120".
+func parseQuantity(sQuantity string) (float64, string, error) {
+	sQuantity = strings.TrimSpace(sQuantity)
+	exprEnd := strings.IndexFunc(sQuantity, unicode.IsLetter)
+	if exprEnd == -1 {
+		exprEnd = len(sQuantity)
+	}
 
-	if sTimestamp == "" {
-		t = time.Now().In(r.tz)
-	} else {
-		t, err = time.Parse("02.01.2006", sTimestamp)
-		if err != nil {
-			return time.Time{}, err
-		}
+	val, err := evalExpr(sQuantity[:exprEnd])
+	if err != nil {
+		return 0, "", err
 	}
 
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+	portion := strings.TrimSpace(sQuantity[exprEnd:])
+	if portion == "г" {
+		portion = ""
+	}
+
+	return val, portion, nil
+}
+
+// formatQuantity formats food weight with original quantity in portions, if set.
+func formatQuantity(weight float64, portion string, portionCount float64) string {
+	if portion == "" {
+		return fmt.Sprintf("%.1f", weight)
+	}
+	return fmt.Sprintf("%.1f (%s%s)", weight, strconv.FormatFloat(portionCount, 'f', -1, 64), portion)
 }
 
-func formatTimestamp(ts time.Time) string {
-	return ts.Format("02.01.2006")
+// formatOptNutrient formats optional nutrient, empty string if unknown.
+func formatOptNutrient(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%.2f", *v)
+}
+
+// scaleOptNutrient returns nutrient for food weight, nil if unknown.
+func scaleOptNutrient(v *float64, weight float64) *float64 {
+	if v == nil {
+		return nil
+	}
+	res := weight / 100 * *v
+	return &res
 }
 
 func getStartOfWeek(ts time.Time) time.Time {
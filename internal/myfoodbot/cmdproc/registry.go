@@ -0,0 +1,493 @@
+package cmdproc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"go.uber.org/zap"
+	tele "gopkg.in/telebot.v3"
+)
+
+// argType defines how command argument is parsed and validated.
+type argType int
+
+const (
+	// argString is any string, may be empty.
+	argString argType = iota
+	// argInt is integer number.
+	argInt
+	// argFloat is float number.
+	argFloat
+	// argExpr is float number or arithmetic expression like "350-120".
+	argExpr
+	// argQuantity is food weight or count of portions like "150" or "2шт".
+	argQuantity
+	// argKeyQuantity is key with optional quantity like "яйцо:2шт" or "bundle".
+	argKeyQuantity
+	// argDate is date in any supported format, empty means today.
+	argDate
+	// argDateRange is date range, takes one argument "from-to" or two dates.
+	argDateRange
+	// argTime is HH:MM time of day.
+	argTime
+	// argChoice is one of argSpec choices.
+	argChoice
+)
+
+type argSpec struct {
+	name    string
+	typ     argType
+	choices []string
+	// optional argument may be omitted at the end of command or be empty.
+	optional bool
+	// variadic argument takes all remaining command parts.
+	variadic bool
+}
+
+type cmdHandler func(r *CmdProcessor, args *cmdArgs, userID int64) []CmdResponse
+
+// cmdSpec is command definition, either with handler and arguments or
+// with subcommands. Help document is generated from these definitions.
+type cmdSpec struct {
+	name    string
+	aliases []string
+	title   string
+	args    []argSpec
+	notes   []string
+	handler cmdHandler
+	subcmds []*cmdSpec
+}
+
+func (r *cmdSpec) match(name string) bool {
+	if r.name == name {
+		return true
+	}
+	for _, a := range r.aliases {
+		if a == name {
+			return true
+		}
+	}
+	return false
+}
+
+func findCmdSpec(specs []*cmdSpec, name string) *cmdSpec {
+	for _, s := range specs {
+		if s.match(name) {
+			return s
+		}
+	}
+	return nil
+}
+
+// quantity is food weight in grams or count of food portions.
+type quantity struct {
+	val     float64
+	portion string
+}
+
+type keyQuantity struct {
+	key         string
+	hasQuantity bool
+	quantity
+}
+
+type dateRange struct {
+	from, to time.Time
+}
+
+// cmdArgs is parsed command arguments, values are indexed as argument specs.
+type cmdArgs struct {
+	// ctx is parent context for command operations, batch context for
+	// commands in batch.
+	ctx    context.Context
+	c      tele.Context
+	raw    []string
+	values []any
+	specs  []argSpec
+	// pos is index of first raw part of each argument.
+	pos []int
+	// offset is count of command and subcommand parts before arguments.
+	offset int
+	usage  string
+}
+
+// Has reports whether optional argument is set.
+func (r *cmdArgs) Has(i int) bool {
+	return r.values[i] != nil
+}
+
+func (r *cmdArgs) String(i int) string {
+	s, _ := r.values[i].(string)
+	return s
+}
+
+func (r *cmdArgs) Strings(i int) []string {
+	s, _ := r.values[i].([]string)
+	return s
+}
+
+func (r *cmdArgs) Int(i int) int64 {
+	v, _ := r.values[i].(int64)
+	return v
+}
+
+func (r *cmdArgs) Float(i int) float64 {
+	v, _ := r.values[i].(float64)
+	return v
+}
+
+// OptFloat returns nil if optional argument is not set.
+func (r *cmdArgs) OptFloat(i int) *float64 {
+	if !r.Has(i) {
+		return nil
+	}
+	v := r.Float(i)
+	return &v
+}
+
+func (r *cmdArgs) Quantity(i int) quantity {
+	v, _ := r.values[i].(quantity)
+	return v
+}
+
+func (r *cmdArgs) KeyQuantities(i int) []keyQuantity {
+	v, _ := r.values[i].([]keyQuantity)
+	return v
+}
+
+func (r *cmdArgs) Date(i int) time.Time {
+	v, _ := r.values[i].(time.Time)
+	return v
+}
+
+func (r *cmdArgs) DateRange(i int) (time.Time, time.Time) {
+	v, _ := r.values[i].(dateRange)
+	return v.from, v.to
+}
+
+func (r *cmdArgs) Time(i int) time.Duration {
+	v, _ := r.values[i].(time.Duration)
+	return v
+}
+
+// BadValue returns error response for argument which is parsed but not valid,
+// expected describes valid values.
+func (r *cmdArgs) BadValue(i int, expected string) []CmdResponse {
+	return r.BadItem(i, 0, expected)
+}
+
+// BadItem is BadValue for j-th item of variadic argument.
+func (r *cmdArgs) BadItem(i, j int, expected string) []CmdResponse {
+	pos := r.pos[i] + j
+	return argErrorResponse(&argError{
+		spec:     &r.specs[i],
+		pos:      pos + 1,
+		value:    r.raw[pos],
+		expected: expected,
+		err:      errArgBadValue,
+	}, r.usage)
+}
+
+// argCheck is validity of i-th argument value.
+type argCheck struct {
+	i        int
+	ok       bool
+	expected string
+}
+
+// Invalid returns BadValue response for first failed check. It is used to
+// report argument rejected by storage validation, generic invalid command
+// response is returned if all checks passed.
+func (r *cmdArgs) Invalid(checks ...argCheck) []CmdResponse {
+	for _, chk := range checks {
+		if !chk.ok {
+			return r.BadValue(chk.i, chk.expected)
+		}
+	}
+	return NewErrorCmdResponse(messages.MsgErrInvalidCommand)
+}
+
+// argError is argument parsing error, pos is 1-based argument position after
+// command and subcommand.
+type argError struct {
+	spec     *argSpec
+	pos      int
+	value    string
+	expected string
+	err      error
+}
+
+func (e *argError) Error() string {
+	if e.spec == nil {
+		return fmt.Sprintf("position %d value %q: %v", e.pos, e.value, e.err)
+	}
+	return fmt.Sprintf("argument %d %q value %q: %v", e.pos, e.spec.name, e.value, e.err)
+}
+
+func (e *argError) Unwrap() error {
+	return e.err
+}
+
+var (
+	errArgMissing  = errors.New("missing argument")
+	errArgTooMany  = errors.New("too many arguments")
+	errArgBadValue = errors.New("bad value")
+)
+
+// parseArgs parses and validates command parts against argument specs.
+func (r *CmdProcessor) parseArgs(specs []argSpec, parts []string) (*cmdArgs, error) {
+	args := &cmdArgs{
+		raw:    parts,
+		values: make([]any, len(specs)),
+		specs:  specs,
+		pos:    make([]int, len(specs)),
+	}
+	values := args.values
+
+	pos := 0
+	for i := range specs {
+		spec := &specs[i]
+		args.pos[i] = pos
+
+		if spec.variadic {
+			val, err := r.parseVariadicArg(spec, pos, parts[pos:])
+			if err != nil {
+				return nil, err
+			}
+			values[i] = val
+			pos = len(parts)
+			continue
+		}
+
+		if pos >= len(parts) {
+			if spec.optional {
+				continue
+			}
+			return nil, &argError{spec: spec, pos: pos + 1, err: errArgMissing}
+		}
+
+		if spec.typ == argDateRange {
+			// Range takes one or two last parts.
+			rangeParts := parts[pos:]
+			if len(rangeParts) > 2 {
+				rangeParts = rangeParts[:2]
+			}
+
+			from, to, err := r.parseTimestampRange(rangeParts)
+			if err != nil {
+				return nil, &argError{spec: spec, pos: pos + 1, value: strings.Join(rangeParts, ","), err: err}
+			}
+			values[i] = dateRange{from: from, to: to}
+			pos += len(rangeParts)
+			continue
+		}
+
+		val, err := r.parseArg(spec, parts[pos])
+		if err != nil {
+			return nil, &argError{spec: spec, pos: pos + 1, value: parts[pos], err: err}
+		}
+		values[i] = val
+		pos++
+	}
+
+	if pos < len(parts) {
+		return nil, &argError{pos: pos + 1, value: strings.Join(parts[pos:], ","), err: errArgTooMany}
+	}
+
+	return args, nil
+}
+
+// parseVariadicArg parses remaining parts starting at pos.
+func (r *CmdProcessor) parseVariadicArg(spec *argSpec, pos int, parts []string) (any, error) {
+	if len(parts) == 0 && !spec.optional {
+		return nil, &argError{spec: spec, pos: pos + 1, err: errArgMissing}
+	}
+
+	switch spec.typ {
+	case argKeyQuantity:
+		vals := make([]keyQuantity, 0, len(parts))
+		for j, part := range parts {
+			val, err := parseKeyQuantity(part)
+			if err != nil {
+				return nil, &argError{spec: spec, pos: pos + j + 1, value: part, err: err}
+			}
+			vals = append(vals, val)
+		}
+		return vals, nil
+	default:
+		return parts, nil
+	}
+}
+
+// parseArg parses single argument, nil value means empty optional argument.
+func (r *CmdProcessor) parseArg(spec *argSpec, s string) (any, error) {
+	// Empty date is current date, not omitted argument.
+	if spec.typ == argDate {
+		return r.parseTimestamp(s)
+	}
+
+	if s == "" && spec.optional {
+		return nil, nil
+	}
+
+	switch spec.typ {
+	case argInt:
+		return strconv.ParseInt(s, 10, 64)
+	case argFloat:
+		return strconv.ParseFloat(s, 64)
+	case argExpr:
+		return evalExpr(s)
+	case argTime:
+		return parseDayTime(s)
+	case argQuantity:
+		val, portion, err := parseQuantity(s)
+		if err != nil {
+			return nil, err
+		}
+		return quantity{val: val, portion: portion}, nil
+	case argKeyQuantity:
+		return parseKeyQuantity(s)
+	case argChoice:
+		for _, c := range spec.choices {
+			if c == s {
+				return s, nil
+			}
+		}
+		return nil, errArgBadValue
+	default:
+		return s, nil
+	}
+}
+
+const (
+	_expectPositive    = "число больше 0"
+	_expectNonNegative = "число не меньше 0"
+	_expectNonEmpty    = "непустое значение"
+)
+
+// argFormat returns description of valid argument values.
+func argFormat(spec *argSpec) string {
+	switch spec.typ {
+	case argInt:
+		return "целое число"
+	case argFloat:
+		return "число, например 12.5"
+	case argExpr:
+		return "число или выражение, например 350-120"
+	case argQuantity:
+		return "вес или количество порций, например 150 или 2шт"
+	case argKeyQuantity:
+		return "ключ или ключ:вес, например яйцо:55 или яйцо:2шт"
+	case argDate:
+		return "дата, например 17.10.2024, 17.10, вчера, -2 или пн"
+	case argDateRange:
+		return "период, например 01.02-07.02, -7 или две даты через запятую"
+	case argTime:
+		return "время HH:MM"
+	case argChoice:
+		return "одно из значений " + strings.Join(spec.choices, ", ")
+	default:
+		return "строка"
+	}
+}
+
+// argErrorResponse returns message with bad argument position, expected format,
+// received value and command usage line.
+func argErrorResponse(err error, usage string) []CmdResponse {
+	var argErr *argError
+	if !errors.As(err, &argErr) {
+		return NewErrorCmdResponse(messages.MsgErrInvalidCommand)
+	}
+
+	var msg string
+	switch {
+	case errors.Is(argErr.err, errArgTooMany):
+		msg = fmt.Sprintf(messages.MsgErrArgTooMany, argErr.pos, argErr.value)
+	case errors.Is(argErr.err, errArgMissing):
+		msg = fmt.Sprintf(messages.MsgErrArgMissing, argErr.pos, argErr.spec.name, argFormat(argErr.spec))
+	default:
+		expected := argErr.expected
+		if expected == "" {
+			expected = argFormat(argErr.spec)
+		}
+		msg = fmt.Sprintf(messages.MsgErrArgBadValue, argErr.pos, argErr.spec.name, argErr.value, expected)
+	}
+
+	lines := []string{html.EscapeString(msg)}
+	if exprMsg, ok := exprErrorText(err); ok {
+		lines = append(lines, html.EscapeString(exprMsg))
+	}
+	lines = append(lines, fmt.Sprintf(messages.MsgCmdUsage, "<code>"+usage+"</code>"))
+
+	return NewErrorCmdResponse(strings.Join(lines, "\n"), optsHTML)
+}
+
+func parseKeyQuantity(s string) (keyQuantity, error) {
+	key, sQuantity, found := strings.Cut(s, ":")
+	if !found {
+		return keyQuantity{key: key}, nil
+	}
+	if strings.Contains(sQuantity, ":") {
+		return keyQuantity{}, errArgBadValue
+	}
+
+	val, portion, err := parseQuantity(sQuantity)
+	if err != nil {
+		return keyQuantity{}, err
+	}
+
+	return keyQuantity{key: key, hasQuantity: true, quantity: quantity{val: val, portion: portion}}, nil
+}
+
+// dispatch resolves command by registry, parses arguments and runs handler.
+func (r *CmdProcessor) dispatch(ctx context.Context, c tele.Context, cmdParts []string, userID int64) []CmdResponse {
+	spec := findCmdSpec(r.cmds, cmdParts[0])
+	argParts := cmdParts[1:]
+
+	parent := ""
+	if spec != nil && spec.subcmds != nil {
+		parent = spec.name
+		if len(argParts) == 0 {
+			spec = nil
+		} else {
+			spec = findCmdSpec(spec.subcmds, argParts[0])
+			argParts = argParts[1:]
+		}
+	}
+
+	if spec == nil {
+		r.logger.Error(
+			"invalid command",
+			zap.String("reason", "unknown command"),
+			zap.Strings("command", cmdParts),
+			zap.Int64("userid", userID),
+		)
+		return NewErrorCmdResponse(messages.MsgErrInvalidCommand)
+	}
+
+	usage := cmdUsage(parent, spec)
+
+	args, err := r.parseArgs(spec.args, argParts)
+	if err != nil {
+		r.logger.Error(
+			"invalid command",
+			zap.String("reason", "args format"),
+			zap.Strings("command", cmdParts),
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+		return argErrorResponse(err, usage)
+	}
+	args.ctx = ctx
+	args.c = c
+	args.offset = len(cmdParts) - len(argParts)
+	args.usage = usage
+
+	return spec.handler(r, args, userID)
+}
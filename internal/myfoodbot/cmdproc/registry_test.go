@@ -0,0 +1,95 @@
+package cmdproc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type RegistryTestSuite struct {
+	suite.Suite
+
+	proc *CmdProcessor
+}
+
+func (r *RegistryTestSuite) TestParseArgsTime() {
+	specs := []argSpec{_argDate, _argMeal, _argFoodKey, _argTime}
+
+	r.Run("time set", func() {
+		args, err := r.proc.parseArgs(specs, []string{"01.02.2025", "1", "food", "08:30"})
+		r.NoError(err)
+		r.True(args.Has(3))
+		r.Equal(8*time.Hour+30*time.Minute, args.Time(3))
+	})
+
+	r.Run("time omitted", func() {
+		for _, parts := range [][]string{
+			{"01.02.2025", "1", "food"},
+			{"01.02.2025", "1", "food", ""},
+		} {
+			args, err := r.proc.parseArgs(specs, parts)
+			r.NoError(err)
+			r.False(args.Has(3))
+		}
+	})
+
+	r.Run("bad time", func() {
+		_, err := r.proc.parseArgs(specs, []string{"01.02.2025", "1", "food", "8"})
+
+		var argErr *argError
+		r.ErrorAs(err, &argErr)
+		r.Equal(4, argErr.pos)
+	})
+}
+
+func (r *RegistryTestSuite) TestParseArgsBundleMultiplier() {
+	for _, name := range []string{"sb", "ab"} {
+		spec := findCmdSpec(findCmdSpec(r.proc.cmds, "j").subcmds, name)
+		r.Require().NotNil(spec, name)
+
+		args, err := r.proc.parseArgs(spec.args, []string{"01.02.2025", "1", "bundle", "0.5"})
+		r.NoError(err, name)
+		r.True(args.Has(3), name)
+		r.Equal(0.5, args.Float(3), name)
+
+		args, err = r.proc.parseArgs(spec.args, []string{"01.02.2025", "1", "bundle"})
+		r.NoError(err, name)
+		r.False(args.Has(3), name)
+
+		_, err = r.proc.parseArgs(spec.args, []string{"01.02.2025", "1", "bundle", "08:30"})
+		r.Error(err, name)
+	}
+}
+
+func (r *RegistryTestSuite) TestDispatchContext() {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "batch")
+
+	var got context.Context
+	proc := &CmdProcessor{
+		tz:     time.UTC,
+		logger: zap.NewNop(),
+		cmds: []*cmdSpec{{
+			name: "t",
+			handler: func(_ *CmdProcessor, args *cmdArgs, _ int64) []CmdResponse {
+				got = args.ctx
+				return nil
+			},
+		}},
+	}
+
+	proc.dispatch(ctx, nil, []string{"t"}, 1)
+	r.Require().NotNil(got)
+	r.Equal("batch", got.Value(ctxKey{}))
+}
+
+func (r *RegistryTestSuite) SetupTest() {
+	r.proc = &CmdProcessor{tz: time.UTC, cmds: newCommands()}
+}
+
+func TestRegistry(t *testing.T) {
+	suite.Run(t, new(RegistryTestSuite))
+}
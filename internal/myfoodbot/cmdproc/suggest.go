@@ -0,0 +1,158 @@
+package cmdproc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/storage"
+	"go.uber.org/zap"
+	tele "gopkg.in/telebot.v3"
+)
+
+// SuggestCallback is the unique identifier of "did you mean" inline buttons.
+// Button data is index of command part and suggested key, command itself is
+// taken from the message the suggestion replies to.
+const SuggestCallback = "suggest"
+
+// Telegram limit of inline button callback data.
+const _maxCallbackData = 64
+
+type suggestion struct {
+	key   string
+	label string
+}
+
+func (r *CmdProcessor) suggestFood(ctx context.Context, userID int64, key string) []suggestion {
+	lst, err := r.stg.SuggestFood(ctx, userID, key, storage.SuggestLimit)
+	if err != nil {
+		if !errors.Is(err, storage.ErrFoodEmptyList) {
+			r.logger.Error(
+				"suggest food DB error",
+				zap.String("key", key),
+				zap.Int64("userid", userID),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+
+	res := make([]suggestion, 0, len(lst))
+	for _, f := range lst {
+		res = append(res, suggestion{key: f.Key, label: fmt.Sprintf("%s - %s", f.Key, f.Name)})
+	}
+	return res
+}
+
+func (r *CmdProcessor) suggestBundle(ctx context.Context, userID int64, key string) []suggestion {
+	lst, err := r.stg.SuggestBundle(ctx, userID, key, storage.SuggestLimit)
+	if err != nil {
+		if !errors.Is(err, storage.ErrBundleEmptyList) {
+			r.logger.Error(
+				"suggest bundle DB error",
+				zap.String("key", key),
+				zap.Int64("userid", userID),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+
+	res := make([]suggestion, 0, len(lst))
+	for _, b := range lst {
+		res = append(res, suggestion{key: b.Key, label: b.Key})
+	}
+	return res
+}
+
+// suggestResponse returns error message with inline buttons, which re-run
+// command with i-th argument replaced by suggested key.
+func (r *CmdProcessor) suggestResponse(args *cmdArgs, i int, msg string, sgs []suggestion) []CmdResponse {
+	if len(sgs) == 0 {
+		return NewErrorCmdResponse(msg)
+	}
+
+	labels := make([]string, 0, len(sgs))
+	for _, sg := range sgs {
+		labels = append(labels, sg.label)
+	}
+	textResp := NewErrorCmdResponse(fmt.Sprintf("%s\n%s\n%s", msg, messages.MsgSuggest, strings.Join(labels, "\n")))
+
+	// Buttons re-run command from the replied message, so they are not
+	// available for command which is started by button itself or in batch.
+	if r.batch || args.c == nil || args.c.Callback() != nil || args.c.Message() == nil {
+		return textResp
+	}
+
+	partIdx := strconv.Itoa(args.offset + args.pos[i])
+
+	markup := &tele.ReplyMarkup{}
+	rows := make([]tele.Row, 0, len(sgs))
+	for _, sg := range sgs {
+		// Data values are separated by "|" and command parts by ",", such key
+		// can't be passed back.
+		if strings.ContainsAny(sg.key, "|,") {
+			continue
+		}
+
+		btn := markup.Data(sg.label, SuggestCallback, partIdx, sg.key)
+		if len(btn.Data)+len(SuggestCallback)+2 > _maxCallbackData {
+			continue
+		}
+		rows = append(rows, markup.Row(btn))
+	}
+	if len(rows) == 0 {
+		return textResp
+	}
+	markup.Inline(rows...)
+
+	return NewErrorCmdResponse(
+		fmt.Sprintf("%s\n%s", msg, messages.MsgSuggest),
+		&tele.SendOptions{ReplyTo: args.c.Message(), ReplyMarkup: markup},
+	)
+}
+
+// ProcessSuggestion re-runs replied command with suggested key.
+func (r *CmdProcessor) ProcessSuggestion(c tele.Context, userID int64) error {
+	cb := c.Callback()
+	if err := c.Respond(); err != nil {
+		return err
+	}
+
+	data := c.Args()
+	if len(data) != 2 || cb.Message == nil || cb.Message.ReplyTo == nil {
+		r.logger.Error(
+			"invalid suggestion",
+			zap.String("data", cb.Data),
+			zap.Int64("userid", userID),
+		)
+		return c.Send(messages.MsgErrSuggestExpired)
+	}
+
+	cmdParts := strings.Split(cb.Message.ReplyTo.Text, ",")
+	partIdx, err := strconv.Atoi(data[0])
+	if err != nil || partIdx < 0 || partIdx >= len(cmdParts) {
+		r.logger.Error(
+			"invalid suggestion",
+			zap.String("data", cb.Data),
+			zap.String("command", cb.Message.ReplyTo.Text),
+			zap.Int64("userid", userID),
+		)
+		return c.Send(messages.MsgErrSuggestExpired)
+	}
+	cmdParts[partIdx] = data[1]
+
+	// Suggestion is used once.
+	if _, err := c.Bot().EditReplyMarkup(cb.Message, nil); err != nil {
+		r.logger.Error(
+			"suggestion markup edit error",
+			zap.Int64("userid", userID),
+			zap.Error(err),
+		)
+	}
+
+	return r.Process(c, strings.Join(cmdParts, ","), userID)
+}
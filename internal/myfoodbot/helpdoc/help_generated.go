@@ -1,7 +0,0 @@
-package helpdoc
-
-// code generated by go generate. DO NOT EDIT.
-
-func init() {
-	add("help", []byte{31, 139, 8, 0, 0, 0, 0, 0, 0, 255, 236, 93, 221, 110, 27, 199, 245, 191, 207, 83, 76, 24, 32, 150, 1, 82, 180, 29, 252, 255, 45, 28, 137, 23, 113, 18, 20, 5, 140, 22, 104, 131, 194, 151, 75, 114, 37, 209, 165, 72, 130, 187, 146, 106, 160, 23, 164, 24, 199, 9, 228, 88, 181, 157, 52, 128, 155, 216, 113, 221, 139, 94, 174, 40, 173, 180, 34, 69, 234, 21, 206, 188, 66, 158, 164, 56, 103, 102, 63, 103, 86, 252, 16, 41, 203, 129, 114, 225, 144, 203, 221, 217, 243, 125, 126, 231, 204, 217, 213, 210, 251, 159, 254, 225, 206, 159, 239, 253, 241, 51, 182, 102, 175, 87, 11, 239, 45, 225, 255, 88, 213, 168, 173, 46, 103, 204, 90, 166, 240, 30, 99, 75, 107, 166, 81, 198, 15, 140, 45, 173, 155, 182, 193, 74, 107, 70, 211, 50, 237, 229, 204, 134, 189, 146, 251, 109, 134, 229, 163, 63, 214, 140, 117, 115, 57, 179, 89, 49, 183, 26, 245, 166, 157, 97, 165, 122, 205, 54, 107, 246, 114, 102, 171, 82, 182, 215, 150, 203, 230, 102, 165, 100, 230, 232, 75, 150, 85, 106, 21, 187, 98, 84, 115, 86, 201, 168, 154, 203, 55, 195, 165, 236, 138, 93, 53, 11, 119, 31, 124, 94, 175, 151, 63, 169, 219, 44, 199, 224, 103, 222, 129, 30, 12, 161, 11, 67, 56, 224, 109, 190, 141, 159, 150, 242, 226, 76, 113, 85, 181, 82, 251, 43, 125, 98, 108, 173, 105, 174, 44, 103, 214, 108, 187, 97, 221, 206, 231, 203, 230, 102, 181, 108, 108, 62, 40, 215, 55, 23, 87, 43, 246, 218, 70, 113, 177, 82, 207, 151, 44, 43, 95, 172, 215, 109, 203, 110, 26, 141, 240, 211, 226, 122, 165, 182, 88, 178, 172, 140, 92, 170, 105, 86, 151, 51, 150, 253, 160, 106, 90, 107, 166, 105, 139, 195, 68, 232, 82, 94, 136, 6, 63, 22, 235, 229, 7, 146, 140, 114, 101, 147, 149, 170, 134, 101, 45, 103, 144, 123, 163, 82, 51, 155, 153, 130, 92, 45, 250, 171, 81, 42, 213, 155, 229, 74, 189, 150, 97, 149, 114, 228, 235, 239, 204, 106, 35, 184, 32, 229, 146, 92, 197, 54, 215, 35, 39, 161, 158, 110, 169, 103, 33, 129, 145, 187, 203, 51, 139, 27, 182, 93, 175, 197, 142, 49, 245, 90, 113, 86, 38, 113, 154, 253, 160, 97, 46, 103, 244, 191, 149, 13, 219, 200, 21, 173, 156, 93, 95, 93, 173, 154, 200, 126, 181, 106, 52, 44, 51, 245, 60, 163, 185, 138, 134, 244, 129, 127, 226, 93, 163, 162, 44, 106, 52, 43, 70, 206, 252, 91, 195, 168, 149, 205, 242, 114, 198, 110, 110, 40, 235, 209, 41, 40, 235, 102, 189, 106, 133, 183, 85, 87, 43, 36, 46, 92, 42, 22, 224, 37, 236, 241, 111, 192, 5, 151, 193, 16, 78, 193, 227, 109, 112, 96, 0, 30, 184, 75, 249, 98, 66, 112, 121, 193, 119, 76, 236, 249, 181, 91, 177, 239, 229, 202, 102, 236, 34, 84, 109, 58, 69, 138, 212, 253, 83, 89, 240, 193, 90, 171, 111, 197, 47, 242, 229, 215, 48, 154, 228, 91, 31, 196, 77, 231, 189, 52, 134, 245, 150, 132, 166, 155, 81, 36, 211, 72, 30, 97, 12, 158, 194, 144, 111, 179, 208, 45, 225, 148, 183, 192, 133, 3, 24, 128, 3, 71, 248, 47, 127, 4, 46, 12, 24, 28, 64, 159, 239, 50, 222, 193, 239, 124, 27, 28, 6, 93, 112, 81, 178, 12, 60, 6, 167, 184, 14, 93, 186, 135, 231, 129, 11, 39, 124, 135, 63, 100, 208, 3, 7, 250, 48, 228, 45, 240, 224, 56, 73, 81, 94, 33, 105, 169, 81, 128, 103, 112, 4, 14, 120, 112, 130, 113, 1, 92, 56, 150, 177, 193, 3, 151, 241, 54, 131, 61, 188, 23, 12, 225, 132, 193, 144, 183, 121, 7, 117, 45, 79, 161, 91, 243, 109, 222, 230, 187, 130, 166, 182, 96, 199, 143, 46, 120, 13, 134, 156, 19, 50, 136, 3, 61, 1, 105, 82, 226, 45, 112, 228, 205, 29, 188, 11, 131, 46, 163, 207, 199, 112, 2, 71, 48, 132, 1, 184, 236, 179, 141, 102, 189, 97, 230, 239, 214, 173, 82, 125, 43, 203, 72, 130, 109, 18, 205, 16, 92, 232, 37, 46, 224, 59, 68, 38, 28, 168, 247, 196, 195, 125, 254, 152, 206, 235, 130, 195, 183, 193, 37, 13, 224, 138, 168, 13, 100, 96, 192, 119, 224, 152, 145, 160, 78, 80, 75, 72, 211, 0, 73, 27, 67, 208, 81, 195, 169, 154, 77, 155, 209, 191, 185, 70, 179, 178, 110, 52, 31, 100, 88, 179, 142, 254, 78, 7, 51, 5, 248, 15, 169, 240, 4, 233, 72, 72, 176, 92, 217, 28, 75, 134, 47, 194, 139, 144, 107, 84, 241, 1, 56, 252, 137, 175, 173, 46, 227, 95, 134, 55, 65, 223, 141, 152, 31, 26, 79, 86, 40, 224, 136, 108, 162, 79, 71, 7, 194, 198, 112, 173, 83, 190, 75, 70, 113, 124, 91, 185, 245, 82, 169, 94, 54, 11, 165, 250, 250, 186, 81, 43, 103, 173, 141, 162, 255, 209, 104, 174, 222, 196, 127, 110, 101, 23, 23, 23, 151, 242, 116, 218, 120, 38, 250, 61, 111, 67, 223, 183, 123, 252, 232, 50, 240, 196, 145, 3, 24, 70, 40, 18, 4, 146, 121, 242, 199, 194, 187, 134, 176, 71, 170, 220, 201, 162, 49, 12, 25, 25, 142, 199, 120, 135, 148, 218, 231, 187, 190, 76, 82, 238, 157, 16, 164, 39, 45, 40, 170, 149, 20, 1, 147, 28, 15, 209, 66, 224, 132, 145, 99, 236, 99, 112, 20, 142, 171, 220, 77, 81, 109, 226, 64, 242, 235, 251, 185, 28, 195, 96, 197, 114, 185, 203, 146, 233, 130, 136, 91, 190, 208, 156, 151, 12, 217, 76, 205, 121, 43, 70, 85, 93, 48, 37, 233, 169, 203, 233, 146, 222, 43, 82, 255, 144, 127, 195, 31, 179, 133, 181, 235, 179, 207, 116, 42, 25, 163, 51, 221, 229, 72, 114, 223, 137, 192, 41, 34, 106, 199, 143, 40, 152, 205, 90, 26, 8, 234, 80, 12, 69, 55, 229, 15, 201, 177, 40, 19, 49, 138, 251, 167, 20, 130, 186, 224, 161, 59, 223, 150, 145, 101, 109, 204, 208, 49, 141, 67, 221, 49, 170, 245, 102, 197, 180, 88, 201, 168, 150, 174, 60, 235, 142, 81, 45, 221, 49, 170, 51, 116, 46, 237, 138, 58, 255, 250, 153, 18, 249, 35, 145, 248, 7, 34, 83, 97, 156, 141, 1, 28, 182, 80, 42, 205, 193, 245, 180, 68, 254, 10, 188, 47, 20, 41, 122, 93, 92, 146, 227, 58, 161, 62, 221, 43, 71, 25, 43, 148, 74, 217, 15, 171, 246, 199, 20, 41, 251, 236, 218, 250, 181, 191, 95, 91, 185, 246, 225, 170, 253, 177, 56, 252, 12, 211, 52, 91, 128, 30, 236, 47, 94, 15, 15, 255, 76, 40, 50, 137, 168, 240, 191, 5, 222, 134, 147, 232, 169, 207, 96, 8, 71, 146, 171, 109, 182, 128, 176, 128, 111, 211, 239, 34, 66, 40, 107, 140, 137, 54, 126, 136, 2, 33, 74, 229, 2, 121, 19, 34, 34, 234, 240, 51, 5, 177, 224, 246, 224, 176, 2, 187, 49, 46, 192, 21, 198, 141, 186, 64, 161, 238, 96, 20, 12, 113, 244, 27, 2, 45, 93, 2, 152, 143, 17, 12, 59, 4, 46, 16, 156, 14, 96, 8, 251, 168, 151, 31, 133, 18, 17, 30, 35, 12, 130, 35, 132, 34, 108, 1, 222, 192, 83, 248, 241, 58, 203, 17, 212, 81, 239, 219, 19, 167, 147, 13, 72, 136, 142, 7, 201, 18, 178, 244, 51, 94, 199, 91, 124, 7, 177, 255, 54, 49, 183, 141, 52, 50, 178, 147, 125, 89, 215, 29, 33, 174, 239, 10, 27, 195, 37, 93, 191, 92, 17, 96, 139, 183, 225, 20, 220, 80, 128, 42, 29, 135, 180, 200, 128, 42, 31, 151, 144, 164, 235, 243, 71, 116, 121, 139, 227, 105, 234, 223, 224, 64, 15, 14, 145, 216, 150, 42, 210, 0, 139, 241, 142, 95, 152, 208, 61, 5, 144, 119, 3, 206, 137, 17, 134, 75, 225, 173, 161, 235, 147, 193, 119, 225, 228, 246, 152, 42, 197, 144, 245, 26, 60, 56, 224, 187, 252, 17, 56, 124, 247, 54, 134, 161, 2, 9, 131, 112, 42, 213, 9, 136, 185, 145, 115, 169, 1, 232, 129, 135, 120, 21, 139, 191, 125, 74, 142, 88, 125, 244, 16, 100, 242, 118, 116, 177, 88, 45, 52, 150, 104, 180, 4, 254, 139, 32, 104, 79, 71, 94, 0, 153, 7, 84, 83, 82, 45, 196, 191, 166, 26, 48, 130, 162, 15, 145, 226, 0, 122, 247, 195, 229, 84, 21, 239, 83, 8, 145, 229, 24, 120, 120, 26, 187, 249, 75, 235, 249, 71, 126, 89, 225, 72, 136, 236, 138, 2, 131, 63, 153, 158, 175, 215, 190, 114, 249, 238, 25, 156, 161, 19, 13, 200, 216, 40, 26, 182, 169, 152, 104, 201, 210, 150, 183, 97, 200, 34, 86, 130, 196, 123, 113, 205, 160, 173, 156, 128, 167, 80, 240, 209, 47, 173, 231, 255, 39, 185, 154, 138, 39, 31, 78, 246, 201, 240, 190, 18, 22, 122, 150, 146, 100, 233, 143, 206, 37, 66, 5, 106, 229, 255, 127, 105, 61, 255, 77, 10, 25, 19, 201, 178, 67, 222, 219, 74, 220, 60, 106, 129, 40, 173, 46, 223, 21, 18, 165, 175, 26, 195, 70, 161, 110, 147, 232, 14, 80, 194, 217, 192, 108, 36, 23, 202, 221, 181, 92, 221, 138, 155, 203, 129, 140, 138, 120, 199, 54, 45, 56, 132, 46, 223, 33, 101, 33, 225, 116, 242, 161, 244, 114, 15, 29, 88, 81, 216, 76, 112, 226, 23, 150, 217, 100, 150, 105, 219, 149, 218, 170, 117, 133, 19, 191, 248, 211, 12, 33, 98, 114, 177, 180, 234, 75, 237, 214, 60, 150, 150, 231, 198, 251, 64, 199, 100, 73, 11, 27, 214, 28, 160, 98, 146, 216, 119, 5, 37, 38, 186, 26, 126, 183, 49, 0, 123, 253, 16, 253, 232, 59, 99, 190, 172, 253, 48, 25, 147, 182, 19, 30, 126, 4, 30, 253, 95, 230, 98, 213, 235, 219, 126, 93, 183, 97, 141, 223, 19, 66, 31, 180, 76, 59, 230, 121, 26, 193, 140, 108, 180, 169, 130, 121, 35, 208, 28, 65, 173, 46, 101, 173, 100, 79, 81, 33, 111, 138, 150, 28, 56, 19, 0, 233, 13, 43, 107, 153, 182, 192, 188, 4, 240, 66, 8, 252, 143, 16, 179, 168, 144, 38, 199, 59, 212, 171, 236, 35, 136, 32, 109, 62, 57, 55, 56, 86, 185, 138, 161, 101, 36, 129, 72, 204, 178, 56, 109, 162, 55, 125, 38, 109, 8, 160, 23, 17, 239, 186, 152, 53, 124, 52, 236, 97, 54, 139, 245, 218, 84, 19, 74, 20, 54, 177, 106, 81, 220, 82, 246, 36, 75, 147, 116, 29, 85, 86, 207, 148, 54, 211, 112, 4, 123, 152, 254, 144, 25, 70, 219, 34, 138, 43, 161, 27, 33, 151, 210, 255, 98, 140, 168, 247, 71, 176, 252, 37, 120, 252, 43, 196, 184, 224, 228, 5, 50, 139, 30, 138, 144, 116, 36, 250, 214, 148, 47, 179, 44, 4, 17, 120, 156, 127, 75, 56, 127, 59, 76, 168, 212, 76, 247, 155, 152, 200, 158, 42, 229, 47, 125, 132, 28, 201, 241, 110, 12, 54, 251, 66, 9, 235, 74, 143, 10, 188, 136, 217, 75, 77, 24, 82, 17, 215, 199, 244, 245, 213, 185, 248, 250, 171, 120, 141, 156, 204, 27, 227, 251, 122, 194, 181, 131, 128, 150, 93, 53, 109, 201, 105, 106, 20, 155, 7, 99, 255, 37, 172, 134, 224, 104, 32, 138, 159, 104, 68, 243, 102, 206, 165, 149, 202, 228, 52, 216, 234, 47, 102, 101, 117, 205, 190, 2, 85, 66, 14, 51, 4, 86, 186, 5, 117, 224, 234, 77, 18, 5, 160, 99, 136, 30, 8, 150, 74, 11, 91, 115, 192, 81, 58, 218, 46, 39, 150, 154, 4, 58, 133, 66, 211, 97, 161, 8, 246, 217, 26, 21, 37, 102, 16, 255, 116, 208, 70, 118, 182, 102, 139, 97, 10, 91, 33, 94, 249, 142, 0, 163, 195, 238, 222, 93, 252, 244, 211, 197, 123, 247, 238, 221, 11, 193, 75, 2, 57, 132, 216, 100, 154, 62, 93, 104, 163, 103, 116, 226, 194, 141, 196, 3, 73, 23, 156, 250, 241, 145, 239, 250, 27, 132, 180, 191, 39, 202, 78, 202, 233, 110, 172, 63, 71, 248, 183, 71, 219, 224, 142, 72, 221, 180, 82, 154, 230, 202, 102, 117, 6, 154, 19, 123, 149, 10, 167, 147, 69, 234, 173, 108, 217, 172, 158, 165, 150, 51, 140, 240, 173, 200, 174, 90, 153, 75, 118, 20, 157, 103, 31, 32, 121, 224, 192, 41, 181, 24, 134, 98, 238, 194, 209, 236, 231, 207, 27, 226, 111, 101, 145, 215, 184, 110, 224, 181, 214, 107, 130, 159, 95, 105, 58, 188, 76, 171, 209, 105, 27, 225, 23, 168, 241, 105, 128, 194, 231, 245, 122, 249, 10, 38, 160, 20, 102, 8, 18, 212, 229, 198, 134, 8, 46, 117, 250, 142, 217, 194, 202, 28, 0, 130, 74, 215, 187, 15, 15, 124, 129, 141, 0, 7, 43, 111, 9, 28, 184, 200, 193, 252, 99, 223, 74, 136, 22, 94, 64, 159, 63, 225, 143, 194, 96, 247, 147, 156, 76, 115, 37, 93, 78, 8, 21, 196, 9, 79, 101, 207, 248, 32, 60, 244, 2, 94, 96, 178, 188, 121, 227, 70, 228, 52, 112, 19, 71, 254, 9, 30, 111, 197, 142, 188, 129, 253, 196, 57, 130, 35, 57, 234, 5, 142, 216, 41, 61, 127, 88, 149, 92, 178, 28, 154, 197, 64, 236, 141, 200, 237, 47, 218, 235, 241, 43, 179, 158, 72, 80, 125, 25, 55, 105, 87, 128, 204, 133, 198, 157, 168, 161, 224, 165, 220, 34, 69, 110, 44, 199, 228, 164, 97, 120, 200, 87, 179, 118, 157, 64, 188, 120, 165, 216, 7, 242, 232, 106, 218, 35, 246, 91, 129, 114, 13, 182, 16, 108, 34, 211, 222, 64, 116, 91, 143, 246, 145, 104, 143, 195, 189, 158, 42, 22, 95, 111, 120, 179, 163, 36, 184, 11, 26, 27, 93, 118, 243, 198, 13, 216, 95, 76, 35, 89, 170, 90, 187, 8, 141, 130, 137, 17, 148, 145, 11, 249, 22, 162, 93, 232, 208, 223, 165, 24, 185, 140, 111, 86, 186, 101, 120, 7, 127, 164, 148, 57, 164, 88, 48, 122, 57, 173, 77, 158, 71, 242, 20, 65, 74, 23, 211, 73, 237, 169, 196, 135, 221, 47, 97, 68, 231, 234, 69, 172, 100, 173, 82, 74, 36, 25, 225, 203, 111, 175, 63, 19, 78, 250, 42, 109, 154, 83, 162, 211, 65, 170, 101, 72, 232, 206, 72, 74, 106, 188, 29, 33, 134, 149, 74, 173, 124, 254, 36, 243, 10, 227, 7, 111, 67, 111, 68, 114, 73, 163, 27, 169, 16, 148, 71, 36, 56, 178, 114, 81, 229, 255, 114, 196, 28, 179, 79, 100, 24, 104, 93, 140, 187, 34, 202, 137, 102, 111, 155, 212, 116, 74, 13, 83, 87, 182, 123, 249, 215, 33, 85, 114, 195, 82, 116, 124, 119, 249, 67, 93, 190, 36, 225, 103, 89, 90, 180, 206, 178, 72, 252, 69, 123, 208, 218, 240, 120, 57, 231, 25, 141, 68, 200, 168, 45, 248, 28, 136, 128, 72, 145, 1, 92, 118, 243, 70, 130, 223, 183, 81, 149, 209, 214, 174, 36, 97, 24, 152, 201, 57, 173, 29, 9, 30, 97, 222, 202, 184, 223, 108, 120, 138, 14, 177, 241, 111, 105, 6, 164, 5, 251, 212, 222, 15, 187, 232, 152, 210, 131, 93, 127, 57, 255, 50, 25, 227, 163, 176, 88, 32, 9, 100, 51, 37, 62, 202, 162, 88, 154, 120, 151, 193, 62, 111, 45, 78, 214, 146, 153, 95, 187, 99, 170, 96, 17, 52, 59, 198, 139, 114, 58, 49, 254, 36, 211, 232, 145, 128, 240, 130, 42, 79, 140, 147, 32, 77, 157, 232, 208, 148, 0, 111, 29, 26, 71, 138, 239, 246, 240, 78, 24, 93, 186, 12, 14, 121, 135, 183, 8, 135, 245, 253, 241, 26, 15, 92, 100, 65, 165, 0, 93, 225, 155, 200, 204, 205, 158, 228, 179, 15, 238, 40, 141, 76, 83, 82, 127, 178, 81, 43, 87, 205, 171, 137, 6, 243, 147, 90, 121, 150, 99, 175, 234, 114, 186, 170, 250, 169, 175, 92, 196, 210, 197, 57, 20, 209, 42, 25, 239, 126, 17, 29, 122, 132, 152, 58, 8, 235, 230, 226, 132, 222, 30, 136, 31, 43, 179, 111, 69, 119, 75, 12, 127, 157, 146, 31, 182, 18, 165, 49, 229, 228, 3, 58, 97, 95, 12, 242, 133, 180, 12, 197, 180, 18, 225, 218, 61, 190, 19, 212, 116, 251, 186, 177, 204, 3, 26, 175, 75, 240, 149, 192, 30, 241, 184, 145, 150, 31, 222, 242, 164, 68, 68, 23, 23, 222, 66, 45, 42, 109, 132, 24, 61, 209, 74, 64, 254, 74, 34, 188, 45, 122, 233, 202, 207, 154, 91, 160, 158, 40, 107, 183, 168, 218, 162, 65, 220, 228, 29, 130, 39, 155, 166, 108, 14, 104, 112, 81, 228, 38, 226, 217, 179, 67, 20, 121, 204, 106, 232, 209, 167, 199, 98, 235, 93, 130, 166, 142, 120, 244, 173, 23, 55, 82, 1, 249, 34, 81, 6, 122, 100, 150, 39, 74, 58, 97, 140, 30, 227, 60, 145, 19, 199, 100, 134, 123, 124, 119, 156, 13, 123, 132, 155, 120, 129, 216, 90, 119, 40, 129, 251, 83, 132, 29, 162, 241, 40, 57, 243, 220, 139, 233, 196, 111, 45, 147, 102, 210, 118, 115, 46, 101, 113, 54, 185, 3, 232, 209, 75, 49, 89, 162, 41, 150, 54, 2, 208, 206, 4, 164, 195, 235, 40, 20, 143, 5, 183, 73, 217, 25, 3, 131, 207, 2, 58, 106, 162, 84, 18, 75, 206, 78, 69, 73, 128, 57, 137, 142, 38, 69, 155, 193, 202, 137, 49, 253, 99, 198, 119, 147, 5, 108, 52, 76, 121, 112, 18, 246, 16, 101, 20, 72, 201, 66, 103, 9, 102, 6, 208, 242, 247, 245, 141, 102, 205, 168, 94, 65, 75, 41, 136, 25, 162, 75, 237, 138, 99, 111, 219, 68, 203, 17, 26, 85, 136, 20, 36, 97, 1, 178, 112, 127, 14, 120, 84, 75, 248, 229, 132, 164, 103, 2, 149, 81, 24, 117, 60, 17, 135, 200, 245, 254, 37, 157, 132, 141, 108, 60, 232, 121, 184, 112, 212, 119, 127, 188, 81, 147, 87, 62, 173, 1, 165, 99, 1, 62, 66, 35, 74, 143, 36, 222, 25, 153, 29, 208, 147, 29, 61, 7, 6, 124, 155, 250, 140, 26, 1, 223, 102, 240, 3, 90, 151, 120, 56, 11, 122, 89, 6, 223, 137, 135, 218, 247, 104, 59, 209, 201, 50, 120, 41, 62, 103, 229, 128, 163, 124, 158, 69, 158, 170, 194, 188, 14, 61, 142, 53, 160, 75, 223, 136, 207, 116, 169, 75, 189, 199, 30, 98, 159, 41, 57, 250, 62, 129, 250, 144, 53, 9, 82, 97, 8, 199, 57, 129, 242, 194, 180, 116, 28, 225, 57, 18, 121, 252, 222, 135, 63, 124, 48, 132, 227, 235, 89, 253, 19, 110, 136, 85, 248, 67, 18, 205, 32, 214, 211, 85, 151, 205, 77, 195, 99, 162, 120, 72, 217, 168, 162, 83, 232, 77, 29, 112, 20, 246, 51, 163, 123, 170, 111, 123, 220, 194, 15, 28, 197, 11, 175, 11, 3, 52, 114, 41, 99, 73, 113, 110, 161, 68, 11, 13, 167, 140, 29, 90, 192, 57, 185, 45, 38, 0, 253, 85, 148, 250, 213, 68, 169, 119, 115, 204, 113, 140, 226, 237, 50, 6, 141, 17, 67, 149, 179, 1, 32, 87, 88, 99, 4, 71, 115, 247, 98, 249, 48, 143, 230, 117, 79, 44, 197, 151, 130, 199, 123, 174, 160, 70, 142, 149, 215, 47, 34, 62, 92, 120, 72, 64, 255, 95, 159, 214, 253, 207, 247, 52, 218, 149, 15, 95, 126, 31, 190, 120, 63, 43, 53, 230, 224, 103, 47, 228, 139, 29, 91, 177, 97, 194, 203, 146, 128, 75, 141, 196, 100, 252, 144, 111, 163, 196, 232, 121, 195, 51, 189, 81, 151, 114, 149, 244, 28, 44, 59, 214, 146, 26, 7, 159, 89, 214, 150, 175, 246, 233, 169, 218, 16, 157, 39, 237, 214, 74, 96, 121, 158, 214, 91, 98, 194, 74, 46, 173, 125, 79, 67, 204, 156, 83, 22, 157, 96, 193, 73, 131, 131, 216, 88, 149, 239, 44, 76, 188, 54, 211, 23, 196, 161, 220, 115, 10, 95, 252, 225, 131, 70, 222, 137, 69, 151, 240, 141, 58, 58, 91, 206, 170, 193, 47, 30, 68, 80, 122, 95, 131, 7, 123, 225, 120, 163, 162, 152, 241, 2, 69, 96, 63, 98, 192, 204, 31, 250, 16, 241, 139, 63, 242, 199, 132, 153, 255, 82, 146, 36, 211, 98, 163, 78, 60, 105, 188, 199, 119, 196, 107, 66, 196, 155, 51, 6, 226, 253, 24, 151, 38, 66, 53, 207, 63, 72, 168, 177, 14, 212, 185, 124, 191, 79, 215, 127, 81, 231, 144, 111, 139, 225, 171, 49, 131, 146, 126, 163, 231, 126, 182, 89, 126, 151, 158, 154, 106, 110, 205, 77, 190, 225, 43, 101, 30, 207, 92, 198, 91, 83, 203, 88, 165, 246, 149, 216, 31, 151, 65, 98, 24, 188, 101, 215, 141, 111, 148, 9, 23, 162, 166, 124, 228, 117, 57, 224, 101, 69, 84, 164, 105, 188, 112, 202, 61, 140, 21, 225, 200, 151, 126, 43, 45, 120, 179, 170, 71, 80, 37, 184, 95, 186, 172, 46, 139, 233, 52, 231, 96, 58, 47, 125, 174, 229, 123, 182, 228, 156, 191, 124, 0, 143, 222, 53, 4, 158, 230, 165, 188, 23, 1, 29, 154, 205, 73, 159, 185, 123, 169, 89, 232, 29, 125, 230, 78, 42, 221, 158, 71, 101, 22, 25, 162, 16, 239, 225, 8, 52, 36, 173, 32, 200, 183, 188, 19, 73, 120, 242, 153, 76, 196, 9, 157, 121, 215, 109, 246, 133, 212, 109, 111, 65, 161, 43, 198, 28, 20, 250, 58, 242, 106, 180, 99, 127, 52, 71, 191, 143, 41, 180, 184, 63, 129, 75, 167, 37, 133, 21, 67, 55, 178, 53, 58, 233, 206, 177, 117, 162, 74, 230, 57, 50, 170, 125, 1, 97, 116, 190, 201, 129, 30, 67, 94, 82, 116, 201, 114, 236, 166, 148, 89, 54, 161, 111, 245, 142, 242, 26, 148, 195, 40, 27, 156, 104, 82, 35, 242, 69, 126, 20, 159, 173, 82, 179, 210, 176, 153, 213, 44, 141, 250, 139, 16, 247, 245, 127, 16, 162, 72, 99, 197, 244, 119, 33, 238, 91, 153, 194, 82, 94, 172, 88, 160, 63, 1, 33, 254, 240, 195, 82, 94, 252, 253, 140, 255, 5, 0, 0, 255, 255, 180, 50, 87, 223, 80, 99, 0, 0})
-}
@@ -25,7 +25,7 @@ func NewService(settings *ServiceSettings, logger *zap.Logger) (*Service, error)
 
 	return &Service{
 		settings: settings,
-		cmdProc:  cmdproc.NewCmdProcessor(stg, settings.TZ, settings.DebugMode, logger),
+		cmdProc:  cmdproc.NewCmdProcessor(stg, settings.TZ, settings.WebURL, settings.DebugMode, logger),
 	}, nil
 }
 
@@ -58,6 +58,8 @@ func (s *Service) setupRouting(b *tele.Bot, allowedUserIDs []int64) {
 	allowedGroup := b.Group()
 	allowedGroup.Use(middleware.Whitelist(allowedUserIDs...))
 	allowedGroup.Handle(tele.OnText, s.onText)
+	allowedGroup.Handle(tele.OnDocument, s.onDocument)
+	allowedGroup.Handle(&tele.InlineButton{Unique: cmdproc.SuggestCallback}, s.onSuggest)
 }
 
 func (s *Service) onStart(c tele.Context) error {
@@ -73,3 +75,11 @@ func (s *Service) onStart(c tele.Context) error {
 func (s *Service) onText(c tele.Context) error {
 	return s.cmdProc.Process(c, c.Text(), c.Sender().ID)
 }
+
+func (s *Service) onDocument(c tele.Context) error {
+	return s.cmdProc.Process(c, c.Message().Caption, c.Sender().ID)
+}
+
+func (s *Service) onSuggest(c tele.Context) error {
+	return s.cmdProc.ProcessSuggestion(c, c.Sender().ID)
+}
@@ -9,6 +9,7 @@ type ServiceSettings struct {
 	DBFilePath     string
 	AllowedUserIDs []int64
 	TZ             *time.Location
+	WebURL         string
 	DebugMode      bool
 }
 
@@ -18,6 +19,7 @@ func NewServiceSettings(
 	dbFilePath string,
 	alloweUserIDs []int64,
 	stz string,
+	webURL string,
 	buildVersion string,
 	debugMode bool) (*ServiceSettings, error) {
 
@@ -33,6 +35,7 @@ func NewServiceSettings(
 		DBFilePath:     dbFilePath,
 		AllowedUserIDs: alloweUserIDs,
 		TZ:             tz,
+		WebURL:         webURL,
 		DebugMode:      debugMode,
 	}, nil
 }
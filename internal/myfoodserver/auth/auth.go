@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/common/weblogin"
+	"github.com/devldavydov/myfood/internal/myfoodserver/model"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	SessionName      = "MyFoodSession"
+	SessionTTL       = 30 * 24 * time.Hour
+	TelegramLoginTTL = 24 * time.Hour
+)
+
+// Auth authenticates HTTP users by Telegram user ID,
+// same as bot allowed users.
+type Auth struct {
+	token          string
+	sessionKey     []byte
+	telegramKey    []byte
+	allowedUserIDs map[int64]struct{}
+	// Session cookie is sent over HTTPS only.
+	secureCookie bool
+	logger       *zap.Logger
+
+	// Used weblogin token nonces -> expiration. Nonces are kept in memory
+	// only, so tokens issued before start are rejected to prevent replay
+	// after restart.
+	usedNonces   map[string]time.Time
+	usedNoncesMu sync.Mutex
+	startedAt    time.Time
+}
+
+func NewAuth(token string, allowedUserIDs []int64, secureCookie bool, logger *zap.Logger) *Auth {
+	sessionMac := hmac.New(sha256.New, []byte(token))
+	sessionMac.Write([]byte(SessionName))
+
+	telegramKey := sha256.Sum256([]byte(token))
+
+	allowed := make(map[int64]struct{}, len(allowedUserIDs))
+	for _, id := range allowedUserIDs {
+		allowed[id] = struct{}{}
+	}
+
+	return &Auth{
+		token:          token,
+		usedNonces:     make(map[string]time.Time),
+		startedAt:      time.Now().Truncate(time.Second),
+		sessionKey:     sessionMac.Sum(nil),
+		telegramKey:    telegramKey[:],
+		allowedUserIDs: allowed,
+		secureCookie:   secureCookie,
+		logger:         logger,
+	}
+}
+
+// Middleware sets request user ID from session or aborts request.
+func (r *Auth) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, err := c.Cookie(SessionName)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, model.NewErrorResponse(messages.MsgErrUnauthorized))
+			return
+		}
+
+		userID, ok := r.parseSession(value)
+		if !ok || !r.isAllowed(userID) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, model.NewErrorResponse(messages.MsgErrUnauthorized))
+			return
+		}
+
+		c.Set(model.UserIDKey, userID)
+		c.Next()
+	}
+}
+
+func (r *Auth) LoginTelegramAPI(c *gin.Context) {
+	req := &TelegramLoginData{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	if !r.verifyTelegramLogin(req) || !r.isAllowed(req.ID) {
+		r.logger.Error(
+			"auth telegram login api failed",
+			zap.Int64("userid", req.ID),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrUnauthorized))
+		return
+	}
+
+	r.startSession(c, req.ID)
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+// WebLoginAPI redeems one-time token issued by bot and redirects to UI.
+func (r *Auth) WebLoginAPI(c *gin.Context) {
+	token, err := weblogin.ParseToken(r.token, c.Query("token"))
+	if err != nil || !r.isAllowed(token.UserID) || !r.issuedAfterStart(token) || !r.useNonce(token) {
+		r.logger.Error(
+			"auth weblogin api failed",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusUnauthorized, model.NewErrorResponse(messages.MsgErrUnauthorized))
+		return
+	}
+
+	r.startSession(c, token.UserID)
+	c.Redirect(http.StatusFound, "/")
+}
+
+func (r *Auth) LogoutAPI(c *gin.Context) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(SessionName, "", -1, "/", "", r.secureCookie, true)
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+func (r *Auth) MeAPI(c *gin.Context) {
+	c.JSON(http.StatusOK, model.NewDataResponse(model.GetUserID(c)))
+}
+
+func (r *Auth) startSession(c *gin.Context, userID int64) {
+	value, expires := r.newSession(userID)
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(SessionName, value, int(time.Until(expires).Seconds()), "/", "", r.secureCookie, true)
+}
+
+func (r *Auth) issuedAfterStart(token *weblogin.Token) bool {
+	return !token.Issued().Before(r.startedAt)
+}
+
+func (r *Auth) useNonce(token *weblogin.Token) bool {
+	r.usedNoncesMu.Lock()
+	defer r.usedNoncesMu.Unlock()
+
+	now := time.Now()
+	for nonce, expires := range r.usedNonces {
+		if now.After(expires) {
+			delete(r.usedNonces, nonce)
+		}
+	}
+
+	if _, ok := r.usedNonces[token.Nonce]; ok {
+		return false
+	}
+
+	r.usedNonces[token.Nonce] = token.Expires
+	return true
+}
+
+func (r *Auth) isAllowed(userID int64) bool {
+	_, ok := r.allowedUserIDs[userID]
+	return ok
+}
@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devldavydov/myfood/internal/common/weblogin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+const _testToken = "123456:token"
+
+type AuthTestSuite struct {
+	suite.Suite
+
+	auth *Auth
+}
+
+func (r *AuthTestSuite) TestSession() {
+	r.Run("valid session", func() {
+		value, expires := r.auth.newSession(1)
+		r.True(expires.After(time.Now()))
+
+		userID, ok := r.auth.parseSession(value)
+		r.True(ok)
+		r.Equal(int64(1), userID)
+	})
+
+	r.Run("expired session", func() {
+		payload := fmt.Sprintf("%d.%d", 1, time.Now().Add(-time.Minute).Unix())
+
+		_, ok := r.auth.parseSession(payload + "." + r.auth.sign(payload))
+		r.False(ok)
+	})
+
+	r.Run("tampered payload", func() {
+		value, _ := r.auth.newSession(1)
+
+		_, ok := r.auth.parseSession("2" + value[1:])
+		r.False(ok)
+	})
+
+	r.Run("tampered signature", func() {
+		value, _ := r.auth.newSession(1)
+		i := strings.LastIndex(value, ".")
+
+		_, ok := r.auth.parseSession(value[:i+1] + strings.Repeat("0", len(value)-i-1))
+		r.False(ok)
+	})
+
+	r.Run("signed with other token", func() {
+		other := NewAuth("654321:other", []int64{1}, true, zap.NewNop())
+		value, _ := other.newSession(1)
+
+		_, ok := r.auth.parseSession(value)
+		r.False(ok)
+	})
+
+	r.Run("malformed session", func() {
+		for _, value := range []string{"", "abc", "1.abc", "1.2.3.4"} {
+			_, ok := r.auth.parseSession(value)
+			r.False(ok, value)
+		}
+	})
+}
+
+func (r *AuthTestSuite) TestTelegramLogin() {
+	r.Run("valid login", func() {
+		data := r.telegramLogin(1, time.Now())
+		r.True(r.auth.verifyTelegramLogin(data))
+	})
+
+	r.Run("bad hash", func() {
+		data := r.telegramLogin(1, time.Now())
+		data.Hash = strings.Repeat("0", len(data.Hash))
+		r.False(r.auth.verifyTelegramLogin(data))
+	})
+
+	r.Run("changed data", func() {
+		data := r.telegramLogin(1, time.Now())
+		data.ID = 2
+		r.False(r.auth.verifyTelegramLogin(data))
+	})
+
+	r.Run("stale auth date", func() {
+		data := r.telegramLogin(1, time.Now().Add(-TelegramLoginTTL-time.Minute))
+		r.False(r.auth.verifyTelegramLogin(data))
+	})
+}
+
+func (r *AuthTestSuite) TestUseNonce() {
+	s, err := weblogin.NewToken(_testToken, 1)
+	r.NoError(err)
+	token, err := weblogin.ParseToken(_testToken, s)
+	r.NoError(err)
+
+	r.Run("first use", func() {
+		r.True(r.auth.useNonce(token))
+	})
+
+	r.Run("reuse rejected", func() {
+		r.False(r.auth.useNonce(token))
+	})
+
+	r.Run("expired nonces are removed", func() {
+		r.True(r.auth.useNonce(&weblogin.Token{UserID: 1, Nonce: "old", Expires: time.Now().Add(-time.Minute)}))
+		r.True(r.auth.useNonce(&weblogin.Token{UserID: 1, Nonce: "new", Expires: time.Now().Add(time.Minute)}))
+		r.NotContains(r.auth.usedNonces, "old")
+		r.Contains(r.auth.usedNonces, token.Nonce)
+	})
+}
+
+func (r *AuthTestSuite) TestIssuedAfterStart() {
+	r.Run("token issued after start", func() {
+		s, err := weblogin.NewToken(_testToken, 1)
+		r.NoError(err)
+		token, err := weblogin.ParseToken(_testToken, s)
+		r.NoError(err)
+
+		r.True(r.auth.issuedAfterStart(token))
+	})
+
+	r.Run("token issued before restart", func() {
+		s, err := weblogin.NewToken(_testToken, 1)
+		r.NoError(err)
+		token, err := weblogin.ParseToken(_testToken, s)
+		r.NoError(err)
+
+		restarted := NewAuth(_testToken, []int64{1}, true, zap.NewNop())
+		restarted.startedAt = restarted.startedAt.Add(time.Second)
+		r.False(restarted.issuedAfterStart(token))
+	})
+}
+
+// telegramLogin returns login data signed like Telegram Login Widget does.
+func (r *AuthTestSuite) telegramLogin(userID int64, authDate time.Time) *TelegramLoginData {
+	data := &TelegramLoginData{
+		ID:        userID,
+		FirstName: "John",
+		Username:  "john",
+		AuthDate:  authDate.Unix(),
+	}
+
+	check := fmt.Sprintf("auth_date=%d\nfirst_name=%s\nid=%d\nusername=%s", data.AuthDate, data.FirstName, data.ID, data.Username)
+	key := sha256.Sum256([]byte(_testToken))
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write([]byte(check))
+	data.Hash = hex.EncodeToString(mac.Sum(nil))
+
+	return data
+}
+
+func (r *AuthTestSuite) SetupTest() {
+	r.auth = NewAuth(_testToken, []int64{1}, true, zap.NewNop())
+}
+
+func TestAuth(t *testing.T) {
+	suite.Run(t, new(AuthTestSuite))
+}
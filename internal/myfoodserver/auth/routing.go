@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Auth) Attach(group *gin.RouterGroup) {
+	group.POST("/telegram", r.LoginTelegramAPI)
+	group.GET("/weblogin", r.WebLoginAPI)
+	group.POST("/logout", r.LogoutAPI)
+	group.GET("/me", r.Middleware(), r.MeAPI)
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Session cookie value format: <userid>.<expires unix>.<hex hmac>.
+
+func (r *Auth) newSession(userID int64) (string, time.Time) {
+	expires := time.Now().Add(SessionTTL)
+	payload := fmt.Sprintf("%d.%d", userID, expires.Unix())
+	return payload + "." + r.sign(payload), expires
+}
+
+func (r *Auth) parseSession(value string) (int64, bool) {
+	i := strings.LastIndex(value, ".")
+	if i == -1 {
+		return 0, false
+	}
+
+	payload, sign := value[:i], value[i+1:]
+	if !hmac.Equal([]byte(sign), []byte(r.sign(payload))) {
+		return 0, false
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 2 {
+		return 0, false
+	}
+
+	userID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (r *Auth) sign(payload string) string {
+	mac := hmac.New(sha256.New, r.sessionKey)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
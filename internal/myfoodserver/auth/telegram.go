@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TelegramLoginData is the user data sent by Telegram Login Widget.
+// See https://core.telegram.org/widgets/login#checking-authorization.
+type TelegramLoginData struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Username  string `json:"username"`
+	PhotoURL  string `json:"photo_url"`
+	AuthDate  int64  `json:"auth_date"`
+	Hash      string `json:"hash"`
+}
+
+func (r *Auth) verifyTelegramLogin(data *TelegramLoginData) bool {
+	if time.Since(time.Unix(data.AuthDate, 0)) > TelegramLoginTTL {
+		return false
+	}
+
+	fields := []string{
+		fmt.Sprintf("auth_date=%d", data.AuthDate),
+		fmt.Sprintf("id=%d", data.ID),
+	}
+	for k, v := range map[string]string{
+		"first_name": data.FirstName,
+		"last_name":  data.LastName,
+		"username":   data.Username,
+		"photo_url":  data.PhotoURL,
+	} {
+		if v != "" {
+			fields = append(fields, k+"="+v)
+		}
+	}
+	sort.Strings(fields)
+
+	mac := hmac.New(sha256.New, r.telegramKey)
+	mac.Write([]byte(strings.Join(fields, "\n")))
+
+	return hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(data.Hash))
+}
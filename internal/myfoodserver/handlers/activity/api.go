@@ -0,0 +1,125 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/myfoodserver/model"
+	"github.com/devldavydov/myfood/internal/storage"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type ActivityHandler struct {
+	stg    storage.Storage
+	logger *zap.Logger
+}
+
+func NewActivityHander(stg storage.Storage, logger *zap.Logger) *ActivityHandler {
+	return &ActivityHandler{stg: stg, logger: logger}
+}
+
+type ActivityItem struct {
+	Date      string  `json:"date"`
+	ActiveCal float64 `json:"activeCal"`
+}
+
+func (r *ActivityHandler) ListAPI(c *gin.Context) {
+	from, to, ok := model.ParseDateRange(c)
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	lst, err := r.stg.GetActivityList(ctx, model.GetUserID(c), from, to)
+	if err != nil && !errors.Is(err, storage.ErrActivityEmptyList) {
+		r.logger.Error(
+			"activity list api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	data := make([]ActivityItem, 0, len(lst))
+	for _, a := range lst {
+		data = append(data, ActivityItem{
+			Date:      model.FormatDate(a.Timestamp),
+			ActiveCal: a.ActiveCal,
+		})
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(data))
+}
+
+func (r *ActivityHandler) SetAPI(c *gin.Context) {
+	req := &ActivityItem{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	ts, err := model.ParseDate(req.Date)
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	a := &storage.Activity{Timestamp: ts, ActiveCal: req.ActiveCal}
+	if !a.Validate() {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetActivity(ctx, model.GetUserID(c), a); err != nil {
+		if errors.Is(err, storage.ErrActivityInvalid) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+			return
+		}
+
+		r.logger.Error(
+			"activity set api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+func (r *ActivityHandler) DeleteAPI(c *gin.Context) {
+	ts, err := model.ParseDate(c.Param("date"))
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Delete from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.DeleteActivity(ctx, model.GetUserID(c), ts); err != nil {
+		r.logger.Error(
+			"activity del api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
@@ -0,0 +1,15 @@
+package activity
+
+import (
+	"github.com/devldavydov/myfood/internal/storage"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func Attach(group *gin.RouterGroup, stg storage.Storage, logger *zap.Logger) {
+	activityHandler := NewActivityHander(stg, logger)
+
+	group.GET("/", activityHandler.ListAPI)
+	group.POST("/set", activityHandler.SetAPI)
+	group.DELETE("/:date", activityHandler.DeleteAPI)
+}
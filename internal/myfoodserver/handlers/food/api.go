@@ -30,15 +30,31 @@ type FoodItem struct {
 	Prot100 float64 `json:"prot100"`
 	Fat100  float64 `json:"fat100"`
 	Carb100 float64 `json:"carb100"`
-	Comment string  `json:"comment"`
+	// Extended nutrients, null if unknown.
+	Fiber100  *float64 `json:"fiber100"`
+	Sugar100  *float64 `json:"sugar100"`
+	SatFat100 *float64 `json:"satFat100"`
+	Salt100   *float64 `json:"salt100"`
+	// Portion name to grams, null keeps existing portions on update.
+	Portions map[string]float64 `json:"portions"`
+	Comment  string             `json:"comment"`
+	Private  bool               `json:"private"`
 }
 
+// ListAPI returns foods matched by optional "q" search query,
+// with optional "limit" and "offset".
 func (r *FoodHandler) ListAPI(c *gin.Context) {
+	limit, offset, ok := model.ParsePage(c)
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
 	// Get from DB
 	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
 	defer cancel()
 
-	foodList, err := r.stg.GetFoodList(ctx)
+	foodList, err := r.stg.FindFood(ctx, model.GetUserID(c), c.Query("q"), limit, offset)
 	if err != nil && !errors.Is(err, storage.ErrFoodEmptyList) {
 		r.logger.Error(
 			"food list api DB error",
@@ -57,6 +73,7 @@ func (r *FoodHandler) ListAPI(c *gin.Context) {
 			Brand:   f.Brand,
 			Cal100:  f.Cal100,
 			Comment: f.Comment,
+			Private: f.Private,
 		})
 	}
 
@@ -65,7 +82,7 @@ func (r *FoodHandler) ListAPI(c *gin.Context) {
 
 func (r *FoodHandler) GetAPI(c *gin.Context) {
 	// Get from DB
-	food, err := r.stg.GetFood(c.Request.Context(), c.Param("key"))
+	food, err := r.stg.GetFood(c.Request.Context(), model.GetUserID(c), c.Param("key"))
 	if err != nil {
 		if errors.Is(err, storage.ErrFoodNotFound) {
 			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodNotFound))
@@ -82,23 +99,33 @@ func (r *FoodHandler) GetAPI(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model.NewDataResponse(&FoodItem{
-		Key:     food.Key,
-		Name:    food.Name,
-		Brand:   food.Brand,
-		Cal100:  food.Cal100,
-		Prot100: food.Prot100,
-		Fat100:  food.Fat100,
-		Carb100: food.Carb100,
-		Comment: food.Comment,
+		Key:       food.Key,
+		Name:      food.Name,
+		Brand:     food.Brand,
+		Cal100:    food.Cal100,
+		Prot100:   food.Prot100,
+		Fat100:    food.Fat100,
+		Carb100:   food.Carb100,
+		Fiber100:  food.Fiber100,
+		Sugar100:  food.Sugar100,
+		SatFat100: food.SatFat100,
+		Salt100:   food.Salt100,
+		Portions:  food.Portions,
+		Comment:   food.Comment,
+		Private:   food.Private,
 	}))
 }
 
 func (r *FoodHandler) DeleteAPI(c *gin.Context) {
-	if err := r.stg.DeleteFood(c.Request.Context(), c.Param("key")); err != nil {
+	if err := r.stg.DeleteFood(c.Request.Context(), model.GetUserID(c), c.Param("key")); err != nil {
 		if errors.Is(err, storage.ErrFoodIsUsed) {
 			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodIsUsed))
 			return
 		}
+		if errors.Is(err, storage.ErrFoodIsRecipe) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodIsRecipe))
+			return
+		}
 
 		r.logger.Error(
 			"food del api DB error",
@@ -125,14 +152,20 @@ func (r *FoodHandler) SetAPI(c *gin.Context) {
 	}
 
 	food := &storage.Food{
-		Key:     req.Food.Key,
-		Name:    req.Food.Name,
-		Brand:   req.Food.Brand,
-		Cal100:  req.Food.Cal100,
-		Prot100: req.Food.Prot100,
-		Fat100:  req.Food.Fat100,
-		Carb100: req.Food.Carb100,
-		Comment: req.Food.Comment,
+		Key:       req.Food.Key,
+		Name:      req.Food.Name,
+		Brand:     req.Food.Brand,
+		Cal100:    req.Food.Cal100,
+		Prot100:   req.Food.Prot100,
+		Fat100:    req.Food.Fat100,
+		Carb100:   req.Food.Carb100,
+		Fiber100:  req.Food.Fiber100,
+		Sugar100:  req.Food.Sugar100,
+		SatFat100: req.Food.SatFat100,
+		Salt100:   req.Food.Salt100,
+		Portions:  req.Food.Portions,
+		Comment:   req.Food.Comment,
+		Private:   req.Food.Private,
 	}
 	if !req.IsEdit {
 		food.Key = uuid.New().String()
@@ -144,11 +177,15 @@ func (r *FoodHandler) SetAPI(c *gin.Context) {
 		return
 	}
 
-	if err := r.stg.SetFood(c.Request.Context(), food); err != nil {
+	if err := r.stg.SetFood(c.Request.Context(), model.GetUserID(c), food); err != nil {
 		if errors.Is(err, storage.ErrFoodInvalid) {
 			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
 			return
 		}
+		if errors.Is(err, storage.ErrFoodIsRecipe) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodIsRecipe))
+			return
+		}
 
 		r.logger.Error(
 			"food set api DB error",
@@ -161,3 +198,78 @@ func (r *FoodHandler) SetAPI(c *gin.Context) {
 
 	c.JSON(http.StatusOK, model.NewOKResponse())
 }
+
+type FoodRenameAPIRequest struct {
+	Key    string `json:"key"`
+	NewKey string `json:"newKey"`
+}
+
+func (r *FoodHandler) RenameAPI(c *gin.Context) {
+	req := &FoodRenameAPIRequest{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	if err := r.stg.RenameFood(c.Request.Context(), model.GetUserID(c), req.Key, req.NewKey); err != nil {
+		if errors.Is(err, storage.ErrFoodInvalid) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+			return
+		}
+
+		if errors.Is(err, storage.ErrFoodNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodNotFound))
+			return
+		}
+
+		if errors.Is(err, storage.ErrFoodExists) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodExists))
+			return
+		}
+
+		r.logger.Error(
+			"food rename api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+type FoodMergeAPIRequest struct {
+	SrcKey string `json:"srcKey"`
+	DstKey string `json:"dstKey"`
+}
+
+func (r *FoodHandler) MergeAPI(c *gin.Context) {
+	req := &FoodMergeAPIRequest{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	if err := r.stg.MergeFoods(c.Request.Context(), model.GetUserID(c), req.SrcKey, req.DstKey); err != nil {
+		if errors.Is(err, storage.ErrFoodNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodNotFound))
+			return
+		}
+
+		if errors.Is(err, storage.ErrFoodMerge) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodMerge))
+			return
+		}
+
+		r.logger.Error(
+			"food merge api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
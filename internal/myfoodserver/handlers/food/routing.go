@@ -13,4 +13,6 @@ func Attach(group *gin.RouterGroup, stg storage.Storage, logger *zap.Logger) {
 	group.GET("/:key", foodHandler.GetAPI)
 	group.DELETE("/:key", foodHandler.DeleteAPI)
 	group.POST("/set", foodHandler.SetAPI)
+	group.POST("/rename", foodHandler.RenameAPI)
+	group.POST("/merge", foodHandler.MergeAPI)
 }
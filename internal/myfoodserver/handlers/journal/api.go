@@ -0,0 +1,635 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/myfoodserver/model"
+	"github.com/devldavydov/myfood/internal/storage"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type JournalHandler struct {
+	stg    storage.Storage
+	logger *zap.Logger
+}
+
+func NewJournalHander(stg storage.Storage, logger *zap.Logger) *JournalHandler {
+	return &JournalHandler{stg: stg, logger: logger}
+}
+
+type JournalReportItem struct {
+	Date       string  `json:"date"`
+	Time       string  `json:"time"`
+	Meal       int64   `json:"meal"`
+	MealName   string  `json:"mealName"`
+	FoodKey    string  `json:"foodKey"`
+	FoodName   string  `json:"foodName"`
+	FoodBrand  string  `json:"foodBrand"`
+	FoodWeight float64 `json:"foodWeight"`
+	// Original quantity in food portions, empty portion if set in grams.
+	Portion      string  `json:"portion"`
+	PortionCount float64 `json:"portionCount"`
+	Cal          float64 `json:"cal"`
+	Prot         float64 `json:"prot"`
+	Fat          float64 `json:"fat"`
+	Carb         float64 `json:"carb"`
+	// Extended nutrients, null if unknown.
+	Fiber  *float64 `json:"fiber"`
+	Sugar  *float64 `json:"sugar"`
+	SatFat *float64 `json:"satFat"`
+	Salt   *float64 `json:"salt"`
+}
+
+func (r *JournalHandler) ReportAPI(c *gin.Context) {
+	from, to, ok := model.ParseDateRange(c)
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	meals, err := r.stg.GetUserMeals(ctx, model.GetUserID(c))
+	if err != nil {
+		r.logger.Error(
+			"journal report api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	rep, err := r.stg.GetJournalReport(ctx, model.GetUserID(c), from, to)
+	if err != nil && !errors.Is(err, storage.ErrJournalReportEmpty) {
+		r.logger.Error(
+			"journal report api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	data := make([]JournalReportItem, 0, len(rep))
+	for _, j := range rep {
+		data = append(data, JournalReportItem{
+			Date:         model.FormatDate(j.Timestamp),
+			Time:         model.FormatTime(j.DayTime),
+			Meal:         int64(j.Meal),
+			MealName:     meals.Name(j.Meal),
+			FoodKey:      j.FoodKey,
+			FoodName:     j.FoodName,
+			FoodBrand:    j.FoodBrand,
+			FoodWeight:   j.FoodWeight,
+			Portion:      j.Portion,
+			PortionCount: j.PortionCount,
+			Cal:          j.Cal,
+			Prot:         j.Prot,
+			Fat:          j.Fat,
+			Carb:         j.Carb,
+			Fiber:        j.Fiber,
+			Sugar:        j.Sugar,
+			SatFat:       j.SatFat,
+			Salt:         j.Salt,
+		})
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(data))
+}
+
+type MealItem struct {
+	Meal    int64    `json:"meal"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+func (r *JournalHandler) MealsAPI(c *gin.Context) {
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	meals, err := r.stg.GetUserMeals(ctx, model.GetUserID(c))
+	if err != nil {
+		r.logger.Error(
+			"journal meals api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	data := make([]MealItem, 0, len(meals))
+	for _, m := range meals {
+		aliases := m.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+
+		data = append(data, MealItem{
+			Meal:    int64(m.Meal),
+			Name:    m.Name,
+			Aliases: aliases,
+		})
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(data))
+}
+
+type JournalMealReport struct {
+	ConsumedDayCal  float64           `json:"consumedDayCal"`
+	ConsumedMealCal float64           `json:"consumedMealCal"`
+	Items           []JournalMealItem `json:"items"`
+}
+
+type JournalMealItem struct {
+	Time         string  `json:"time"`
+	FoodKey      string  `json:"foodKey"`
+	FoodName     string  `json:"foodName"`
+	FoodBrand    string  `json:"foodBrand"`
+	FoodWeight   float64 `json:"foodWeight"`
+	Portion      string  `json:"portion"`
+	PortionCount float64 `json:"portionCount"`
+	Cal          float64 `json:"cal"`
+}
+
+func (r *JournalHandler) MealReportAPI(c *gin.Context) {
+	ts, err := model.ParseDate(c.Param("date"))
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	meal, ok := parseMeal(c.Param("meal"))
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	rep, err := r.stg.GetJournalMealReport(ctx, model.GetUserID(c), ts, meal)
+	if err != nil && !errors.Is(err, storage.ErrJournalMealReportEmpty) {
+		r.logger.Error(
+			"journal meal report api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	data := &JournalMealReport{Items: []JournalMealItem{}}
+	if rep != nil {
+		data.ConsumedDayCal = rep.ConsumedDayCal
+		data.ConsumedMealCal = rep.ConsumedMealCal
+		for _, item := range rep.Items {
+			data.Items = append(data.Items, JournalMealItem{
+				Time:         model.FormatTime(item.DayTime),
+				FoodKey:      item.FoodKey,
+				FoodName:     item.FoodName,
+				FoodBrand:    item.FoodBrand,
+				FoodWeight:   item.FoodWeight,
+				Portion:      item.Portion,
+				PortionCount: item.PortionCount,
+				Cal:          item.Cal,
+			})
+		}
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(data))
+}
+
+type JournalStatsItem struct {
+	Date      string  `json:"date"`
+	TotalCal  float64 `json:"totalCal"`
+	TotalProt float64 `json:"totalProt"`
+	TotalFat  float64 `json:"totalFat"`
+	TotalCarb float64 `json:"totalCarb"`
+	// Extended nutrients, null if unknown.
+	TotalFiber  *float64 `json:"totalFiber"`
+	TotalSugar  *float64 `json:"totalSugar"`
+	TotalSatFat *float64 `json:"totalSatFat"`
+	TotalSalt   *float64 `json:"totalSalt"`
+	// Count of entries with unknown extended nutrient, total is partial
+	// if not zero.
+	FiberUnknown  int `json:"fiberUnknown"`
+	SugarUnknown  int `json:"sugarUnknown"`
+	SatFatUnknown int `json:"satFatUnknown"`
+	SaltUnknown   int `json:"saltUnknown"`
+}
+
+func (r *JournalHandler) StatsAPI(c *gin.Context) {
+	from, to, ok := model.ParseDateRange(c)
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	stats, err := r.stg.GetJournalStats(ctx, model.GetUserID(c), from, to)
+	if err != nil && !errors.Is(err, storage.ErrJournalStatsEmpty) {
+		r.logger.Error(
+			"journal stats api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	data := make([]JournalStatsItem, 0, len(stats))
+	for _, s := range stats {
+		data = append(data, JournalStatsItem{
+			Date:          model.FormatDate(s.Timestamp),
+			TotalCal:      s.TotalCal,
+			TotalProt:     s.TotalProt,
+			TotalFat:      s.TotalFat,
+			TotalCarb:     s.TotalCarb,
+			TotalFiber:    s.TotalFiber,
+			TotalSugar:    s.TotalSugar,
+			TotalSatFat:   s.TotalSatFat,
+			TotalSalt:     s.TotalSalt,
+			FiberUnknown:  s.FiberUnknown,
+			SugarUnknown:  s.SugarUnknown,
+			SatFatUnknown: s.SatFatUnknown,
+			SaltUnknown:   s.SaltUnknown,
+		})
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(data))
+}
+
+type JournalSetAPIRequest struct {
+	Date string `json:"date"`
+	// Required time of day, entries with different time are stored separately.
+	Time       string  `json:"time"`
+	Meal       int64   `json:"meal"`
+	FoodKey    string  `json:"foodKey"`
+	FoodWeight float64 `json:"foodWeight"`
+	// Optional food portion, FoodWeight is ignored if set.
+	Portion      string  `json:"portion"`
+	PortionCount float64 `json:"portionCount"`
+	// Add weight to latest entry of food in meal regardless of time instead
+	// of replacing entry at time.
+	Add bool `json:"add"`
+}
+
+func (r *JournalHandler) SetAPI(c *gin.Context) {
+	req := &JournalSetAPIRequest{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	ts, err := model.ParseDate(req.Date)
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	dayTime, err := model.ParseTime(req.Time)
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	jrnl := &storage.Journal{
+		Timestamp:    ts,
+		DayTime:      dayTime,
+		Meal:         storage.Meal(req.Meal),
+		FoodKey:      req.FoodKey,
+		FoodWeight:   req.FoodWeight,
+		Portion:      req.Portion,
+		PortionCount: req.PortionCount,
+	}
+
+	if !jrnl.Validate() {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetJournal(ctx, model.GetUserID(c), jrnl, journalSetMode(req.Add)); err != nil {
+		if errors.Is(err, storage.ErrJournalInvalid) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+			return
+		}
+
+		if errors.Is(err, storage.ErrJournalInvalidFood) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodNotFound))
+			return
+		}
+
+		if errors.Is(err, storage.ErrUserMealNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrUserMealNotFound))
+			return
+		}
+
+		if errors.Is(err, storage.ErrFoodPortionNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodPortionNotFound))
+			return
+		}
+
+		r.logger.Error(
+			"journal set api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+type JournalSetBundleAPIRequest struct {
+	Date string `json:"date"`
+	// Required time of day.
+	Time      string `json:"time"`
+	Meal      int64  `json:"meal"`
+	BundleKey string `json:"bundleKey"`
+	// Bundle weights multiplier, 0 means full bundle.
+	Multiplier float64 `json:"multiplier"`
+	// Add weights to latest entries of foods in meal instead of replacing them.
+	Add bool `json:"add"`
+}
+
+func (r *JournalHandler) SetBundleAPI(c *gin.Context) {
+	req := &JournalSetBundleAPIRequest{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	ts, err := model.ParseDate(req.Date)
+	if err != nil || req.Meal < 0 || req.BundleKey == "" {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	dayTime, err := model.ParseTime(req.Time)
+	if err != nil || req.Multiplier < 0 {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	multiplier := req.Multiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetJournalBundle(ctx, model.GetUserID(c), ts, dayTime, storage.Meal(req.Meal), req.BundleKey, multiplier, journalSetMode(req.Add)); err != nil {
+		if errors.Is(err, storage.ErrJournalInvalid) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+			return
+		}
+
+		if errors.Is(err, storage.ErrJournalInvalidFood) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrFoodNotFound))
+			return
+		}
+
+		if errors.Is(err, storage.ErrUserMealNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrUserMealNotFound))
+			return
+		}
+
+		if errors.Is(err, storage.ErrBundleNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBundleNotFound))
+			return
+		}
+		if errors.Is(err, storage.ErrBundleDepRecursive) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBundleDepBundleRecursive))
+			return
+		}
+
+		r.logger.Error(
+			"journal set bundle api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+func (r *JournalHandler) DeleteAPI(c *gin.Context) {
+	ts, err := model.ParseDate(c.Param("date"))
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	meal, ok := parseMeal(c.Param("meal"))
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Delete from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	// Without time query parameter delete all entries of food in meal,
+	// empty time deletes entry logged without time.
+	if tm, ok := c.GetQuery("time"); ok {
+		var dayTime *time.Duration
+		if tm != "" {
+			var d time.Duration
+			d, err = model.ParseTime(tm)
+			if err != nil {
+				c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+				return
+			}
+			dayTime = &d
+		}
+
+		err = r.stg.DeleteJournalEntry(ctx, model.GetUserID(c), ts, dayTime, meal, c.Param("key"))
+	} else {
+		err = r.stg.DeleteJournal(ctx, model.GetUserID(c), ts, meal, c.Param("key"))
+	}
+
+	if err != nil {
+		r.logger.Error(
+			"journal del api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+func (r *JournalHandler) DeleteMealAPI(c *gin.Context) {
+	ts, err := model.ParseDate(c.Param("date"))
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	meal, ok := parseMeal(c.Param("meal"))
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Delete from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.DeleteJournalMeal(ctx, model.GetUserID(c), ts, meal); err != nil {
+		r.logger.Error(
+			"journal del meal api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+type JournalCopyAPIRequest struct {
+	DateFrom string `json:"dateFrom"`
+	MealFrom int64  `json:"mealFrom"`
+	DateTo   string `json:"dateTo"`
+	MealTo   int64  `json:"mealTo"`
+}
+
+func (r *JournalHandler) CopyAPI(c *gin.Context) {
+	req := &JournalCopyAPIRequest{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	tsFrom, err := model.ParseDate(req.DateFrom)
+	if err != nil || req.MealFrom < 0 {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	tsTo, err := model.ParseDate(req.DateTo)
+	if err != nil || req.MealTo < 0 {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	cnt, err := r.stg.CopyJournal(ctx,
+		model.GetUserID(c),
+		tsFrom,
+		storage.Meal(req.MealFrom),
+		tsTo,
+		storage.Meal(req.MealTo))
+	if err != nil {
+		if errors.Is(err, storage.ErrCopyToNotEmpty) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrJournalCopy))
+			return
+		}
+
+		if errors.Is(err, storage.ErrUserMealNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrUserMealNotFound))
+			return
+		}
+
+		r.logger.Error(
+			"journal copy api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(cnt))
+}
+
+type JournalRecalcAPIRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func (r *JournalHandler) RecalcAPI(c *gin.Context) {
+	req := &JournalRecalcAPIRequest{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	from, err := model.ParseDate(req.From)
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	to, err := model.ParseDate(req.To)
+	if err != nil || to.Before(from) {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	cnt, err := r.stg.RecalcJournal(ctx, model.GetUserID(c), from, to)
+	if err != nil {
+		r.logger.Error(
+			"journal recalc api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(cnt))
+}
+
+func parseMeal(meal string) (storage.Meal, bool) {
+	m, err := strconv.ParseInt(meal, 10, 64)
+	if err != nil || m < 0 {
+		return 0, false
+	}
+
+	return storage.Meal(m), true
+}
+
+func journalSetMode(add bool) storage.JournalSetMode {
+	if add {
+		return storage.JournalSetModeAdd
+	}
+
+	return storage.JournalSetModeReplace
+}
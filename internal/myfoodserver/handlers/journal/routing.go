@@ -8,5 +8,15 @@ import (
 
 func Attach(group *gin.RouterGroup, stg storage.Storage, logger *zap.Logger) {
 	journalHandler := NewJournalHander(stg, logger)
-	group.GET("/", journalHandler.Index)
+
+	group.GET("/report", journalHandler.ReportAPI)
+	group.GET("/stats", journalHandler.StatsAPI)
+	group.GET("/meals", journalHandler.MealsAPI)
+	group.GET("/:date/:meal", journalHandler.MealReportAPI)
+	group.POST("/set", journalHandler.SetAPI)
+	group.POST("/bundle", journalHandler.SetBundleAPI)
+	group.POST("/copy", journalHandler.CopyAPI)
+	group.POST("/recalc", journalHandler.RecalcAPI)
+	group.DELETE("/:date/:meal", journalHandler.DeleteMealAPI)
+	group.DELETE("/:date/:meal/:key", journalHandler.DeleteAPI)
 }
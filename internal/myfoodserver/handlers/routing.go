@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"github.com/devldavydov/myfood/internal/myfoodserver/auth"
+	"github.com/devldavydov/myfood/internal/myfoodserver/handlers/activity"
 	"github.com/devldavydov/myfood/internal/myfoodserver/handlers/food"
 	"github.com/devldavydov/myfood/internal/myfoodserver/handlers/journal"
 	"github.com/devldavydov/myfood/internal/myfoodserver/handlers/settings"
@@ -10,11 +12,14 @@ import (
 	"go.uber.org/zap"
 )
 
-func Init(router *gin.Engine, stg storage.Storage, logger *zap.Logger) {
+func Init(router *gin.Engine, stg storage.Storage, authenticator *auth.Auth, logger *zap.Logger) {
 	api := router.Group("/api")
+	authenticator.Attach(api.Group("/auth"))
 
-	food.Attach(api.Group("/food"), stg, logger)
-	journal.Attach(api.Group("/journal"), stg, logger)
-	settings.Attach(api.Group("/settings"), stg, logger)
-	weight.Attach(api.Group("/weight"), stg, logger)
+	private := api.Group("", authenticator.Middleware())
+	activity.Attach(private.Group("/activity"), stg, logger)
+	food.Attach(private.Group("/food"), stg, logger)
+	journal.Attach(private.Group("/journal"), stg, logger)
+	settings.Attach(private.Group("/settings"), stg, logger)
+	weight.Attach(private.Group("/weight"), stg, logger)
 }
@@ -0,0 +1,92 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/myfoodserver/model"
+	"github.com/devldavydov/myfood/internal/storage"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type SettingsHandler struct {
+	stg    storage.Storage
+	logger *zap.Logger
+}
+
+func NewSettingsHander(stg storage.Storage, logger *zap.Logger) *SettingsHandler {
+	return &SettingsHandler{stg: stg, logger: logger}
+}
+
+type UserSettingsItem struct {
+	CalLimit         float64 `json:"calLimit"`
+	DefaultActiveCal float64 `json:"defaultActiveCal"`
+}
+
+func (r *SettingsHandler) GetAPI(c *gin.Context) {
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	stgs, err := r.stg.GetUserSettings(ctx, model.GetUserID(c))
+	if err != nil {
+		if errors.Is(err, storage.ErrUserSettingsNotFound) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrUserSettingsNotFound))
+			return
+		}
+
+		r.logger.Error(
+			"user settings get api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(&UserSettingsItem{
+		CalLimit:         stgs.CalLimit,
+		DefaultActiveCal: stgs.DefaultActiveCal,
+	}))
+}
+
+func (r *SettingsHandler) SetAPI(c *gin.Context) {
+	req := &UserSettingsItem{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	stgs := &storage.UserSettings{
+		CalLimit:         req.CalLimit,
+		DefaultActiveCal: req.DefaultActiveCal,
+	}
+	if !stgs.Validate() {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetUserSettings(ctx, model.GetUserID(c), stgs); err != nil {
+		if errors.Is(err, storage.ErrUserSettingsInvalid) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+			return
+		}
+
+		r.logger.Error(
+			"user settings set api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
@@ -8,5 +8,7 @@ import (
 
 func Attach(group *gin.RouterGroup, stg storage.Storage, logger *zap.Logger) {
 	settingsHandler := NewSettingsHander(stg, logger)
-	group.GET("/", settingsHandler.Index)
+
+	group.GET("/", settingsHandler.GetAPI)
+	group.POST("/set", settingsHandler.SetAPI)
 }
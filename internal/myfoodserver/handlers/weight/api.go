@@ -0,0 +1,125 @@
+package weight
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/devldavydov/myfood/internal/common/messages"
+	"github.com/devldavydov/myfood/internal/myfoodserver/model"
+	"github.com/devldavydov/myfood/internal/storage"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type WeightHandler struct {
+	stg    storage.Storage
+	logger *zap.Logger
+}
+
+func NewWeightHander(stg storage.Storage, logger *zap.Logger) *WeightHandler {
+	return &WeightHandler{stg: stg, logger: logger}
+}
+
+type WeightItem struct {
+	Date  string  `json:"date"`
+	Value float64 `json:"value"`
+}
+
+func (r *WeightHandler) ListAPI(c *gin.Context) {
+	from, to, ok := model.ParseDateRange(c)
+	if !ok {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Get from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	lst, err := r.stg.GetWeightList(ctx, model.GetUserID(c), from, to)
+	if err != nil && !errors.Is(err, storage.ErrWeightEmptyList) {
+		r.logger.Error(
+			"weight list api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	data := make([]WeightItem, 0, len(lst))
+	for _, w := range lst {
+		data = append(data, WeightItem{
+			Date:  model.FormatDate(w.Timestamp),
+			Value: w.Value,
+		})
+	}
+
+	c.JSON(http.StatusOK, model.NewDataResponse(data))
+}
+
+func (r *WeightHandler) SetAPI(c *gin.Context) {
+	req := &WeightItem{}
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	ts, err := model.ParseDate(req.Date)
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	w := &storage.Weight{Timestamp: ts, Value: req.Value}
+	if !w.Validate() {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Save in DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.SetWeight(ctx, model.GetUserID(c), w); err != nil {
+		if errors.Is(err, storage.ErrWeightInvalid) {
+			c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+			return
+		}
+
+		r.logger.Error(
+			"weight set api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
+
+func (r *WeightHandler) DeleteAPI(c *gin.Context) {
+	ts, err := model.ParseDate(c.Param("date"))
+	if err != nil {
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrBadRequest))
+		return
+	}
+
+	// Delete from DB
+	ctx, cancel := context.WithTimeout(c.Request.Context(), storage.StorageOperationTimeout)
+	defer cancel()
+
+	if err := r.stg.DeleteWeight(ctx, model.GetUserID(c), ts); err != nil {
+		r.logger.Error(
+			"weight del api DB error",
+			zap.Error(err),
+		)
+
+		c.JSON(http.StatusOK, model.NewErrorResponse(messages.MsgErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, model.NewOKResponse())
+}
@@ -8,5 +8,8 @@ import (
 
 func Attach(group *gin.RouterGroup, stg storage.Storage, logger *zap.Logger) {
 	weightHandler := NewWeightHander(stg, logger)
-	group.GET("/", weightHandler.Index)
+
+	group.GET("/", weightHandler.ListAPI)
+	group.POST("/set", weightHandler.SetAPI)
+	group.DELETE("/:date", weightHandler.DeleteAPI)
 }
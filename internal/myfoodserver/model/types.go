@@ -1,5 +1,12 @@
 package model
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
 type ResponseData struct {
 	Error string `json:"error"`
 	Data  any    `json:"data"`
@@ -16,3 +23,78 @@ func NewDataResponse(data any) *ResponseData {
 func NewOKResponse() *ResponseData {
 	return &ResponseData{Data: "ok"}
 }
+
+const (
+	// DateFormat is the date format of API requests and responses.
+	DateFormat = "2006-01-02"
+	// TimeFormat is the journal time of day format of API requests and responses.
+	TimeFormat = "15:04"
+	// UserIDKey is the gin context key of the request user ID.
+	UserIDKey = "userID"
+)
+
+func ParseDate(date string) (time.Time, error) {
+	return time.Parse(DateFormat, date)
+}
+
+func FormatDate(ts time.Time) string {
+	return ts.Format(DateFormat)
+}
+
+// ParseTime parses HH:MM time of day.
+func ParseTime(tm string) (time.Duration, error) {
+	t, err := time.Parse(TimeFormat, tm)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// FormatTime formats time of day, empty string for legacy entry without time.
+func FormatTime(d *time.Duration) string {
+	if d == nil {
+		return ""
+	}
+
+	return time.Time{}.Add(*d).Format(TimeFormat)
+}
+
+// ParseDateRange parses "from" and "to" query parameters.
+func ParseDateRange(c *gin.Context) (time.Time, time.Time, bool) {
+	from, err := ParseDate(c.Query("from"))
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	to, err := ParseDate(c.Query("to"))
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, !to.Before(from)
+}
+
+// ParsePage parses optional "limit" and "offset" query parameters,
+// zero limit means no limit.
+func ParsePage(c *gin.Context) (int, int, bool) {
+	var page [2]int
+	for i, name := range []string{"limit", "offset"} {
+		s := c.Query(name)
+		if s == "" {
+			continue
+		}
+
+		v, err := strconv.Atoi(s)
+		if err != nil || v < 0 {
+			return 0, 0, false
+		}
+		page[i] = v
+	}
+
+	return page[0], page[1], true
+}
+
+func GetUserID(c *gin.Context) int64 {
+	return c.GetInt64(UserIDKey)
+}
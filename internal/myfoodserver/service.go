@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/devldavydov/myfood/internal/myfoodserver/auth"
 	handler "github.com/devldavydov/myfood/internal/myfoodserver/handlers"
 	"github.com/devldavydov/myfood/internal/storage"
 	"github.com/gin-contrib/gzip"
@@ -12,8 +13,6 @@ import (
 	"go.uber.org/zap"
 )
 
-const SessionName = "MyFoodSession"
-
 type Service struct {
 	settings *ServerSettings
 	logger   *zap.Logger
@@ -35,7 +34,8 @@ func (r *Service) Run(ctx context.Context) error {
 	router := gin.Default()
 	router.Use(gzip.Gzip(gzip.DefaultCompression))
 
-	handler.Init(router, r.stg, r.logger)
+	authenticator := auth.NewAuth(r.settings.Token, r.settings.AllowedUserIDs, r.settings.SecureCookie, r.logger)
+	handler.Init(router, r.stg, authenticator, r.logger)
 
 	// Start server
 	httpServer := &http.Server{
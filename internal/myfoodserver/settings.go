@@ -9,12 +9,18 @@ type ServerSettings struct {
 	RunAddress      *url.URL
 	DBFilePath      string
 	ShutdownTimeout time.Duration
+	Token           string
+	AllowedUserIDs  []int64
+	SecureCookie    bool
 }
 
 func NewServerSettings(
 	runAddress string,
 	dbFilePath string,
-	shutdownTimeout time.Duration) (*ServerSettings, error) {
+	shutdownTimeout time.Duration,
+	token string,
+	allowedUserIDs []int64,
+	secureCookie bool) (*ServerSettings, error) {
 
 	urlRunAddress, err := url.ParseRequestURI(runAddress)
 	if err != nil {
@@ -25,5 +31,8 @@ func NewServerSettings(
 		RunAddress:      urlRunAddress,
 		DBFilePath:      dbFilePath,
 		ShutdownTimeout: shutdownTimeout,
+		Token:           token,
+		AllowedUserIDs:  allowedUserIDs,
+		SecureCookie:    secureCookie,
 	}, nil
 }
@@ -19,6 +19,8 @@ import (
 	"github.com/devldavydov/myfood/internal/storage/ent/bundle"
 	"github.com/devldavydov/myfood/internal/storage/ent/food"
 	"github.com/devldavydov/myfood/internal/storage/ent/journal"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
 	"github.com/devldavydov/myfood/internal/storage/ent/usersettings"
 	"github.com/devldavydov/myfood/internal/storage/ent/weight"
 )
@@ -36,6 +38,10 @@ type Client struct {
 	Food *FoodClient
 	// Journal is the client for interacting with the Journal builders.
 	Journal *JournalClient
+	// Recipe is the client for interacting with the Recipe builders.
+	Recipe *RecipeClient
+	// UserMeal is the client for interacting with the UserMeal builders.
+	UserMeal *UserMealClient
 	// UserSettings is the client for interacting with the UserSettings builders.
 	UserSettings *UserSettingsClient
 	// Weight is the client for interacting with the Weight builders.
@@ -55,6 +61,8 @@ func (c *Client) init() {
 	c.Bundle = NewBundleClient(c.config)
 	c.Food = NewFoodClient(c.config)
 	c.Journal = NewJournalClient(c.config)
+	c.Recipe = NewRecipeClient(c.config)
+	c.UserMeal = NewUserMealClient(c.config)
 	c.UserSettings = NewUserSettingsClient(c.config)
 	c.Weight = NewWeightClient(c.config)
 }
@@ -153,6 +161,8 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Bundle:       NewBundleClient(cfg),
 		Food:         NewFoodClient(cfg),
 		Journal:      NewJournalClient(cfg),
+		Recipe:       NewRecipeClient(cfg),
+		UserMeal:     NewUserMealClient(cfg),
 		UserSettings: NewUserSettingsClient(cfg),
 		Weight:       NewWeightClient(cfg),
 	}, nil
@@ -178,6 +188,8 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Bundle:       NewBundleClient(cfg),
 		Food:         NewFoodClient(cfg),
 		Journal:      NewJournalClient(cfg),
+		Recipe:       NewRecipeClient(cfg),
+		UserMeal:     NewUserMealClient(cfg),
 		UserSettings: NewUserSettingsClient(cfg),
 		Weight:       NewWeightClient(cfg),
 	}, nil
@@ -209,7 +221,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Activity, c.Bundle, c.Food, c.Journal, c.UserSettings, c.Weight,
+		c.Activity, c.Bundle, c.Food, c.Journal, c.Recipe, c.UserMeal, c.UserSettings,
+		c.Weight,
 	} {
 		n.Use(hooks...)
 	}
@@ -219,7 +232,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Activity, c.Bundle, c.Food, c.Journal, c.UserSettings, c.Weight,
+		c.Activity, c.Bundle, c.Food, c.Journal, c.Recipe, c.UserMeal, c.UserSettings,
+		c.Weight,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -236,6 +250,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Food.mutate(ctx, m)
 	case *JournalMutation:
 		return c.Journal.mutate(ctx, m)
+	case *RecipeMutation:
+		return c.Recipe.mutate(ctx, m)
+	case *UserMealMutation:
+		return c.UserMeal.mutate(ctx, m)
 	case *UserSettingsMutation:
 		return c.UserSettings.mutate(ctx, m)
 	case *WeightMutation:
@@ -809,6 +827,272 @@ func (c *JournalClient) mutate(ctx context.Context, m *JournalMutation) (Value,
 	}
 }
 
+// RecipeClient is a client for the Recipe schema.
+type RecipeClient struct {
+	config
+}
+
+// NewRecipeClient returns a client for the Recipe from the given config.
+func NewRecipeClient(c config) *RecipeClient {
+	return &RecipeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recipe.Hooks(f(g(h())))`.
+func (c *RecipeClient) Use(hooks ...Hook) {
+	c.hooks.Recipe = append(c.hooks.Recipe, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recipe.Intercept(f(g(h())))`.
+func (c *RecipeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Recipe = append(c.inters.Recipe, interceptors...)
+}
+
+// Create returns a builder for creating a Recipe entity.
+func (c *RecipeClient) Create() *RecipeCreate {
+	mutation := newRecipeMutation(c.config, OpCreate)
+	return &RecipeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Recipe entities.
+func (c *RecipeClient) CreateBulk(builders ...*RecipeCreate) *RecipeCreateBulk {
+	return &RecipeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecipeClient) MapCreateBulk(slice any, setFunc func(*RecipeCreate, int)) *RecipeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecipeCreateBulk{err: fmt.Errorf("calling to RecipeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecipeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecipeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Recipe.
+func (c *RecipeClient) Update() *RecipeUpdate {
+	mutation := newRecipeMutation(c.config, OpUpdate)
+	return &RecipeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecipeClient) UpdateOne(r *Recipe) *RecipeUpdateOne {
+	mutation := newRecipeMutation(c.config, OpUpdateOne, withRecipe(r))
+	return &RecipeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecipeClient) UpdateOneID(id int) *RecipeUpdateOne {
+	mutation := newRecipeMutation(c.config, OpUpdateOne, withRecipeID(id))
+	return &RecipeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Recipe.
+func (c *RecipeClient) Delete() *RecipeDelete {
+	mutation := newRecipeMutation(c.config, OpDelete)
+	return &RecipeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecipeClient) DeleteOne(r *Recipe) *RecipeDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecipeClient) DeleteOneID(id int) *RecipeDeleteOne {
+	builder := c.Delete().Where(recipe.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecipeDeleteOne{builder}
+}
+
+// Query returns a query builder for Recipe.
+func (c *RecipeClient) Query() *RecipeQuery {
+	return &RecipeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecipe},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Recipe entity by its id.
+func (c *RecipeClient) Get(ctx context.Context, id int) (*Recipe, error) {
+	return c.Query().Where(recipe.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecipeClient) GetX(ctx context.Context, id int) *Recipe {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RecipeClient) Hooks() []Hook {
+	return c.hooks.Recipe
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecipeClient) Interceptors() []Interceptor {
+	return c.inters.Recipe
+}
+
+func (c *RecipeClient) mutate(ctx context.Context, m *RecipeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecipeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecipeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecipeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecipeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Recipe mutation op: %q", m.Op())
+	}
+}
+
+// UserMealClient is a client for the UserMeal schema.
+type UserMealClient struct {
+	config
+}
+
+// NewUserMealClient returns a client for the UserMeal from the given config.
+func NewUserMealClient(c config) *UserMealClient {
+	return &UserMealClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usermeal.Hooks(f(g(h())))`.
+func (c *UserMealClient) Use(hooks ...Hook) {
+	c.hooks.UserMeal = append(c.hooks.UserMeal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usermeal.Intercept(f(g(h())))`.
+func (c *UserMealClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserMeal = append(c.inters.UserMeal, interceptors...)
+}
+
+// Create returns a builder for creating a UserMeal entity.
+func (c *UserMealClient) Create() *UserMealCreate {
+	mutation := newUserMealMutation(c.config, OpCreate)
+	return &UserMealCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserMeal entities.
+func (c *UserMealClient) CreateBulk(builders ...*UserMealCreate) *UserMealCreateBulk {
+	return &UserMealCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserMealClient) MapCreateBulk(slice any, setFunc func(*UserMealCreate, int)) *UserMealCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserMealCreateBulk{err: fmt.Errorf("calling to UserMealClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserMealCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserMealCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserMeal.
+func (c *UserMealClient) Update() *UserMealUpdate {
+	mutation := newUserMealMutation(c.config, OpUpdate)
+	return &UserMealUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserMealClient) UpdateOne(um *UserMeal) *UserMealUpdateOne {
+	mutation := newUserMealMutation(c.config, OpUpdateOne, withUserMeal(um))
+	return &UserMealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserMealClient) UpdateOneID(id int) *UserMealUpdateOne {
+	mutation := newUserMealMutation(c.config, OpUpdateOne, withUserMealID(id))
+	return &UserMealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserMeal.
+func (c *UserMealClient) Delete() *UserMealDelete {
+	mutation := newUserMealMutation(c.config, OpDelete)
+	return &UserMealDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserMealClient) DeleteOne(um *UserMeal) *UserMealDeleteOne {
+	return c.DeleteOneID(um.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserMealClient) DeleteOneID(id int) *UserMealDeleteOne {
+	builder := c.Delete().Where(usermeal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserMealDeleteOne{builder}
+}
+
+// Query returns a query builder for UserMeal.
+func (c *UserMealClient) Query() *UserMealQuery {
+	return &UserMealQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserMeal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserMeal entity by its id.
+func (c *UserMealClient) Get(ctx context.Context, id int) (*UserMeal, error) {
+	return c.Query().Where(usermeal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserMealClient) GetX(ctx context.Context, id int) *UserMeal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserMealClient) Hooks() []Hook {
+	return c.hooks.UserMeal
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserMealClient) Interceptors() []Interceptor {
+	return c.inters.UserMeal
+}
+
+func (c *UserMealClient) mutate(ctx context.Context, m *UserMealMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserMealCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserMealUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserMealUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserMealDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserMeal mutation op: %q", m.Op())
+	}
+}
+
 // UserSettingsClient is a client for the UserSettings schema.
 type UserSettingsClient struct {
 	config
@@ -1078,9 +1362,11 @@ func (c *WeightClient) mutate(ctx context.Context, m *WeightMutation) (Value, er
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Activity, Bundle, Food, Journal, UserSettings, Weight []ent.Hook
+		Activity, Bundle, Food, Journal, Recipe, UserMeal, UserSettings,
+		Weight []ent.Hook
 	}
 	inters struct {
-		Activity, Bundle, Food, Journal, UserSettings, Weight []ent.Interceptor
+		Activity, Bundle, Food, Journal, Recipe, UserMeal, UserSettings,
+		Weight []ent.Interceptor
 	}
 )
@@ -16,6 +16,8 @@ import (
 	"github.com/devldavydov/myfood/internal/storage/ent/bundle"
 	"github.com/devldavydov/myfood/internal/storage/ent/food"
 	"github.com/devldavydov/myfood/internal/storage/ent/journal"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
 	"github.com/devldavydov/myfood/internal/storage/ent/usersettings"
 	"github.com/devldavydov/myfood/internal/storage/ent/weight"
 )
@@ -82,6 +84,8 @@ func checkColumn(table, column string) error {
 			bundle.Table:       bundle.ValidColumn,
 			food.Table:         food.ValidColumn,
 			journal.Table:      journal.ValidColumn,
+			recipe.Table:       recipe.ValidColumn,
+			usermeal.Table:     usermeal.ValidColumn,
 			usersettings.Table: usersettings.ValidColumn,
 			weight.Table:       weight.ValidColumn,
 		})
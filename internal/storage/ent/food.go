@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -30,8 +31,20 @@ type Food struct {
 	Fat100 float64 `json:"fat100,omitempty"`
 	// Carb100 holds the value of the "carb100" field.
 	Carb100 float64 `json:"carb100,omitempty"`
+	// Fiber100 holds the value of the "fiber100" field.
+	Fiber100 *float64 `json:"fiber100,omitempty"`
+	// Sugar100 holds the value of the "sugar100" field.
+	Sugar100 *float64 `json:"sugar100,omitempty"`
+	// Satfat100 holds the value of the "satfat100" field.
+	Satfat100 *float64 `json:"satfat100,omitempty"`
+	// Salt100 holds the value of the "salt100" field.
+	Salt100 *float64 `json:"salt100,omitempty"`
 	// Comment holds the value of the "comment" field.
 	Comment string `json:"comment,omitempty"`
+	// Portions holds the value of the "portions" field.
+	Portions map[string]float64 `json:"portions,omitempty"`
+	// Userid holds the value of the "userid" field.
+	Userid int64 `json:"userid,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FoodQuery when eager-loading is set.
 	Edges        FoodEdges `json:"edges"`
@@ -61,9 +74,11 @@ func (*Food) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case food.FieldCal100, food.FieldProt100, food.FieldFat100, food.FieldCarb100:
+		case food.FieldPortions:
+			values[i] = new([]byte)
+		case food.FieldCal100, food.FieldProt100, food.FieldFat100, food.FieldCarb100, food.FieldFiber100, food.FieldSugar100, food.FieldSatfat100, food.FieldSalt100:
 			values[i] = new(sql.NullFloat64)
-		case food.FieldID:
+		case food.FieldID, food.FieldUserid:
 			values[i] = new(sql.NullInt64)
 		case food.FieldKey, food.FieldName, food.FieldBrand, food.FieldComment:
 			values[i] = new(sql.NullString)
@@ -130,12 +145,54 @@ func (f *Food) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.Carb100 = value.Float64
 			}
+		case food.FieldFiber100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fiber100", values[i])
+			} else if value.Valid {
+				f.Fiber100 = new(float64)
+				*f.Fiber100 = value.Float64
+			}
+		case food.FieldSugar100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field sugar100", values[i])
+			} else if value.Valid {
+				f.Sugar100 = new(float64)
+				*f.Sugar100 = value.Float64
+			}
+		case food.FieldSatfat100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field satfat100", values[i])
+			} else if value.Valid {
+				f.Satfat100 = new(float64)
+				*f.Satfat100 = value.Float64
+			}
+		case food.FieldSalt100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field salt100", values[i])
+			} else if value.Valid {
+				f.Salt100 = new(float64)
+				*f.Salt100 = value.Float64
+			}
 		case food.FieldComment:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field comment", values[i])
 			} else if value.Valid {
 				f.Comment = value.String
 			}
+		case food.FieldPortions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field portions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Portions); err != nil {
+					return fmt.Errorf("unmarshal field portions: %w", err)
+				}
+			}
+		case food.FieldUserid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userid", values[i])
+			} else if value.Valid {
+				f.Userid = value.Int64
+			}
 		default:
 			f.selectValues.Set(columns[i], values[i])
 		}
@@ -198,8 +255,34 @@ func (f *Food) String() string {
 	builder.WriteString("carb100=")
 	builder.WriteString(fmt.Sprintf("%v", f.Carb100))
 	builder.WriteString(", ")
+	if v := f.Fiber100; v != nil {
+		builder.WriteString("fiber100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := f.Sugar100; v != nil {
+		builder.WriteString("sugar100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := f.Satfat100; v != nil {
+		builder.WriteString("satfat100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := f.Salt100; v != nil {
+		builder.WriteString("salt100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("comment=")
 	builder.WriteString(f.Comment)
+	builder.WriteString(", ")
+	builder.WriteString("portions=")
+	builder.WriteString(fmt.Sprintf("%v", f.Portions))
+	builder.WriteString(", ")
+	builder.WriteString("userid=")
+	builder.WriteString(fmt.Sprintf("%v", f.Userid))
 	builder.WriteByte(')')
 	return builder.String()
 }
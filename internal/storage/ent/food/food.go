@@ -26,8 +26,20 @@ const (
 	FieldFat100 = "fat100"
 	// FieldCarb100 holds the string denoting the carb100 field in the database.
 	FieldCarb100 = "carb100"
+	// FieldFiber100 holds the string denoting the fiber100 field in the database.
+	FieldFiber100 = "fiber100"
+	// FieldSugar100 holds the string denoting the sugar100 field in the database.
+	FieldSugar100 = "sugar100"
+	// FieldSatfat100 holds the string denoting the satfat100 field in the database.
+	FieldSatfat100 = "satfat100"
+	// FieldSalt100 holds the string denoting the salt100 field in the database.
+	FieldSalt100 = "salt100"
 	// FieldComment holds the string denoting the comment field in the database.
 	FieldComment = "comment"
+	// FieldPortions holds the string denoting the portions field in the database.
+	FieldPortions = "portions"
+	// FieldUserid holds the string denoting the userid field in the database.
+	FieldUserid = "userid"
 	// EdgeJournals holds the string denoting the journals edge name in mutations.
 	EdgeJournals = "journals"
 	// Table holds the table name of the food in the database.
@@ -51,7 +63,13 @@ var Columns = []string{
 	FieldProt100,
 	FieldFat100,
 	FieldCarb100,
+	FieldFiber100,
+	FieldSugar100,
+	FieldSatfat100,
+	FieldSalt100,
 	FieldComment,
+	FieldPortions,
+	FieldUserid,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +85,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultUserid holds the default value on creation for the "userid" field.
+	DefaultUserid int64
 )
 
 // OrderOption defines the ordering options for the Food queries.
@@ -112,11 +132,36 @@ func ByCarb100(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCarb100, opts...).ToFunc()
 }
 
+// ByFiber100 orders the results by the fiber100 field.
+func ByFiber100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFiber100, opts...).ToFunc()
+}
+
+// BySugar100 orders the results by the sugar100 field.
+func BySugar100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSugar100, opts...).ToFunc()
+}
+
+// BySatfat100 orders the results by the satfat100 field.
+func BySatfat100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSatfat100, opts...).ToFunc()
+}
+
+// BySalt100 orders the results by the salt100 field.
+func BySalt100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalt100, opts...).ToFunc()
+}
+
 // ByComment orders the results by the comment field.
 func ByComment(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldComment, opts...).ToFunc()
 }
 
+// ByUserid orders the results by the userid field.
+func ByUserid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserid, opts...).ToFunc()
+}
+
 // ByJournalsCount orders the results by journals count.
 func ByJournalsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
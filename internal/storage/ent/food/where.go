@@ -88,11 +88,36 @@ func Carb100(v float64) predicate.Food {
 	return predicate.Food(sql.FieldEQ(FieldCarb100, v))
 }
 
+// Fiber100 applies equality check predicate on the "fiber100" field. It's identical to Fiber100EQ.
+func Fiber100(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldFiber100, v))
+}
+
+// Sugar100 applies equality check predicate on the "sugar100" field. It's identical to Sugar100EQ.
+func Sugar100(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldSugar100, v))
+}
+
+// Satfat100 applies equality check predicate on the "satfat100" field. It's identical to Satfat100EQ.
+func Satfat100(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldSatfat100, v))
+}
+
+// Salt100 applies equality check predicate on the "salt100" field. It's identical to Salt100EQ.
+func Salt100(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldSalt100, v))
+}
+
 // Comment applies equality check predicate on the "comment" field. It's identical to CommentEQ.
 func Comment(v string) predicate.Food {
 	return predicate.Food(sql.FieldEQ(FieldComment, v))
 }
 
+// Userid applies equality check predicate on the "userid" field. It's identical to UseridEQ.
+func Userid(v int64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldUserid, v))
+}
+
 // KeyEQ applies the EQ predicate on the "key" field.
 func KeyEQ(v string) predicate.Food {
 	return predicate.Food(sql.FieldEQ(FieldKey, v))
@@ -458,6 +483,206 @@ func Carb100LTE(v float64) predicate.Food {
 	return predicate.Food(sql.FieldLTE(FieldCarb100, v))
 }
 
+// Fiber100EQ applies the EQ predicate on the "fiber100" field.
+func Fiber100EQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldFiber100, v))
+}
+
+// Fiber100NEQ applies the NEQ predicate on the "fiber100" field.
+func Fiber100NEQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldNEQ(FieldFiber100, v))
+}
+
+// Fiber100In applies the In predicate on the "fiber100" field.
+func Fiber100In(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldIn(FieldFiber100, vs...))
+}
+
+// Fiber100NotIn applies the NotIn predicate on the "fiber100" field.
+func Fiber100NotIn(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldNotIn(FieldFiber100, vs...))
+}
+
+// Fiber100GT applies the GT predicate on the "fiber100" field.
+func Fiber100GT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGT(FieldFiber100, v))
+}
+
+// Fiber100GTE applies the GTE predicate on the "fiber100" field.
+func Fiber100GTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGTE(FieldFiber100, v))
+}
+
+// Fiber100LT applies the LT predicate on the "fiber100" field.
+func Fiber100LT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLT(FieldFiber100, v))
+}
+
+// Fiber100LTE applies the LTE predicate on the "fiber100" field.
+func Fiber100LTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLTE(FieldFiber100, v))
+}
+
+// Fiber100IsNil applies the IsNil predicate on the "fiber100" field.
+func Fiber100IsNil() predicate.Food {
+	return predicate.Food(sql.FieldIsNull(FieldFiber100))
+}
+
+// Fiber100NotNil applies the NotNil predicate on the "fiber100" field.
+func Fiber100NotNil() predicate.Food {
+	return predicate.Food(sql.FieldNotNull(FieldFiber100))
+}
+
+// Sugar100EQ applies the EQ predicate on the "sugar100" field.
+func Sugar100EQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldSugar100, v))
+}
+
+// Sugar100NEQ applies the NEQ predicate on the "sugar100" field.
+func Sugar100NEQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldNEQ(FieldSugar100, v))
+}
+
+// Sugar100In applies the In predicate on the "sugar100" field.
+func Sugar100In(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldIn(FieldSugar100, vs...))
+}
+
+// Sugar100NotIn applies the NotIn predicate on the "sugar100" field.
+func Sugar100NotIn(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldNotIn(FieldSugar100, vs...))
+}
+
+// Sugar100GT applies the GT predicate on the "sugar100" field.
+func Sugar100GT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGT(FieldSugar100, v))
+}
+
+// Sugar100GTE applies the GTE predicate on the "sugar100" field.
+func Sugar100GTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGTE(FieldSugar100, v))
+}
+
+// Sugar100LT applies the LT predicate on the "sugar100" field.
+func Sugar100LT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLT(FieldSugar100, v))
+}
+
+// Sugar100LTE applies the LTE predicate on the "sugar100" field.
+func Sugar100LTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLTE(FieldSugar100, v))
+}
+
+// Sugar100IsNil applies the IsNil predicate on the "sugar100" field.
+func Sugar100IsNil() predicate.Food {
+	return predicate.Food(sql.FieldIsNull(FieldSugar100))
+}
+
+// Sugar100NotNil applies the NotNil predicate on the "sugar100" field.
+func Sugar100NotNil() predicate.Food {
+	return predicate.Food(sql.FieldNotNull(FieldSugar100))
+}
+
+// Satfat100EQ applies the EQ predicate on the "satfat100" field.
+func Satfat100EQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldSatfat100, v))
+}
+
+// Satfat100NEQ applies the NEQ predicate on the "satfat100" field.
+func Satfat100NEQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldNEQ(FieldSatfat100, v))
+}
+
+// Satfat100In applies the In predicate on the "satfat100" field.
+func Satfat100In(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldIn(FieldSatfat100, vs...))
+}
+
+// Satfat100NotIn applies the NotIn predicate on the "satfat100" field.
+func Satfat100NotIn(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldNotIn(FieldSatfat100, vs...))
+}
+
+// Satfat100GT applies the GT predicate on the "satfat100" field.
+func Satfat100GT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGT(FieldSatfat100, v))
+}
+
+// Satfat100GTE applies the GTE predicate on the "satfat100" field.
+func Satfat100GTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGTE(FieldSatfat100, v))
+}
+
+// Satfat100LT applies the LT predicate on the "satfat100" field.
+func Satfat100LT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLT(FieldSatfat100, v))
+}
+
+// Satfat100LTE applies the LTE predicate on the "satfat100" field.
+func Satfat100LTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLTE(FieldSatfat100, v))
+}
+
+// Satfat100IsNil applies the IsNil predicate on the "satfat100" field.
+func Satfat100IsNil() predicate.Food {
+	return predicate.Food(sql.FieldIsNull(FieldSatfat100))
+}
+
+// Satfat100NotNil applies the NotNil predicate on the "satfat100" field.
+func Satfat100NotNil() predicate.Food {
+	return predicate.Food(sql.FieldNotNull(FieldSatfat100))
+}
+
+// Salt100EQ applies the EQ predicate on the "salt100" field.
+func Salt100EQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldSalt100, v))
+}
+
+// Salt100NEQ applies the NEQ predicate on the "salt100" field.
+func Salt100NEQ(v float64) predicate.Food {
+	return predicate.Food(sql.FieldNEQ(FieldSalt100, v))
+}
+
+// Salt100In applies the In predicate on the "salt100" field.
+func Salt100In(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldIn(FieldSalt100, vs...))
+}
+
+// Salt100NotIn applies the NotIn predicate on the "salt100" field.
+func Salt100NotIn(vs ...float64) predicate.Food {
+	return predicate.Food(sql.FieldNotIn(FieldSalt100, vs...))
+}
+
+// Salt100GT applies the GT predicate on the "salt100" field.
+func Salt100GT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGT(FieldSalt100, v))
+}
+
+// Salt100GTE applies the GTE predicate on the "salt100" field.
+func Salt100GTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldGTE(FieldSalt100, v))
+}
+
+// Salt100LT applies the LT predicate on the "salt100" field.
+func Salt100LT(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLT(FieldSalt100, v))
+}
+
+// Salt100LTE applies the LTE predicate on the "salt100" field.
+func Salt100LTE(v float64) predicate.Food {
+	return predicate.Food(sql.FieldLTE(FieldSalt100, v))
+}
+
+// Salt100IsNil applies the IsNil predicate on the "salt100" field.
+func Salt100IsNil() predicate.Food {
+	return predicate.Food(sql.FieldIsNull(FieldSalt100))
+}
+
+// Salt100NotNil applies the NotNil predicate on the "salt100" field.
+func Salt100NotNil() predicate.Food {
+	return predicate.Food(sql.FieldNotNull(FieldSalt100))
+}
+
 // CommentEQ applies the EQ predicate on the "comment" field.
 func CommentEQ(v string) predicate.Food {
 	return predicate.Food(sql.FieldEQ(FieldComment, v))
@@ -533,6 +758,56 @@ func CommentContainsFold(v string) predicate.Food {
 	return predicate.Food(sql.FieldContainsFold(FieldComment, v))
 }
 
+// PortionsIsNil applies the IsNil predicate on the "portions" field.
+func PortionsIsNil() predicate.Food {
+	return predicate.Food(sql.FieldIsNull(FieldPortions))
+}
+
+// PortionsNotNil applies the NotNil predicate on the "portions" field.
+func PortionsNotNil() predicate.Food {
+	return predicate.Food(sql.FieldNotNull(FieldPortions))
+}
+
+// UseridEQ applies the EQ predicate on the "userid" field.
+func UseridEQ(v int64) predicate.Food {
+	return predicate.Food(sql.FieldEQ(FieldUserid, v))
+}
+
+// UseridNEQ applies the NEQ predicate on the "userid" field.
+func UseridNEQ(v int64) predicate.Food {
+	return predicate.Food(sql.FieldNEQ(FieldUserid, v))
+}
+
+// UseridIn applies the In predicate on the "userid" field.
+func UseridIn(vs ...int64) predicate.Food {
+	return predicate.Food(sql.FieldIn(FieldUserid, vs...))
+}
+
+// UseridNotIn applies the NotIn predicate on the "userid" field.
+func UseridNotIn(vs ...int64) predicate.Food {
+	return predicate.Food(sql.FieldNotIn(FieldUserid, vs...))
+}
+
+// UseridGT applies the GT predicate on the "userid" field.
+func UseridGT(v int64) predicate.Food {
+	return predicate.Food(sql.FieldGT(FieldUserid, v))
+}
+
+// UseridGTE applies the GTE predicate on the "userid" field.
+func UseridGTE(v int64) predicate.Food {
+	return predicate.Food(sql.FieldGTE(FieldUserid, v))
+}
+
+// UseridLT applies the LT predicate on the "userid" field.
+func UseridLT(v int64) predicate.Food {
+	return predicate.Food(sql.FieldLT(FieldUserid, v))
+}
+
+// UseridLTE applies the LTE predicate on the "userid" field.
+func UseridLTE(v int64) predicate.Food {
+	return predicate.Food(sql.FieldLTE(FieldUserid, v))
+}
+
 // HasJournals applies the HasEdge predicate on the "journals" edge.
 func HasJournals() predicate.Food {
 	return predicate.Food(func(s *sql.Selector) {
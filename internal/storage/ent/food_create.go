@@ -72,6 +72,62 @@ func (fc *FoodCreate) SetCarb100(f float64) *FoodCreate {
 	return fc
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (fc *FoodCreate) SetFiber100(f float64) *FoodCreate {
+	fc.mutation.SetFiber100(f)
+	return fc
+}
+
+// SetNillableFiber100 sets the "fiber100" field if the given value is not nil.
+func (fc *FoodCreate) SetNillableFiber100(f *float64) *FoodCreate {
+	if f != nil {
+		fc.SetFiber100(*f)
+	}
+	return fc
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (fc *FoodCreate) SetSugar100(f float64) *FoodCreate {
+	fc.mutation.SetSugar100(f)
+	return fc
+}
+
+// SetNillableSugar100 sets the "sugar100" field if the given value is not nil.
+func (fc *FoodCreate) SetNillableSugar100(f *float64) *FoodCreate {
+	if f != nil {
+		fc.SetSugar100(*f)
+	}
+	return fc
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (fc *FoodCreate) SetSatfat100(f float64) *FoodCreate {
+	fc.mutation.SetSatfat100(f)
+	return fc
+}
+
+// SetNillableSatfat100 sets the "satfat100" field if the given value is not nil.
+func (fc *FoodCreate) SetNillableSatfat100(f *float64) *FoodCreate {
+	if f != nil {
+		fc.SetSatfat100(*f)
+	}
+	return fc
+}
+
+// SetSalt100 sets the "salt100" field.
+func (fc *FoodCreate) SetSalt100(f float64) *FoodCreate {
+	fc.mutation.SetSalt100(f)
+	return fc
+}
+
+// SetNillableSalt100 sets the "salt100" field if the given value is not nil.
+func (fc *FoodCreate) SetNillableSalt100(f *float64) *FoodCreate {
+	if f != nil {
+		fc.SetSalt100(*f)
+	}
+	return fc
+}
+
 // SetComment sets the "comment" field.
 func (fc *FoodCreate) SetComment(s string) *FoodCreate {
 	fc.mutation.SetComment(s)
@@ -86,6 +142,26 @@ func (fc *FoodCreate) SetNillableComment(s *string) *FoodCreate {
 	return fc
 }
 
+// SetPortions sets the "portions" field.
+func (fc *FoodCreate) SetPortions(m map[string]float64) *FoodCreate {
+	fc.mutation.SetPortions(m)
+	return fc
+}
+
+// SetUserid sets the "userid" field.
+func (fc *FoodCreate) SetUserid(i int64) *FoodCreate {
+	fc.mutation.SetUserid(i)
+	return fc
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (fc *FoodCreate) SetNillableUserid(i *int64) *FoodCreate {
+	if i != nil {
+		fc.SetUserid(*i)
+	}
+	return fc
+}
+
 // AddJournalIDs adds the "journals" edge to the Journal entity by IDs.
 func (fc *FoodCreate) AddJournalIDs(ids ...int) *FoodCreate {
 	fc.mutation.AddJournalIDs(ids...)
@@ -108,6 +184,7 @@ func (fc *FoodCreate) Mutation() *FoodMutation {
 
 // Save creates the Food in the database.
 func (fc *FoodCreate) Save(ctx context.Context) (*Food, error) {
+	fc.defaults()
 	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
 }
 
@@ -133,6 +210,14 @@ func (fc *FoodCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (fc *FoodCreate) defaults() {
+	if _, ok := fc.mutation.Userid(); !ok {
+		v := food.DefaultUserid
+		fc.mutation.SetUserid(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (fc *FoodCreate) check() error {
 	if _, ok := fc.mutation.Key(); !ok {
@@ -158,6 +243,9 @@ func (fc *FoodCreate) check() error {
 	if _, ok := fc.mutation.Carb100(); !ok {
 		return &ValidationError{Name: "carb100", err: errors.New(`ent: missing required field "Food.carb100"`)}
 	}
+	if _, ok := fc.mutation.Userid(); !ok {
+		return &ValidationError{Name: "userid", err: errors.New(`ent: missing required field "Food.userid"`)}
+	}
 	return nil
 }
 
@@ -213,10 +301,34 @@ func (fc *FoodCreate) createSpec() (*Food, *sqlgraph.CreateSpec) {
 		_spec.SetField(food.FieldCarb100, field.TypeFloat64, value)
 		_node.Carb100 = value
 	}
+	if value, ok := fc.mutation.Fiber100(); ok {
+		_spec.SetField(food.FieldFiber100, field.TypeFloat64, value)
+		_node.Fiber100 = &value
+	}
+	if value, ok := fc.mutation.Sugar100(); ok {
+		_spec.SetField(food.FieldSugar100, field.TypeFloat64, value)
+		_node.Sugar100 = &value
+	}
+	if value, ok := fc.mutation.Satfat100(); ok {
+		_spec.SetField(food.FieldSatfat100, field.TypeFloat64, value)
+		_node.Satfat100 = &value
+	}
+	if value, ok := fc.mutation.Salt100(); ok {
+		_spec.SetField(food.FieldSalt100, field.TypeFloat64, value)
+		_node.Salt100 = &value
+	}
 	if value, ok := fc.mutation.Comment(); ok {
 		_spec.SetField(food.FieldComment, field.TypeString, value)
 		_node.Comment = value
 	}
+	if value, ok := fc.mutation.Portions(); ok {
+		_spec.SetField(food.FieldPortions, field.TypeJSON, value)
+		_node.Portions = value
+	}
+	if value, ok := fc.mutation.Userid(); ok {
+		_spec.SetField(food.FieldUserid, field.TypeInt64, value)
+		_node.Userid = value
+	}
 	if nodes := fc.mutation.JournalsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -399,6 +511,102 @@ func (u *FoodUpsert) AddCarb100(v float64) *FoodUpsert {
 	return u
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (u *FoodUpsert) SetFiber100(v float64) *FoodUpsert {
+	u.Set(food.FieldFiber100, v)
+	return u
+}
+
+// UpdateFiber100 sets the "fiber100" field to the value that was provided on create.
+func (u *FoodUpsert) UpdateFiber100() *FoodUpsert {
+	u.SetExcluded(food.FieldFiber100)
+	return u
+}
+
+// AddFiber100 adds v to the "fiber100" field.
+func (u *FoodUpsert) AddFiber100(v float64) *FoodUpsert {
+	u.Add(food.FieldFiber100, v)
+	return u
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (u *FoodUpsert) ClearFiber100() *FoodUpsert {
+	u.SetNull(food.FieldFiber100)
+	return u
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (u *FoodUpsert) SetSugar100(v float64) *FoodUpsert {
+	u.Set(food.FieldSugar100, v)
+	return u
+}
+
+// UpdateSugar100 sets the "sugar100" field to the value that was provided on create.
+func (u *FoodUpsert) UpdateSugar100() *FoodUpsert {
+	u.SetExcluded(food.FieldSugar100)
+	return u
+}
+
+// AddSugar100 adds v to the "sugar100" field.
+func (u *FoodUpsert) AddSugar100(v float64) *FoodUpsert {
+	u.Add(food.FieldSugar100, v)
+	return u
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (u *FoodUpsert) ClearSugar100() *FoodUpsert {
+	u.SetNull(food.FieldSugar100)
+	return u
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (u *FoodUpsert) SetSatfat100(v float64) *FoodUpsert {
+	u.Set(food.FieldSatfat100, v)
+	return u
+}
+
+// UpdateSatfat100 sets the "satfat100" field to the value that was provided on create.
+func (u *FoodUpsert) UpdateSatfat100() *FoodUpsert {
+	u.SetExcluded(food.FieldSatfat100)
+	return u
+}
+
+// AddSatfat100 adds v to the "satfat100" field.
+func (u *FoodUpsert) AddSatfat100(v float64) *FoodUpsert {
+	u.Add(food.FieldSatfat100, v)
+	return u
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (u *FoodUpsert) ClearSatfat100() *FoodUpsert {
+	u.SetNull(food.FieldSatfat100)
+	return u
+}
+
+// SetSalt100 sets the "salt100" field.
+func (u *FoodUpsert) SetSalt100(v float64) *FoodUpsert {
+	u.Set(food.FieldSalt100, v)
+	return u
+}
+
+// UpdateSalt100 sets the "salt100" field to the value that was provided on create.
+func (u *FoodUpsert) UpdateSalt100() *FoodUpsert {
+	u.SetExcluded(food.FieldSalt100)
+	return u
+}
+
+// AddSalt100 adds v to the "salt100" field.
+func (u *FoodUpsert) AddSalt100(v float64) *FoodUpsert {
+	u.Add(food.FieldSalt100, v)
+	return u
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (u *FoodUpsert) ClearSalt100() *FoodUpsert {
+	u.SetNull(food.FieldSalt100)
+	return u
+}
+
 // SetComment sets the "comment" field.
 func (u *FoodUpsert) SetComment(v string) *FoodUpsert {
 	u.Set(food.FieldComment, v)
@@ -417,6 +625,42 @@ func (u *FoodUpsert) ClearComment() *FoodUpsert {
 	return u
 }
 
+// SetPortions sets the "portions" field.
+func (u *FoodUpsert) SetPortions(v map[string]float64) *FoodUpsert {
+	u.Set(food.FieldPortions, v)
+	return u
+}
+
+// UpdatePortions sets the "portions" field to the value that was provided on create.
+func (u *FoodUpsert) UpdatePortions() *FoodUpsert {
+	u.SetExcluded(food.FieldPortions)
+	return u
+}
+
+// ClearPortions clears the value of the "portions" field.
+func (u *FoodUpsert) ClearPortions() *FoodUpsert {
+	u.SetNull(food.FieldPortions)
+	return u
+}
+
+// SetUserid sets the "userid" field.
+func (u *FoodUpsert) SetUserid(v int64) *FoodUpsert {
+	u.Set(food.FieldUserid, v)
+	return u
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *FoodUpsert) UpdateUserid() *FoodUpsert {
+	u.SetExcluded(food.FieldUserid)
+	return u
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *FoodUpsert) AddUserid(v int64) *FoodUpsert {
+	u.Add(food.FieldUserid, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -590,6 +834,118 @@ func (u *FoodUpsertOne) UpdateCarb100() *FoodUpsertOne {
 	})
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (u *FoodUpsertOne) SetFiber100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetFiber100(v)
+	})
+}
+
+// AddFiber100 adds v to the "fiber100" field.
+func (u *FoodUpsertOne) AddFiber100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddFiber100(v)
+	})
+}
+
+// UpdateFiber100 sets the "fiber100" field to the value that was provided on create.
+func (u *FoodUpsertOne) UpdateFiber100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateFiber100()
+	})
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (u *FoodUpsertOne) ClearFiber100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearFiber100()
+	})
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (u *FoodUpsertOne) SetSugar100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetSugar100(v)
+	})
+}
+
+// AddSugar100 adds v to the "sugar100" field.
+func (u *FoodUpsertOne) AddSugar100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddSugar100(v)
+	})
+}
+
+// UpdateSugar100 sets the "sugar100" field to the value that was provided on create.
+func (u *FoodUpsertOne) UpdateSugar100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateSugar100()
+	})
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (u *FoodUpsertOne) ClearSugar100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearSugar100()
+	})
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (u *FoodUpsertOne) SetSatfat100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetSatfat100(v)
+	})
+}
+
+// AddSatfat100 adds v to the "satfat100" field.
+func (u *FoodUpsertOne) AddSatfat100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddSatfat100(v)
+	})
+}
+
+// UpdateSatfat100 sets the "satfat100" field to the value that was provided on create.
+func (u *FoodUpsertOne) UpdateSatfat100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateSatfat100()
+	})
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (u *FoodUpsertOne) ClearSatfat100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearSatfat100()
+	})
+}
+
+// SetSalt100 sets the "salt100" field.
+func (u *FoodUpsertOne) SetSalt100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetSalt100(v)
+	})
+}
+
+// AddSalt100 adds v to the "salt100" field.
+func (u *FoodUpsertOne) AddSalt100(v float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddSalt100(v)
+	})
+}
+
+// UpdateSalt100 sets the "salt100" field to the value that was provided on create.
+func (u *FoodUpsertOne) UpdateSalt100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateSalt100()
+	})
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (u *FoodUpsertOne) ClearSalt100() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearSalt100()
+	})
+}
+
 // SetComment sets the "comment" field.
 func (u *FoodUpsertOne) SetComment(v string) *FoodUpsertOne {
 	return u.Update(func(s *FoodUpsert) {
@@ -611,6 +967,48 @@ func (u *FoodUpsertOne) ClearComment() *FoodUpsertOne {
 	})
 }
 
+// SetPortions sets the "portions" field.
+func (u *FoodUpsertOne) SetPortions(v map[string]float64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetPortions(v)
+	})
+}
+
+// UpdatePortions sets the "portions" field to the value that was provided on create.
+func (u *FoodUpsertOne) UpdatePortions() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdatePortions()
+	})
+}
+
+// ClearPortions clears the value of the "portions" field.
+func (u *FoodUpsertOne) ClearPortions() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearPortions()
+	})
+}
+
+// SetUserid sets the "userid" field.
+func (u *FoodUpsertOne) SetUserid(v int64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetUserid(v)
+	})
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *FoodUpsertOne) AddUserid(v int64) *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddUserid(v)
+	})
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *FoodUpsertOne) UpdateUserid() *FoodUpsertOne {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateUserid()
+	})
+}
+
 // Exec executes the query.
 func (u *FoodUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -663,6 +1061,7 @@ func (fcb *FoodCreateBulk) Save(ctx context.Context) ([]*Food, error) {
 	for i := range fcb.builders {
 		func(i int, root context.Context) {
 			builder := fcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*FoodMutation)
 				if !ok {
@@ -947,6 +1346,118 @@ func (u *FoodUpsertBulk) UpdateCarb100() *FoodUpsertBulk {
 	})
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (u *FoodUpsertBulk) SetFiber100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetFiber100(v)
+	})
+}
+
+// AddFiber100 adds v to the "fiber100" field.
+func (u *FoodUpsertBulk) AddFiber100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddFiber100(v)
+	})
+}
+
+// UpdateFiber100 sets the "fiber100" field to the value that was provided on create.
+func (u *FoodUpsertBulk) UpdateFiber100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateFiber100()
+	})
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (u *FoodUpsertBulk) ClearFiber100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearFiber100()
+	})
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (u *FoodUpsertBulk) SetSugar100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetSugar100(v)
+	})
+}
+
+// AddSugar100 adds v to the "sugar100" field.
+func (u *FoodUpsertBulk) AddSugar100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddSugar100(v)
+	})
+}
+
+// UpdateSugar100 sets the "sugar100" field to the value that was provided on create.
+func (u *FoodUpsertBulk) UpdateSugar100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateSugar100()
+	})
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (u *FoodUpsertBulk) ClearSugar100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearSugar100()
+	})
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (u *FoodUpsertBulk) SetSatfat100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetSatfat100(v)
+	})
+}
+
+// AddSatfat100 adds v to the "satfat100" field.
+func (u *FoodUpsertBulk) AddSatfat100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddSatfat100(v)
+	})
+}
+
+// UpdateSatfat100 sets the "satfat100" field to the value that was provided on create.
+func (u *FoodUpsertBulk) UpdateSatfat100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateSatfat100()
+	})
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (u *FoodUpsertBulk) ClearSatfat100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearSatfat100()
+	})
+}
+
+// SetSalt100 sets the "salt100" field.
+func (u *FoodUpsertBulk) SetSalt100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetSalt100(v)
+	})
+}
+
+// AddSalt100 adds v to the "salt100" field.
+func (u *FoodUpsertBulk) AddSalt100(v float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddSalt100(v)
+	})
+}
+
+// UpdateSalt100 sets the "salt100" field to the value that was provided on create.
+func (u *FoodUpsertBulk) UpdateSalt100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateSalt100()
+	})
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (u *FoodUpsertBulk) ClearSalt100() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearSalt100()
+	})
+}
+
 // SetComment sets the "comment" field.
 func (u *FoodUpsertBulk) SetComment(v string) *FoodUpsertBulk {
 	return u.Update(func(s *FoodUpsert) {
@@ -968,6 +1479,48 @@ func (u *FoodUpsertBulk) ClearComment() *FoodUpsertBulk {
 	})
 }
 
+// SetPortions sets the "portions" field.
+func (u *FoodUpsertBulk) SetPortions(v map[string]float64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetPortions(v)
+	})
+}
+
+// UpdatePortions sets the "portions" field to the value that was provided on create.
+func (u *FoodUpsertBulk) UpdatePortions() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdatePortions()
+	})
+}
+
+// ClearPortions clears the value of the "portions" field.
+func (u *FoodUpsertBulk) ClearPortions() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.ClearPortions()
+	})
+}
+
+// SetUserid sets the "userid" field.
+func (u *FoodUpsertBulk) SetUserid(v int64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.SetUserid(v)
+	})
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *FoodUpsertBulk) AddUserid(v int64) *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.AddUserid(v)
+	})
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *FoodUpsertBulk) UpdateUserid() *FoodUpsertBulk {
+	return u.Update(func(s *FoodUpsert) {
+		s.UpdateUserid()
+	})
+}
+
 // Exec executes the query.
 func (u *FoodUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
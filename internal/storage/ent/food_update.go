@@ -161,6 +161,114 @@ func (fu *FoodUpdate) AddCarb100(f float64) *FoodUpdate {
 	return fu
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (fu *FoodUpdate) SetFiber100(f float64) *FoodUpdate {
+	fu.mutation.ResetFiber100()
+	fu.mutation.SetFiber100(f)
+	return fu
+}
+
+// SetNillableFiber100 sets the "fiber100" field if the given value is not nil.
+func (fu *FoodUpdate) SetNillableFiber100(f *float64) *FoodUpdate {
+	if f != nil {
+		fu.SetFiber100(*f)
+	}
+	return fu
+}
+
+// AddFiber100 adds f to the "fiber100" field.
+func (fu *FoodUpdate) AddFiber100(f float64) *FoodUpdate {
+	fu.mutation.AddFiber100(f)
+	return fu
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (fu *FoodUpdate) ClearFiber100() *FoodUpdate {
+	fu.mutation.ClearFiber100()
+	return fu
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (fu *FoodUpdate) SetSugar100(f float64) *FoodUpdate {
+	fu.mutation.ResetSugar100()
+	fu.mutation.SetSugar100(f)
+	return fu
+}
+
+// SetNillableSugar100 sets the "sugar100" field if the given value is not nil.
+func (fu *FoodUpdate) SetNillableSugar100(f *float64) *FoodUpdate {
+	if f != nil {
+		fu.SetSugar100(*f)
+	}
+	return fu
+}
+
+// AddSugar100 adds f to the "sugar100" field.
+func (fu *FoodUpdate) AddSugar100(f float64) *FoodUpdate {
+	fu.mutation.AddSugar100(f)
+	return fu
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (fu *FoodUpdate) ClearSugar100() *FoodUpdate {
+	fu.mutation.ClearSugar100()
+	return fu
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (fu *FoodUpdate) SetSatfat100(f float64) *FoodUpdate {
+	fu.mutation.ResetSatfat100()
+	fu.mutation.SetSatfat100(f)
+	return fu
+}
+
+// SetNillableSatfat100 sets the "satfat100" field if the given value is not nil.
+func (fu *FoodUpdate) SetNillableSatfat100(f *float64) *FoodUpdate {
+	if f != nil {
+		fu.SetSatfat100(*f)
+	}
+	return fu
+}
+
+// AddSatfat100 adds f to the "satfat100" field.
+func (fu *FoodUpdate) AddSatfat100(f float64) *FoodUpdate {
+	fu.mutation.AddSatfat100(f)
+	return fu
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (fu *FoodUpdate) ClearSatfat100() *FoodUpdate {
+	fu.mutation.ClearSatfat100()
+	return fu
+}
+
+// SetSalt100 sets the "salt100" field.
+func (fu *FoodUpdate) SetSalt100(f float64) *FoodUpdate {
+	fu.mutation.ResetSalt100()
+	fu.mutation.SetSalt100(f)
+	return fu
+}
+
+// SetNillableSalt100 sets the "salt100" field if the given value is not nil.
+func (fu *FoodUpdate) SetNillableSalt100(f *float64) *FoodUpdate {
+	if f != nil {
+		fu.SetSalt100(*f)
+	}
+	return fu
+}
+
+// AddSalt100 adds f to the "salt100" field.
+func (fu *FoodUpdate) AddSalt100(f float64) *FoodUpdate {
+	fu.mutation.AddSalt100(f)
+	return fu
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (fu *FoodUpdate) ClearSalt100() *FoodUpdate {
+	fu.mutation.ClearSalt100()
+	return fu
+}
+
 // SetComment sets the "comment" field.
 func (fu *FoodUpdate) SetComment(s string) *FoodUpdate {
 	fu.mutation.SetComment(s)
@@ -181,6 +289,39 @@ func (fu *FoodUpdate) ClearComment() *FoodUpdate {
 	return fu
 }
 
+// SetPortions sets the "portions" field.
+func (fu *FoodUpdate) SetPortions(m map[string]float64) *FoodUpdate {
+	fu.mutation.SetPortions(m)
+	return fu
+}
+
+// ClearPortions clears the value of the "portions" field.
+func (fu *FoodUpdate) ClearPortions() *FoodUpdate {
+	fu.mutation.ClearPortions()
+	return fu
+}
+
+// SetUserid sets the "userid" field.
+func (fu *FoodUpdate) SetUserid(i int64) *FoodUpdate {
+	fu.mutation.ResetUserid()
+	fu.mutation.SetUserid(i)
+	return fu
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (fu *FoodUpdate) SetNillableUserid(i *int64) *FoodUpdate {
+	if i != nil {
+		fu.SetUserid(*i)
+	}
+	return fu
+}
+
+// AddUserid adds i to the "userid" field.
+func (fu *FoodUpdate) AddUserid(i int64) *FoodUpdate {
+	fu.mutation.AddUserid(i)
+	return fu
+}
+
 // AddJournalIDs adds the "journals" edge to the Journal entity by IDs.
 func (fu *FoodUpdate) AddJournalIDs(ids ...int) *FoodUpdate {
 	fu.mutation.AddJournalIDs(ids...)
@@ -313,12 +454,60 @@ func (fu *FoodUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.AddedCarb100(); ok {
 		_spec.AddField(food.FieldCarb100, field.TypeFloat64, value)
 	}
+	if value, ok := fu.mutation.Fiber100(); ok {
+		_spec.SetField(food.FieldFiber100, field.TypeFloat64, value)
+	}
+	if value, ok := fu.mutation.AddedFiber100(); ok {
+		_spec.AddField(food.FieldFiber100, field.TypeFloat64, value)
+	}
+	if fu.mutation.Fiber100Cleared() {
+		_spec.ClearField(food.FieldFiber100, field.TypeFloat64)
+	}
+	if value, ok := fu.mutation.Sugar100(); ok {
+		_spec.SetField(food.FieldSugar100, field.TypeFloat64, value)
+	}
+	if value, ok := fu.mutation.AddedSugar100(); ok {
+		_spec.AddField(food.FieldSugar100, field.TypeFloat64, value)
+	}
+	if fu.mutation.Sugar100Cleared() {
+		_spec.ClearField(food.FieldSugar100, field.TypeFloat64)
+	}
+	if value, ok := fu.mutation.Satfat100(); ok {
+		_spec.SetField(food.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if value, ok := fu.mutation.AddedSatfat100(); ok {
+		_spec.AddField(food.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if fu.mutation.Satfat100Cleared() {
+		_spec.ClearField(food.FieldSatfat100, field.TypeFloat64)
+	}
+	if value, ok := fu.mutation.Salt100(); ok {
+		_spec.SetField(food.FieldSalt100, field.TypeFloat64, value)
+	}
+	if value, ok := fu.mutation.AddedSalt100(); ok {
+		_spec.AddField(food.FieldSalt100, field.TypeFloat64, value)
+	}
+	if fu.mutation.Salt100Cleared() {
+		_spec.ClearField(food.FieldSalt100, field.TypeFloat64)
+	}
 	if value, ok := fu.mutation.Comment(); ok {
 		_spec.SetField(food.FieldComment, field.TypeString, value)
 	}
 	if fu.mutation.CommentCleared() {
 		_spec.ClearField(food.FieldComment, field.TypeString)
 	}
+	if value, ok := fu.mutation.Portions(); ok {
+		_spec.SetField(food.FieldPortions, field.TypeJSON, value)
+	}
+	if fu.mutation.PortionsCleared() {
+		_spec.ClearField(food.FieldPortions, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.Userid(); ok {
+		_spec.SetField(food.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := fu.mutation.AddedUserid(); ok {
+		_spec.AddField(food.FieldUserid, field.TypeInt64, value)
+	}
 	if fu.mutation.JournalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -518,6 +707,114 @@ func (fuo *FoodUpdateOne) AddCarb100(f float64) *FoodUpdateOne {
 	return fuo
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (fuo *FoodUpdateOne) SetFiber100(f float64) *FoodUpdateOne {
+	fuo.mutation.ResetFiber100()
+	fuo.mutation.SetFiber100(f)
+	return fuo
+}
+
+// SetNillableFiber100 sets the "fiber100" field if the given value is not nil.
+func (fuo *FoodUpdateOne) SetNillableFiber100(f *float64) *FoodUpdateOne {
+	if f != nil {
+		fuo.SetFiber100(*f)
+	}
+	return fuo
+}
+
+// AddFiber100 adds f to the "fiber100" field.
+func (fuo *FoodUpdateOne) AddFiber100(f float64) *FoodUpdateOne {
+	fuo.mutation.AddFiber100(f)
+	return fuo
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (fuo *FoodUpdateOne) ClearFiber100() *FoodUpdateOne {
+	fuo.mutation.ClearFiber100()
+	return fuo
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (fuo *FoodUpdateOne) SetSugar100(f float64) *FoodUpdateOne {
+	fuo.mutation.ResetSugar100()
+	fuo.mutation.SetSugar100(f)
+	return fuo
+}
+
+// SetNillableSugar100 sets the "sugar100" field if the given value is not nil.
+func (fuo *FoodUpdateOne) SetNillableSugar100(f *float64) *FoodUpdateOne {
+	if f != nil {
+		fuo.SetSugar100(*f)
+	}
+	return fuo
+}
+
+// AddSugar100 adds f to the "sugar100" field.
+func (fuo *FoodUpdateOne) AddSugar100(f float64) *FoodUpdateOne {
+	fuo.mutation.AddSugar100(f)
+	return fuo
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (fuo *FoodUpdateOne) ClearSugar100() *FoodUpdateOne {
+	fuo.mutation.ClearSugar100()
+	return fuo
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (fuo *FoodUpdateOne) SetSatfat100(f float64) *FoodUpdateOne {
+	fuo.mutation.ResetSatfat100()
+	fuo.mutation.SetSatfat100(f)
+	return fuo
+}
+
+// SetNillableSatfat100 sets the "satfat100" field if the given value is not nil.
+func (fuo *FoodUpdateOne) SetNillableSatfat100(f *float64) *FoodUpdateOne {
+	if f != nil {
+		fuo.SetSatfat100(*f)
+	}
+	return fuo
+}
+
+// AddSatfat100 adds f to the "satfat100" field.
+func (fuo *FoodUpdateOne) AddSatfat100(f float64) *FoodUpdateOne {
+	fuo.mutation.AddSatfat100(f)
+	return fuo
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (fuo *FoodUpdateOne) ClearSatfat100() *FoodUpdateOne {
+	fuo.mutation.ClearSatfat100()
+	return fuo
+}
+
+// SetSalt100 sets the "salt100" field.
+func (fuo *FoodUpdateOne) SetSalt100(f float64) *FoodUpdateOne {
+	fuo.mutation.ResetSalt100()
+	fuo.mutation.SetSalt100(f)
+	return fuo
+}
+
+// SetNillableSalt100 sets the "salt100" field if the given value is not nil.
+func (fuo *FoodUpdateOne) SetNillableSalt100(f *float64) *FoodUpdateOne {
+	if f != nil {
+		fuo.SetSalt100(*f)
+	}
+	return fuo
+}
+
+// AddSalt100 adds f to the "salt100" field.
+func (fuo *FoodUpdateOne) AddSalt100(f float64) *FoodUpdateOne {
+	fuo.mutation.AddSalt100(f)
+	return fuo
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (fuo *FoodUpdateOne) ClearSalt100() *FoodUpdateOne {
+	fuo.mutation.ClearSalt100()
+	return fuo
+}
+
 // SetComment sets the "comment" field.
 func (fuo *FoodUpdateOne) SetComment(s string) *FoodUpdateOne {
 	fuo.mutation.SetComment(s)
@@ -538,6 +835,39 @@ func (fuo *FoodUpdateOne) ClearComment() *FoodUpdateOne {
 	return fuo
 }
 
+// SetPortions sets the "portions" field.
+func (fuo *FoodUpdateOne) SetPortions(m map[string]float64) *FoodUpdateOne {
+	fuo.mutation.SetPortions(m)
+	return fuo
+}
+
+// ClearPortions clears the value of the "portions" field.
+func (fuo *FoodUpdateOne) ClearPortions() *FoodUpdateOne {
+	fuo.mutation.ClearPortions()
+	return fuo
+}
+
+// SetUserid sets the "userid" field.
+func (fuo *FoodUpdateOne) SetUserid(i int64) *FoodUpdateOne {
+	fuo.mutation.ResetUserid()
+	fuo.mutation.SetUserid(i)
+	return fuo
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (fuo *FoodUpdateOne) SetNillableUserid(i *int64) *FoodUpdateOne {
+	if i != nil {
+		fuo.SetUserid(*i)
+	}
+	return fuo
+}
+
+// AddUserid adds i to the "userid" field.
+func (fuo *FoodUpdateOne) AddUserid(i int64) *FoodUpdateOne {
+	fuo.mutation.AddUserid(i)
+	return fuo
+}
+
 // AddJournalIDs adds the "journals" edge to the Journal entity by IDs.
 func (fuo *FoodUpdateOne) AddJournalIDs(ids ...int) *FoodUpdateOne {
 	fuo.mutation.AddJournalIDs(ids...)
@@ -700,12 +1030,60 @@ func (fuo *FoodUpdateOne) sqlSave(ctx context.Context) (_node *Food, err error)
 	if value, ok := fuo.mutation.AddedCarb100(); ok {
 		_spec.AddField(food.FieldCarb100, field.TypeFloat64, value)
 	}
+	if value, ok := fuo.mutation.Fiber100(); ok {
+		_spec.SetField(food.FieldFiber100, field.TypeFloat64, value)
+	}
+	if value, ok := fuo.mutation.AddedFiber100(); ok {
+		_spec.AddField(food.FieldFiber100, field.TypeFloat64, value)
+	}
+	if fuo.mutation.Fiber100Cleared() {
+		_spec.ClearField(food.FieldFiber100, field.TypeFloat64)
+	}
+	if value, ok := fuo.mutation.Sugar100(); ok {
+		_spec.SetField(food.FieldSugar100, field.TypeFloat64, value)
+	}
+	if value, ok := fuo.mutation.AddedSugar100(); ok {
+		_spec.AddField(food.FieldSugar100, field.TypeFloat64, value)
+	}
+	if fuo.mutation.Sugar100Cleared() {
+		_spec.ClearField(food.FieldSugar100, field.TypeFloat64)
+	}
+	if value, ok := fuo.mutation.Satfat100(); ok {
+		_spec.SetField(food.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if value, ok := fuo.mutation.AddedSatfat100(); ok {
+		_spec.AddField(food.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if fuo.mutation.Satfat100Cleared() {
+		_spec.ClearField(food.FieldSatfat100, field.TypeFloat64)
+	}
+	if value, ok := fuo.mutation.Salt100(); ok {
+		_spec.SetField(food.FieldSalt100, field.TypeFloat64, value)
+	}
+	if value, ok := fuo.mutation.AddedSalt100(); ok {
+		_spec.AddField(food.FieldSalt100, field.TypeFloat64, value)
+	}
+	if fuo.mutation.Salt100Cleared() {
+		_spec.ClearField(food.FieldSalt100, field.TypeFloat64)
+	}
 	if value, ok := fuo.mutation.Comment(); ok {
 		_spec.SetField(food.FieldComment, field.TypeString, value)
 	}
 	if fuo.mutation.CommentCleared() {
 		_spec.ClearField(food.FieldComment, field.TypeString)
 	}
+	if value, ok := fuo.mutation.Portions(); ok {
+		_spec.SetField(food.FieldPortions, field.TypeJSON, value)
+	}
+	if fuo.mutation.PortionsCleared() {
+		_spec.ClearField(food.FieldPortions, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.Userid(); ok {
+		_spec.SetField(food.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := fuo.mutation.AddedUserid(); ok {
+		_spec.AddField(food.FieldUserid, field.TypeInt64, value)
+	}
 	if fuo.mutation.JournalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -57,6 +57,30 @@ func (f JournalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JournalMutation", m)
 }
 
+// The RecipeFunc type is an adapter to allow the use of ordinary
+// function as Recipe mutator.
+type RecipeFunc func(context.Context, *ent.RecipeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecipeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecipeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecipeMutation", m)
+}
+
+// The UserMealFunc type is an adapter to allow the use of ordinary
+// function as UserMeal mutator.
+type UserMealFunc func(context.Context, *ent.UserMealMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserMealFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserMealMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMealMutation", m)
+}
+
 // The UserSettingsFunc type is an adapter to allow the use of ordinary
 // function as UserSettings mutator.
 type UserSettingsFunc func(context.Context, *ent.UserSettingsMutation) (ent.Value, error)
@@ -26,6 +26,28 @@ type Journal struct {
 	Meal int64 `json:"meal,omitempty"`
 	// Foodweight holds the value of the "foodweight" field.
 	Foodweight float64 `json:"foodweight,omitempty"`
+	// Daytime holds the value of the "daytime" field.
+	Daytime *int64 `json:"daytime,omitempty"`
+	// Portion holds the value of the "portion" field.
+	Portion string `json:"portion,omitempty"`
+	// Portioncount holds the value of the "portioncount" field.
+	Portioncount float64 `json:"portioncount,omitempty"`
+	// Cal100 holds the value of the "cal100" field.
+	Cal100 *float64 `json:"cal100,omitempty"`
+	// Prot100 holds the value of the "prot100" field.
+	Prot100 *float64 `json:"prot100,omitempty"`
+	// Fat100 holds the value of the "fat100" field.
+	Fat100 *float64 `json:"fat100,omitempty"`
+	// Carb100 holds the value of the "carb100" field.
+	Carb100 *float64 `json:"carb100,omitempty"`
+	// Fiber100 holds the value of the "fiber100" field.
+	Fiber100 *float64 `json:"fiber100,omitempty"`
+	// Sugar100 holds the value of the "sugar100" field.
+	Sugar100 *float64 `json:"sugar100,omitempty"`
+	// Satfat100 holds the value of the "satfat100" field.
+	Satfat100 *float64 `json:"satfat100,omitempty"`
+	// Salt100 holds the value of the "salt100" field.
+	Salt100 *float64 `json:"salt100,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the JournalQuery when eager-loading is set.
 	Edges         JournalEdges `json:"edges"`
@@ -58,10 +80,12 @@ func (*Journal) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case journal.FieldFoodweight:
+		case journal.FieldFoodweight, journal.FieldPortioncount, journal.FieldCal100, journal.FieldProt100, journal.FieldFat100, journal.FieldCarb100, journal.FieldFiber100, journal.FieldSugar100, journal.FieldSatfat100, journal.FieldSalt100:
 			values[i] = new(sql.NullFloat64)
-		case journal.FieldID, journal.FieldUserid, journal.FieldMeal:
+		case journal.FieldID, journal.FieldUserid, journal.FieldMeal, journal.FieldDaytime:
 			values[i] = new(sql.NullInt64)
+		case journal.FieldPortion:
+			values[i] = new(sql.NullString)
 		case journal.FieldTimestamp:
 			values[i] = new(sql.NullTime)
 		case journal.ForeignKeys[0]: // food_journals
@@ -111,6 +135,81 @@ func (j *Journal) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				j.Foodweight = value.Float64
 			}
+		case journal.FieldDaytime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field daytime", values[i])
+			} else if value.Valid {
+				j.Daytime = new(int64)
+				*j.Daytime = value.Int64
+			}
+		case journal.FieldPortion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field portion", values[i])
+			} else if value.Valid {
+				j.Portion = value.String
+			}
+		case journal.FieldPortioncount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field portioncount", values[i])
+			} else if value.Valid {
+				j.Portioncount = value.Float64
+			}
+		case journal.FieldCal100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cal100", values[i])
+			} else if value.Valid {
+				j.Cal100 = new(float64)
+				*j.Cal100 = value.Float64
+			}
+		case journal.FieldProt100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field prot100", values[i])
+			} else if value.Valid {
+				j.Prot100 = new(float64)
+				*j.Prot100 = value.Float64
+			}
+		case journal.FieldFat100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fat100", values[i])
+			} else if value.Valid {
+				j.Fat100 = new(float64)
+				*j.Fat100 = value.Float64
+			}
+		case journal.FieldCarb100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field carb100", values[i])
+			} else if value.Valid {
+				j.Carb100 = new(float64)
+				*j.Carb100 = value.Float64
+			}
+		case journal.FieldFiber100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fiber100", values[i])
+			} else if value.Valid {
+				j.Fiber100 = new(float64)
+				*j.Fiber100 = value.Float64
+			}
+		case journal.FieldSugar100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field sugar100", values[i])
+			} else if value.Valid {
+				j.Sugar100 = new(float64)
+				*j.Sugar100 = value.Float64
+			}
+		case journal.FieldSatfat100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field satfat100", values[i])
+			} else if value.Valid {
+				j.Satfat100 = new(float64)
+				*j.Satfat100 = value.Float64
+			}
+		case journal.FieldSalt100:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field salt100", values[i])
+			} else if value.Valid {
+				j.Salt100 = new(float64)
+				*j.Salt100 = value.Float64
+			}
 		case journal.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field food_journals", value)
@@ -170,6 +269,57 @@ func (j *Journal) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("foodweight=")
 	builder.WriteString(fmt.Sprintf("%v", j.Foodweight))
+	builder.WriteString(", ")
+	if v := j.Daytime; v != nil {
+		builder.WriteString("daytime=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("portion=")
+	builder.WriteString(j.Portion)
+	builder.WriteString(", ")
+	builder.WriteString("portioncount=")
+	builder.WriteString(fmt.Sprintf("%v", j.Portioncount))
+	builder.WriteString(", ")
+	if v := j.Cal100; v != nil {
+		builder.WriteString("cal100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Prot100; v != nil {
+		builder.WriteString("prot100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Fat100; v != nil {
+		builder.WriteString("fat100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Carb100; v != nil {
+		builder.WriteString("carb100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Fiber100; v != nil {
+		builder.WriteString("fiber100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Sugar100; v != nil {
+		builder.WriteString("sugar100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Satfat100; v != nil {
+		builder.WriteString("satfat100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := j.Salt100; v != nil {
+		builder.WriteString("salt100=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,6 +20,28 @@ const (
 	FieldMeal = "meal"
 	// FieldFoodweight holds the string denoting the foodweight field in the database.
 	FieldFoodweight = "foodweight"
+	// FieldDaytime holds the string denoting the daytime field in the database.
+	FieldDaytime = "daytime"
+	// FieldPortion holds the string denoting the portion field in the database.
+	FieldPortion = "portion"
+	// FieldPortioncount holds the string denoting the portioncount field in the database.
+	FieldPortioncount = "portioncount"
+	// FieldCal100 holds the string denoting the cal100 field in the database.
+	FieldCal100 = "cal100"
+	// FieldProt100 holds the string denoting the prot100 field in the database.
+	FieldProt100 = "prot100"
+	// FieldFat100 holds the string denoting the fat100 field in the database.
+	FieldFat100 = "fat100"
+	// FieldCarb100 holds the string denoting the carb100 field in the database.
+	FieldCarb100 = "carb100"
+	// FieldFiber100 holds the string denoting the fiber100 field in the database.
+	FieldFiber100 = "fiber100"
+	// FieldSugar100 holds the string denoting the sugar100 field in the database.
+	FieldSugar100 = "sugar100"
+	// FieldSatfat100 holds the string denoting the satfat100 field in the database.
+	FieldSatfat100 = "satfat100"
+	// FieldSalt100 holds the string denoting the salt100 field in the database.
+	FieldSalt100 = "salt100"
 	// EdgeFood holds the string denoting the food edge name in mutations.
 	EdgeFood = "food"
 	// Table holds the table name of the journal in the database.
@@ -40,6 +62,17 @@ var Columns = []string{
 	FieldTimestamp,
 	FieldMeal,
 	FieldFoodweight,
+	FieldDaytime,
+	FieldPortion,
+	FieldPortioncount,
+	FieldCal100,
+	FieldProt100,
+	FieldFat100,
+	FieldCarb100,
+	FieldFiber100,
+	FieldSugar100,
+	FieldSatfat100,
+	FieldSalt100,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "journals"
@@ -63,6 +96,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultPortion holds the default value on creation for the "portion" field.
+	DefaultPortion string
+	// DefaultPortioncount holds the default value on creation for the "portioncount" field.
+	DefaultPortioncount float64
+)
+
 // OrderOption defines the ordering options for the Journal queries.
 type OrderOption func(*sql.Selector)
 
@@ -91,6 +131,61 @@ func ByFoodweight(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFoodweight, opts...).ToFunc()
 }
 
+// ByDaytime orders the results by the daytime field.
+func ByDaytime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDaytime, opts...).ToFunc()
+}
+
+// ByPortion orders the results by the portion field.
+func ByPortion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPortion, opts...).ToFunc()
+}
+
+// ByPortioncount orders the results by the portioncount field.
+func ByPortioncount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPortioncount, opts...).ToFunc()
+}
+
+// ByCal100 orders the results by the cal100 field.
+func ByCal100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCal100, opts...).ToFunc()
+}
+
+// ByProt100 orders the results by the prot100 field.
+func ByProt100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProt100, opts...).ToFunc()
+}
+
+// ByFat100 orders the results by the fat100 field.
+func ByFat100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFat100, opts...).ToFunc()
+}
+
+// ByCarb100 orders the results by the carb100 field.
+func ByCarb100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarb100, opts...).ToFunc()
+}
+
+// ByFiber100 orders the results by the fiber100 field.
+func ByFiber100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFiber100, opts...).ToFunc()
+}
+
+// BySugar100 orders the results by the sugar100 field.
+func BySugar100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSugar100, opts...).ToFunc()
+}
+
+// BySatfat100 orders the results by the satfat100 field.
+func BySatfat100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSatfat100, opts...).ToFunc()
+}
+
+// BySalt100 orders the results by the salt100 field.
+func BySalt100(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalt100, opts...).ToFunc()
+}
+
 // ByFoodField orders the results by food field.
 func ByFoodField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
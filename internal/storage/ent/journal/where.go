@@ -75,6 +75,61 @@ func Foodweight(v float64) predicate.Journal {
 	return predicate.Journal(sql.FieldEQ(FieldFoodweight, v))
 }
 
+// Daytime applies equality check predicate on the "daytime" field. It's identical to DaytimeEQ.
+func Daytime(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldDaytime, v))
+}
+
+// Portion applies equality check predicate on the "portion" field. It's identical to PortionEQ.
+func Portion(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldPortion, v))
+}
+
+// Portioncount applies equality check predicate on the "portioncount" field. It's identical to PortioncountEQ.
+func Portioncount(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldPortioncount, v))
+}
+
+// Cal100 applies equality check predicate on the "cal100" field. It's identical to Cal100EQ.
+func Cal100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldCal100, v))
+}
+
+// Prot100 applies equality check predicate on the "prot100" field. It's identical to Prot100EQ.
+func Prot100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldProt100, v))
+}
+
+// Fat100 applies equality check predicate on the "fat100" field. It's identical to Fat100EQ.
+func Fat100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldFat100, v))
+}
+
+// Carb100 applies equality check predicate on the "carb100" field. It's identical to Carb100EQ.
+func Carb100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldCarb100, v))
+}
+
+// Fiber100 applies equality check predicate on the "fiber100" field. It's identical to Fiber100EQ.
+func Fiber100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldFiber100, v))
+}
+
+// Sugar100 applies equality check predicate on the "sugar100" field. It's identical to Sugar100EQ.
+func Sugar100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldSugar100, v))
+}
+
+// Satfat100 applies equality check predicate on the "satfat100" field. It's identical to Satfat100EQ.
+func Satfat100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldSatfat100, v))
+}
+
+// Salt100 applies equality check predicate on the "salt100" field. It's identical to Salt100EQ.
+func Salt100(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldSalt100, v))
+}
+
 // UseridEQ applies the EQ predicate on the "userid" field.
 func UseridEQ(v int64) predicate.Journal {
 	return predicate.Journal(sql.FieldEQ(FieldUserid, v))
@@ -235,6 +290,561 @@ func FoodweightLTE(v float64) predicate.Journal {
 	return predicate.Journal(sql.FieldLTE(FieldFoodweight, v))
 }
 
+// DaytimeEQ applies the EQ predicate on the "daytime" field.
+func DaytimeEQ(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldDaytime, v))
+}
+
+// DaytimeNEQ applies the NEQ predicate on the "daytime" field.
+func DaytimeNEQ(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldDaytime, v))
+}
+
+// DaytimeIn applies the In predicate on the "daytime" field.
+func DaytimeIn(vs ...int64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldDaytime, vs...))
+}
+
+// DaytimeNotIn applies the NotIn predicate on the "daytime" field.
+func DaytimeNotIn(vs ...int64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldDaytime, vs...))
+}
+
+// DaytimeGT applies the GT predicate on the "daytime" field.
+func DaytimeGT(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldDaytime, v))
+}
+
+// DaytimeGTE applies the GTE predicate on the "daytime" field.
+func DaytimeGTE(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldDaytime, v))
+}
+
+// DaytimeLT applies the LT predicate on the "daytime" field.
+func DaytimeLT(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldDaytime, v))
+}
+
+// DaytimeLTE applies the LTE predicate on the "daytime" field.
+func DaytimeLTE(v int64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldDaytime, v))
+}
+
+// DaytimeIsNil applies the IsNil predicate on the "daytime" field.
+func DaytimeIsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldDaytime))
+}
+
+// DaytimeNotNil applies the NotNil predicate on the "daytime" field.
+func DaytimeNotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldDaytime))
+}
+
+// PortionEQ applies the EQ predicate on the "portion" field.
+func PortionEQ(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldPortion, v))
+}
+
+// PortionNEQ applies the NEQ predicate on the "portion" field.
+func PortionNEQ(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldPortion, v))
+}
+
+// PortionIn applies the In predicate on the "portion" field.
+func PortionIn(vs ...string) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldPortion, vs...))
+}
+
+// PortionNotIn applies the NotIn predicate on the "portion" field.
+func PortionNotIn(vs ...string) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldPortion, vs...))
+}
+
+// PortionGT applies the GT predicate on the "portion" field.
+func PortionGT(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldPortion, v))
+}
+
+// PortionGTE applies the GTE predicate on the "portion" field.
+func PortionGTE(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldPortion, v))
+}
+
+// PortionLT applies the LT predicate on the "portion" field.
+func PortionLT(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldPortion, v))
+}
+
+// PortionLTE applies the LTE predicate on the "portion" field.
+func PortionLTE(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldPortion, v))
+}
+
+// PortionContains applies the Contains predicate on the "portion" field.
+func PortionContains(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldContains(FieldPortion, v))
+}
+
+// PortionHasPrefix applies the HasPrefix predicate on the "portion" field.
+func PortionHasPrefix(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldHasPrefix(FieldPortion, v))
+}
+
+// PortionHasSuffix applies the HasSuffix predicate on the "portion" field.
+func PortionHasSuffix(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldHasSuffix(FieldPortion, v))
+}
+
+// PortionEqualFold applies the EqualFold predicate on the "portion" field.
+func PortionEqualFold(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldEqualFold(FieldPortion, v))
+}
+
+// PortionContainsFold applies the ContainsFold predicate on the "portion" field.
+func PortionContainsFold(v string) predicate.Journal {
+	return predicate.Journal(sql.FieldContainsFold(FieldPortion, v))
+}
+
+// PortioncountEQ applies the EQ predicate on the "portioncount" field.
+func PortioncountEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldPortioncount, v))
+}
+
+// PortioncountNEQ applies the NEQ predicate on the "portioncount" field.
+func PortioncountNEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldPortioncount, v))
+}
+
+// PortioncountIn applies the In predicate on the "portioncount" field.
+func PortioncountIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldPortioncount, vs...))
+}
+
+// PortioncountNotIn applies the NotIn predicate on the "portioncount" field.
+func PortioncountNotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldPortioncount, vs...))
+}
+
+// PortioncountGT applies the GT predicate on the "portioncount" field.
+func PortioncountGT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldPortioncount, v))
+}
+
+// PortioncountGTE applies the GTE predicate on the "portioncount" field.
+func PortioncountGTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldPortioncount, v))
+}
+
+// PortioncountLT applies the LT predicate on the "portioncount" field.
+func PortioncountLT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldPortioncount, v))
+}
+
+// PortioncountLTE applies the LTE predicate on the "portioncount" field.
+func PortioncountLTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldPortioncount, v))
+}
+
+// Cal100EQ applies the EQ predicate on the "cal100" field.
+func Cal100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldCal100, v))
+}
+
+// Cal100NEQ applies the NEQ predicate on the "cal100" field.
+func Cal100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldCal100, v))
+}
+
+// Cal100In applies the In predicate on the "cal100" field.
+func Cal100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldCal100, vs...))
+}
+
+// Cal100NotIn applies the NotIn predicate on the "cal100" field.
+func Cal100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldCal100, vs...))
+}
+
+// Cal100GT applies the GT predicate on the "cal100" field.
+func Cal100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldCal100, v))
+}
+
+// Cal100GTE applies the GTE predicate on the "cal100" field.
+func Cal100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldCal100, v))
+}
+
+// Cal100LT applies the LT predicate on the "cal100" field.
+func Cal100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldCal100, v))
+}
+
+// Cal100LTE applies the LTE predicate on the "cal100" field.
+func Cal100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldCal100, v))
+}
+
+// Cal100IsNil applies the IsNil predicate on the "cal100" field.
+func Cal100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldCal100))
+}
+
+// Cal100NotNil applies the NotNil predicate on the "cal100" field.
+func Cal100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldCal100))
+}
+
+// Prot100EQ applies the EQ predicate on the "prot100" field.
+func Prot100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldProt100, v))
+}
+
+// Prot100NEQ applies the NEQ predicate on the "prot100" field.
+func Prot100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldProt100, v))
+}
+
+// Prot100In applies the In predicate on the "prot100" field.
+func Prot100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldProt100, vs...))
+}
+
+// Prot100NotIn applies the NotIn predicate on the "prot100" field.
+func Prot100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldProt100, vs...))
+}
+
+// Prot100GT applies the GT predicate on the "prot100" field.
+func Prot100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldProt100, v))
+}
+
+// Prot100GTE applies the GTE predicate on the "prot100" field.
+func Prot100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldProt100, v))
+}
+
+// Prot100LT applies the LT predicate on the "prot100" field.
+func Prot100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldProt100, v))
+}
+
+// Prot100LTE applies the LTE predicate on the "prot100" field.
+func Prot100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldProt100, v))
+}
+
+// Prot100IsNil applies the IsNil predicate on the "prot100" field.
+func Prot100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldProt100))
+}
+
+// Prot100NotNil applies the NotNil predicate on the "prot100" field.
+func Prot100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldProt100))
+}
+
+// Fat100EQ applies the EQ predicate on the "fat100" field.
+func Fat100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldFat100, v))
+}
+
+// Fat100NEQ applies the NEQ predicate on the "fat100" field.
+func Fat100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldFat100, v))
+}
+
+// Fat100In applies the In predicate on the "fat100" field.
+func Fat100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldFat100, vs...))
+}
+
+// Fat100NotIn applies the NotIn predicate on the "fat100" field.
+func Fat100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldFat100, vs...))
+}
+
+// Fat100GT applies the GT predicate on the "fat100" field.
+func Fat100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldFat100, v))
+}
+
+// Fat100GTE applies the GTE predicate on the "fat100" field.
+func Fat100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldFat100, v))
+}
+
+// Fat100LT applies the LT predicate on the "fat100" field.
+func Fat100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldFat100, v))
+}
+
+// Fat100LTE applies the LTE predicate on the "fat100" field.
+func Fat100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldFat100, v))
+}
+
+// Fat100IsNil applies the IsNil predicate on the "fat100" field.
+func Fat100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldFat100))
+}
+
+// Fat100NotNil applies the NotNil predicate on the "fat100" field.
+func Fat100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldFat100))
+}
+
+// Carb100EQ applies the EQ predicate on the "carb100" field.
+func Carb100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldCarb100, v))
+}
+
+// Carb100NEQ applies the NEQ predicate on the "carb100" field.
+func Carb100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldCarb100, v))
+}
+
+// Carb100In applies the In predicate on the "carb100" field.
+func Carb100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldCarb100, vs...))
+}
+
+// Carb100NotIn applies the NotIn predicate on the "carb100" field.
+func Carb100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldCarb100, vs...))
+}
+
+// Carb100GT applies the GT predicate on the "carb100" field.
+func Carb100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldCarb100, v))
+}
+
+// Carb100GTE applies the GTE predicate on the "carb100" field.
+func Carb100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldCarb100, v))
+}
+
+// Carb100LT applies the LT predicate on the "carb100" field.
+func Carb100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldCarb100, v))
+}
+
+// Carb100LTE applies the LTE predicate on the "carb100" field.
+func Carb100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldCarb100, v))
+}
+
+// Carb100IsNil applies the IsNil predicate on the "carb100" field.
+func Carb100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldCarb100))
+}
+
+// Carb100NotNil applies the NotNil predicate on the "carb100" field.
+func Carb100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldCarb100))
+}
+
+// Fiber100EQ applies the EQ predicate on the "fiber100" field.
+func Fiber100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldFiber100, v))
+}
+
+// Fiber100NEQ applies the NEQ predicate on the "fiber100" field.
+func Fiber100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldFiber100, v))
+}
+
+// Fiber100In applies the In predicate on the "fiber100" field.
+func Fiber100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldFiber100, vs...))
+}
+
+// Fiber100NotIn applies the NotIn predicate on the "fiber100" field.
+func Fiber100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldFiber100, vs...))
+}
+
+// Fiber100GT applies the GT predicate on the "fiber100" field.
+func Fiber100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldFiber100, v))
+}
+
+// Fiber100GTE applies the GTE predicate on the "fiber100" field.
+func Fiber100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldFiber100, v))
+}
+
+// Fiber100LT applies the LT predicate on the "fiber100" field.
+func Fiber100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldFiber100, v))
+}
+
+// Fiber100LTE applies the LTE predicate on the "fiber100" field.
+func Fiber100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldFiber100, v))
+}
+
+// Fiber100IsNil applies the IsNil predicate on the "fiber100" field.
+func Fiber100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldFiber100))
+}
+
+// Fiber100NotNil applies the NotNil predicate on the "fiber100" field.
+func Fiber100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldFiber100))
+}
+
+// Sugar100EQ applies the EQ predicate on the "sugar100" field.
+func Sugar100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldSugar100, v))
+}
+
+// Sugar100NEQ applies the NEQ predicate on the "sugar100" field.
+func Sugar100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldSugar100, v))
+}
+
+// Sugar100In applies the In predicate on the "sugar100" field.
+func Sugar100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldSugar100, vs...))
+}
+
+// Sugar100NotIn applies the NotIn predicate on the "sugar100" field.
+func Sugar100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldSugar100, vs...))
+}
+
+// Sugar100GT applies the GT predicate on the "sugar100" field.
+func Sugar100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldSugar100, v))
+}
+
+// Sugar100GTE applies the GTE predicate on the "sugar100" field.
+func Sugar100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldSugar100, v))
+}
+
+// Sugar100LT applies the LT predicate on the "sugar100" field.
+func Sugar100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldSugar100, v))
+}
+
+// Sugar100LTE applies the LTE predicate on the "sugar100" field.
+func Sugar100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldSugar100, v))
+}
+
+// Sugar100IsNil applies the IsNil predicate on the "sugar100" field.
+func Sugar100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldSugar100))
+}
+
+// Sugar100NotNil applies the NotNil predicate on the "sugar100" field.
+func Sugar100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldSugar100))
+}
+
+// Satfat100EQ applies the EQ predicate on the "satfat100" field.
+func Satfat100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldSatfat100, v))
+}
+
+// Satfat100NEQ applies the NEQ predicate on the "satfat100" field.
+func Satfat100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldSatfat100, v))
+}
+
+// Satfat100In applies the In predicate on the "satfat100" field.
+func Satfat100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldSatfat100, vs...))
+}
+
+// Satfat100NotIn applies the NotIn predicate on the "satfat100" field.
+func Satfat100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldSatfat100, vs...))
+}
+
+// Satfat100GT applies the GT predicate on the "satfat100" field.
+func Satfat100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldSatfat100, v))
+}
+
+// Satfat100GTE applies the GTE predicate on the "satfat100" field.
+func Satfat100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldSatfat100, v))
+}
+
+// Satfat100LT applies the LT predicate on the "satfat100" field.
+func Satfat100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldSatfat100, v))
+}
+
+// Satfat100LTE applies the LTE predicate on the "satfat100" field.
+func Satfat100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldSatfat100, v))
+}
+
+// Satfat100IsNil applies the IsNil predicate on the "satfat100" field.
+func Satfat100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldSatfat100))
+}
+
+// Satfat100NotNil applies the NotNil predicate on the "satfat100" field.
+func Satfat100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldSatfat100))
+}
+
+// Salt100EQ applies the EQ predicate on the "salt100" field.
+func Salt100EQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldEQ(FieldSalt100, v))
+}
+
+// Salt100NEQ applies the NEQ predicate on the "salt100" field.
+func Salt100NEQ(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNEQ(FieldSalt100, v))
+}
+
+// Salt100In applies the In predicate on the "salt100" field.
+func Salt100In(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldIn(FieldSalt100, vs...))
+}
+
+// Salt100NotIn applies the NotIn predicate on the "salt100" field.
+func Salt100NotIn(vs ...float64) predicate.Journal {
+	return predicate.Journal(sql.FieldNotIn(FieldSalt100, vs...))
+}
+
+// Salt100GT applies the GT predicate on the "salt100" field.
+func Salt100GT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGT(FieldSalt100, v))
+}
+
+// Salt100GTE applies the GTE predicate on the "salt100" field.
+func Salt100GTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldGTE(FieldSalt100, v))
+}
+
+// Salt100LT applies the LT predicate on the "salt100" field.
+func Salt100LT(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLT(FieldSalt100, v))
+}
+
+// Salt100LTE applies the LTE predicate on the "salt100" field.
+func Salt100LTE(v float64) predicate.Journal {
+	return predicate.Journal(sql.FieldLTE(FieldSalt100, v))
+}
+
+// Salt100IsNil applies the IsNil predicate on the "salt100" field.
+func Salt100IsNil() predicate.Journal {
+	return predicate.Journal(sql.FieldIsNull(FieldSalt100))
+}
+
+// Salt100NotNil applies the NotNil predicate on the "salt100" field.
+func Salt100NotNil() predicate.Journal {
+	return predicate.Journal(sql.FieldNotNull(FieldSalt100))
+}
+
 // HasFood applies the HasEdge predicate on the "food" edge.
 func HasFood() predicate.Journal {
 	return predicate.Journal(func(s *sql.Selector) {
@@ -47,6 +47,160 @@ func (jc *JournalCreate) SetFoodweight(f float64) *JournalCreate {
 	return jc
 }
 
+// SetDaytime sets the "daytime" field.
+func (jc *JournalCreate) SetDaytime(i int64) *JournalCreate {
+	jc.mutation.SetDaytime(i)
+	return jc
+}
+
+// SetNillableDaytime sets the "daytime" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableDaytime(i *int64) *JournalCreate {
+	if i != nil {
+		jc.SetDaytime(*i)
+	}
+	return jc
+}
+
+// SetPortion sets the "portion" field.
+func (jc *JournalCreate) SetPortion(s string) *JournalCreate {
+	jc.mutation.SetPortion(s)
+	return jc
+}
+
+// SetNillablePortion sets the "portion" field if the given value is not nil.
+func (jc *JournalCreate) SetNillablePortion(s *string) *JournalCreate {
+	if s != nil {
+		jc.SetPortion(*s)
+	}
+	return jc
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (jc *JournalCreate) SetPortioncount(f float64) *JournalCreate {
+	jc.mutation.SetPortioncount(f)
+	return jc
+}
+
+// SetNillablePortioncount sets the "portioncount" field if the given value is not nil.
+func (jc *JournalCreate) SetNillablePortioncount(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetPortioncount(*f)
+	}
+	return jc
+}
+
+// SetCal100 sets the "cal100" field.
+func (jc *JournalCreate) SetCal100(f float64) *JournalCreate {
+	jc.mutation.SetCal100(f)
+	return jc
+}
+
+// SetNillableCal100 sets the "cal100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableCal100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetCal100(*f)
+	}
+	return jc
+}
+
+// SetProt100 sets the "prot100" field.
+func (jc *JournalCreate) SetProt100(f float64) *JournalCreate {
+	jc.mutation.SetProt100(f)
+	return jc
+}
+
+// SetNillableProt100 sets the "prot100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableProt100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetProt100(*f)
+	}
+	return jc
+}
+
+// SetFat100 sets the "fat100" field.
+func (jc *JournalCreate) SetFat100(f float64) *JournalCreate {
+	jc.mutation.SetFat100(f)
+	return jc
+}
+
+// SetNillableFat100 sets the "fat100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableFat100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetFat100(*f)
+	}
+	return jc
+}
+
+// SetCarb100 sets the "carb100" field.
+func (jc *JournalCreate) SetCarb100(f float64) *JournalCreate {
+	jc.mutation.SetCarb100(f)
+	return jc
+}
+
+// SetNillableCarb100 sets the "carb100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableCarb100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetCarb100(*f)
+	}
+	return jc
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (jc *JournalCreate) SetFiber100(f float64) *JournalCreate {
+	jc.mutation.SetFiber100(f)
+	return jc
+}
+
+// SetNillableFiber100 sets the "fiber100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableFiber100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetFiber100(*f)
+	}
+	return jc
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (jc *JournalCreate) SetSugar100(f float64) *JournalCreate {
+	jc.mutation.SetSugar100(f)
+	return jc
+}
+
+// SetNillableSugar100 sets the "sugar100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableSugar100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetSugar100(*f)
+	}
+	return jc
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (jc *JournalCreate) SetSatfat100(f float64) *JournalCreate {
+	jc.mutation.SetSatfat100(f)
+	return jc
+}
+
+// SetNillableSatfat100 sets the "satfat100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableSatfat100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetSatfat100(*f)
+	}
+	return jc
+}
+
+// SetSalt100 sets the "salt100" field.
+func (jc *JournalCreate) SetSalt100(f float64) *JournalCreate {
+	jc.mutation.SetSalt100(f)
+	return jc
+}
+
+// SetNillableSalt100 sets the "salt100" field if the given value is not nil.
+func (jc *JournalCreate) SetNillableSalt100(f *float64) *JournalCreate {
+	if f != nil {
+		jc.SetSalt100(*f)
+	}
+	return jc
+}
+
 // SetFoodID sets the "food" edge to the Food entity by ID.
 func (jc *JournalCreate) SetFoodID(id int) *JournalCreate {
 	jc.mutation.SetFoodID(id)
@@ -65,6 +219,7 @@ func (jc *JournalCreate) Mutation() *JournalMutation {
 
 // Save creates the Journal in the database.
 func (jc *JournalCreate) Save(ctx context.Context) (*Journal, error) {
+	jc.defaults()
 	return withHooks(ctx, jc.sqlSave, jc.mutation, jc.hooks)
 }
 
@@ -90,6 +245,18 @@ func (jc *JournalCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (jc *JournalCreate) defaults() {
+	if _, ok := jc.mutation.Portion(); !ok {
+		v := journal.DefaultPortion
+		jc.mutation.SetPortion(v)
+	}
+	if _, ok := jc.mutation.Portioncount(); !ok {
+		v := journal.DefaultPortioncount
+		jc.mutation.SetPortioncount(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (jc *JournalCreate) check() error {
 	if _, ok := jc.mutation.Userid(); !ok {
@@ -104,6 +271,12 @@ func (jc *JournalCreate) check() error {
 	if _, ok := jc.mutation.Foodweight(); !ok {
 		return &ValidationError{Name: "foodweight", err: errors.New(`ent: missing required field "Journal.foodweight"`)}
 	}
+	if _, ok := jc.mutation.Portion(); !ok {
+		return &ValidationError{Name: "portion", err: errors.New(`ent: missing required field "Journal.portion"`)}
+	}
+	if _, ok := jc.mutation.Portioncount(); !ok {
+		return &ValidationError{Name: "portioncount", err: errors.New(`ent: missing required field "Journal.portioncount"`)}
+	}
 	if _, ok := jc.mutation.FoodID(); !ok {
 		return &ValidationError{Name: "food", err: errors.New(`ent: missing required edge "Journal.food"`)}
 	}
@@ -150,6 +323,50 @@ func (jc *JournalCreate) createSpec() (*Journal, *sqlgraph.CreateSpec) {
 		_spec.SetField(journal.FieldFoodweight, field.TypeFloat64, value)
 		_node.Foodweight = value
 	}
+	if value, ok := jc.mutation.Daytime(); ok {
+		_spec.SetField(journal.FieldDaytime, field.TypeInt64, value)
+		_node.Daytime = &value
+	}
+	if value, ok := jc.mutation.Portion(); ok {
+		_spec.SetField(journal.FieldPortion, field.TypeString, value)
+		_node.Portion = value
+	}
+	if value, ok := jc.mutation.Portioncount(); ok {
+		_spec.SetField(journal.FieldPortioncount, field.TypeFloat64, value)
+		_node.Portioncount = value
+	}
+	if value, ok := jc.mutation.Cal100(); ok {
+		_spec.SetField(journal.FieldCal100, field.TypeFloat64, value)
+		_node.Cal100 = &value
+	}
+	if value, ok := jc.mutation.Prot100(); ok {
+		_spec.SetField(journal.FieldProt100, field.TypeFloat64, value)
+		_node.Prot100 = &value
+	}
+	if value, ok := jc.mutation.Fat100(); ok {
+		_spec.SetField(journal.FieldFat100, field.TypeFloat64, value)
+		_node.Fat100 = &value
+	}
+	if value, ok := jc.mutation.Carb100(); ok {
+		_spec.SetField(journal.FieldCarb100, field.TypeFloat64, value)
+		_node.Carb100 = &value
+	}
+	if value, ok := jc.mutation.Fiber100(); ok {
+		_spec.SetField(journal.FieldFiber100, field.TypeFloat64, value)
+		_node.Fiber100 = &value
+	}
+	if value, ok := jc.mutation.Sugar100(); ok {
+		_spec.SetField(journal.FieldSugar100, field.TypeFloat64, value)
+		_node.Sugar100 = &value
+	}
+	if value, ok := jc.mutation.Satfat100(); ok {
+		_spec.SetField(journal.FieldSatfat100, field.TypeFloat64, value)
+		_node.Satfat100 = &value
+	}
+	if value, ok := jc.mutation.Salt100(); ok {
+		_spec.SetField(journal.FieldSalt100, field.TypeFloat64, value)
+		_node.Salt100 = &value
+	}
 	if nodes := jc.mutation.FoodIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -285,6 +502,252 @@ func (u *JournalUpsert) AddFoodweight(v float64) *JournalUpsert {
 	return u
 }
 
+// SetDaytime sets the "daytime" field.
+func (u *JournalUpsert) SetDaytime(v int64) *JournalUpsert {
+	u.Set(journal.FieldDaytime, v)
+	return u
+}
+
+// UpdateDaytime sets the "daytime" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateDaytime() *JournalUpsert {
+	u.SetExcluded(journal.FieldDaytime)
+	return u
+}
+
+// AddDaytime adds v to the "daytime" field.
+func (u *JournalUpsert) AddDaytime(v int64) *JournalUpsert {
+	u.Add(journal.FieldDaytime, v)
+	return u
+}
+
+// ClearDaytime clears the value of the "daytime" field.
+func (u *JournalUpsert) ClearDaytime() *JournalUpsert {
+	u.SetNull(journal.FieldDaytime)
+	return u
+}
+
+// SetPortion sets the "portion" field.
+func (u *JournalUpsert) SetPortion(v string) *JournalUpsert {
+	u.Set(journal.FieldPortion, v)
+	return u
+}
+
+// UpdatePortion sets the "portion" field to the value that was provided on create.
+func (u *JournalUpsert) UpdatePortion() *JournalUpsert {
+	u.SetExcluded(journal.FieldPortion)
+	return u
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (u *JournalUpsert) SetPortioncount(v float64) *JournalUpsert {
+	u.Set(journal.FieldPortioncount, v)
+	return u
+}
+
+// UpdatePortioncount sets the "portioncount" field to the value that was provided on create.
+func (u *JournalUpsert) UpdatePortioncount() *JournalUpsert {
+	u.SetExcluded(journal.FieldPortioncount)
+	return u
+}
+
+// AddPortioncount adds v to the "portioncount" field.
+func (u *JournalUpsert) AddPortioncount(v float64) *JournalUpsert {
+	u.Add(journal.FieldPortioncount, v)
+	return u
+}
+
+// SetCal100 sets the "cal100" field.
+func (u *JournalUpsert) SetCal100(v float64) *JournalUpsert {
+	u.Set(journal.FieldCal100, v)
+	return u
+}
+
+// UpdateCal100 sets the "cal100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateCal100() *JournalUpsert {
+	u.SetExcluded(journal.FieldCal100)
+	return u
+}
+
+// AddCal100 adds v to the "cal100" field.
+func (u *JournalUpsert) AddCal100(v float64) *JournalUpsert {
+	u.Add(journal.FieldCal100, v)
+	return u
+}
+
+// ClearCal100 clears the value of the "cal100" field.
+func (u *JournalUpsert) ClearCal100() *JournalUpsert {
+	u.SetNull(journal.FieldCal100)
+	return u
+}
+
+// SetProt100 sets the "prot100" field.
+func (u *JournalUpsert) SetProt100(v float64) *JournalUpsert {
+	u.Set(journal.FieldProt100, v)
+	return u
+}
+
+// UpdateProt100 sets the "prot100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateProt100() *JournalUpsert {
+	u.SetExcluded(journal.FieldProt100)
+	return u
+}
+
+// AddProt100 adds v to the "prot100" field.
+func (u *JournalUpsert) AddProt100(v float64) *JournalUpsert {
+	u.Add(journal.FieldProt100, v)
+	return u
+}
+
+// ClearProt100 clears the value of the "prot100" field.
+func (u *JournalUpsert) ClearProt100() *JournalUpsert {
+	u.SetNull(journal.FieldProt100)
+	return u
+}
+
+// SetFat100 sets the "fat100" field.
+func (u *JournalUpsert) SetFat100(v float64) *JournalUpsert {
+	u.Set(journal.FieldFat100, v)
+	return u
+}
+
+// UpdateFat100 sets the "fat100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateFat100() *JournalUpsert {
+	u.SetExcluded(journal.FieldFat100)
+	return u
+}
+
+// AddFat100 adds v to the "fat100" field.
+func (u *JournalUpsert) AddFat100(v float64) *JournalUpsert {
+	u.Add(journal.FieldFat100, v)
+	return u
+}
+
+// ClearFat100 clears the value of the "fat100" field.
+func (u *JournalUpsert) ClearFat100() *JournalUpsert {
+	u.SetNull(journal.FieldFat100)
+	return u
+}
+
+// SetCarb100 sets the "carb100" field.
+func (u *JournalUpsert) SetCarb100(v float64) *JournalUpsert {
+	u.Set(journal.FieldCarb100, v)
+	return u
+}
+
+// UpdateCarb100 sets the "carb100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateCarb100() *JournalUpsert {
+	u.SetExcluded(journal.FieldCarb100)
+	return u
+}
+
+// AddCarb100 adds v to the "carb100" field.
+func (u *JournalUpsert) AddCarb100(v float64) *JournalUpsert {
+	u.Add(journal.FieldCarb100, v)
+	return u
+}
+
+// ClearCarb100 clears the value of the "carb100" field.
+func (u *JournalUpsert) ClearCarb100() *JournalUpsert {
+	u.SetNull(journal.FieldCarb100)
+	return u
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (u *JournalUpsert) SetFiber100(v float64) *JournalUpsert {
+	u.Set(journal.FieldFiber100, v)
+	return u
+}
+
+// UpdateFiber100 sets the "fiber100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateFiber100() *JournalUpsert {
+	u.SetExcluded(journal.FieldFiber100)
+	return u
+}
+
+// AddFiber100 adds v to the "fiber100" field.
+func (u *JournalUpsert) AddFiber100(v float64) *JournalUpsert {
+	u.Add(journal.FieldFiber100, v)
+	return u
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (u *JournalUpsert) ClearFiber100() *JournalUpsert {
+	u.SetNull(journal.FieldFiber100)
+	return u
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (u *JournalUpsert) SetSugar100(v float64) *JournalUpsert {
+	u.Set(journal.FieldSugar100, v)
+	return u
+}
+
+// UpdateSugar100 sets the "sugar100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateSugar100() *JournalUpsert {
+	u.SetExcluded(journal.FieldSugar100)
+	return u
+}
+
+// AddSugar100 adds v to the "sugar100" field.
+func (u *JournalUpsert) AddSugar100(v float64) *JournalUpsert {
+	u.Add(journal.FieldSugar100, v)
+	return u
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (u *JournalUpsert) ClearSugar100() *JournalUpsert {
+	u.SetNull(journal.FieldSugar100)
+	return u
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (u *JournalUpsert) SetSatfat100(v float64) *JournalUpsert {
+	u.Set(journal.FieldSatfat100, v)
+	return u
+}
+
+// UpdateSatfat100 sets the "satfat100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateSatfat100() *JournalUpsert {
+	u.SetExcluded(journal.FieldSatfat100)
+	return u
+}
+
+// AddSatfat100 adds v to the "satfat100" field.
+func (u *JournalUpsert) AddSatfat100(v float64) *JournalUpsert {
+	u.Add(journal.FieldSatfat100, v)
+	return u
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (u *JournalUpsert) ClearSatfat100() *JournalUpsert {
+	u.SetNull(journal.FieldSatfat100)
+	return u
+}
+
+// SetSalt100 sets the "salt100" field.
+func (u *JournalUpsert) SetSalt100(v float64) *JournalUpsert {
+	u.Set(journal.FieldSalt100, v)
+	return u
+}
+
+// UpdateSalt100 sets the "salt100" field to the value that was provided on create.
+func (u *JournalUpsert) UpdateSalt100() *JournalUpsert {
+	u.SetExcluded(journal.FieldSalt100)
+	return u
+}
+
+// AddSalt100 adds v to the "salt100" field.
+func (u *JournalUpsert) AddSalt100(v float64) *JournalUpsert {
+	u.Add(journal.FieldSalt100, v)
+	return u
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (u *JournalUpsert) ClearSalt100() *JournalUpsert {
+	u.SetNull(journal.FieldSalt100)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -402,45 +865,332 @@ func (u *JournalUpsertOne) UpdateFoodweight() *JournalUpsertOne {
 	})
 }
 
-// Exec executes the query.
-func (u *JournalUpsertOne) Exec(ctx context.Context) error {
-	if len(u.create.conflict) == 0 {
-		return errors.New("ent: missing options for JournalCreate.OnConflict")
-	}
-	return u.create.Exec(ctx)
+// SetDaytime sets the "daytime" field.
+func (u *JournalUpsertOne) SetDaytime(v int64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetDaytime(v)
+	})
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (u *JournalUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
-		panic(err)
-	}
+// AddDaytime adds v to the "daytime" field.
+func (u *JournalUpsertOne) AddDaytime(v int64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddDaytime(v)
+	})
 }
 
-// Exec executes the UPSERT query and returns the inserted/updated ID.
-func (u *JournalUpsertOne) ID(ctx context.Context) (id int, err error) {
-	node, err := u.create.Save(ctx)
-	if err != nil {
-		return id, err
-	}
-	return node.ID, nil
+// UpdateDaytime sets the "daytime" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateDaytime() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateDaytime()
+	})
 }
 
-// IDX is like ID, but panics if an error occurs.
-func (u *JournalUpsertOne) IDX(ctx context.Context) int {
-	id, err := u.ID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
+// ClearDaytime clears the value of the "daytime" field.
+func (u *JournalUpsertOne) ClearDaytime() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearDaytime()
+	})
 }
 
-// JournalCreateBulk is the builder for creating many Journal entities in bulk.
-type JournalCreateBulk struct {
-	config
-	err      error
-	builders []*JournalCreate
-	conflict []sql.ConflictOption
+// SetPortion sets the "portion" field.
+func (u *JournalUpsertOne) SetPortion(v string) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetPortion(v)
+	})
+}
+
+// UpdatePortion sets the "portion" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdatePortion() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdatePortion()
+	})
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (u *JournalUpsertOne) SetPortioncount(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetPortioncount(v)
+	})
+}
+
+// AddPortioncount adds v to the "portioncount" field.
+func (u *JournalUpsertOne) AddPortioncount(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddPortioncount(v)
+	})
+}
+
+// UpdatePortioncount sets the "portioncount" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdatePortioncount() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdatePortioncount()
+	})
+}
+
+// SetCal100 sets the "cal100" field.
+func (u *JournalUpsertOne) SetCal100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetCal100(v)
+	})
+}
+
+// AddCal100 adds v to the "cal100" field.
+func (u *JournalUpsertOne) AddCal100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddCal100(v)
+	})
+}
+
+// UpdateCal100 sets the "cal100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateCal100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateCal100()
+	})
+}
+
+// ClearCal100 clears the value of the "cal100" field.
+func (u *JournalUpsertOne) ClearCal100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearCal100()
+	})
+}
+
+// SetProt100 sets the "prot100" field.
+func (u *JournalUpsertOne) SetProt100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetProt100(v)
+	})
+}
+
+// AddProt100 adds v to the "prot100" field.
+func (u *JournalUpsertOne) AddProt100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddProt100(v)
+	})
+}
+
+// UpdateProt100 sets the "prot100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateProt100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateProt100()
+	})
+}
+
+// ClearProt100 clears the value of the "prot100" field.
+func (u *JournalUpsertOne) ClearProt100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearProt100()
+	})
+}
+
+// SetFat100 sets the "fat100" field.
+func (u *JournalUpsertOne) SetFat100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetFat100(v)
+	})
+}
+
+// AddFat100 adds v to the "fat100" field.
+func (u *JournalUpsertOne) AddFat100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddFat100(v)
+	})
+}
+
+// UpdateFat100 sets the "fat100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateFat100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateFat100()
+	})
+}
+
+// ClearFat100 clears the value of the "fat100" field.
+func (u *JournalUpsertOne) ClearFat100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearFat100()
+	})
+}
+
+// SetCarb100 sets the "carb100" field.
+func (u *JournalUpsertOne) SetCarb100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetCarb100(v)
+	})
+}
+
+// AddCarb100 adds v to the "carb100" field.
+func (u *JournalUpsertOne) AddCarb100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddCarb100(v)
+	})
+}
+
+// UpdateCarb100 sets the "carb100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateCarb100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateCarb100()
+	})
+}
+
+// ClearCarb100 clears the value of the "carb100" field.
+func (u *JournalUpsertOne) ClearCarb100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearCarb100()
+	})
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (u *JournalUpsertOne) SetFiber100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetFiber100(v)
+	})
+}
+
+// AddFiber100 adds v to the "fiber100" field.
+func (u *JournalUpsertOne) AddFiber100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddFiber100(v)
+	})
+}
+
+// UpdateFiber100 sets the "fiber100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateFiber100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateFiber100()
+	})
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (u *JournalUpsertOne) ClearFiber100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearFiber100()
+	})
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (u *JournalUpsertOne) SetSugar100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetSugar100(v)
+	})
+}
+
+// AddSugar100 adds v to the "sugar100" field.
+func (u *JournalUpsertOne) AddSugar100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddSugar100(v)
+	})
+}
+
+// UpdateSugar100 sets the "sugar100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateSugar100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateSugar100()
+	})
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (u *JournalUpsertOne) ClearSugar100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearSugar100()
+	})
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (u *JournalUpsertOne) SetSatfat100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetSatfat100(v)
+	})
+}
+
+// AddSatfat100 adds v to the "satfat100" field.
+func (u *JournalUpsertOne) AddSatfat100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddSatfat100(v)
+	})
+}
+
+// UpdateSatfat100 sets the "satfat100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateSatfat100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateSatfat100()
+	})
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (u *JournalUpsertOne) ClearSatfat100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearSatfat100()
+	})
+}
+
+// SetSalt100 sets the "salt100" field.
+func (u *JournalUpsertOne) SetSalt100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetSalt100(v)
+	})
+}
+
+// AddSalt100 adds v to the "salt100" field.
+func (u *JournalUpsertOne) AddSalt100(v float64) *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddSalt100(v)
+	})
+}
+
+// UpdateSalt100 sets the "salt100" field to the value that was provided on create.
+func (u *JournalUpsertOne) UpdateSalt100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateSalt100()
+	})
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (u *JournalUpsertOne) ClearSalt100() *JournalUpsertOne {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearSalt100()
+	})
+}
+
+// Exec executes the query.
+func (u *JournalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JournalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JournalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JournalUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JournalUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JournalCreateBulk is the builder for creating many Journal entities in bulk.
+type JournalCreateBulk struct {
+	config
+	err      error
+	builders []*JournalCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Journal entities in the database.
@@ -454,6 +1204,7 @@ func (jcb *JournalCreateBulk) Save(ctx context.Context) ([]*Journal, error) {
 	for i := range jcb.builders {
 		func(i int, root context.Context) {
 			builder := jcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*JournalMutation)
 				if !ok {
@@ -682,6 +1433,293 @@ func (u *JournalUpsertBulk) UpdateFoodweight() *JournalUpsertBulk {
 	})
 }
 
+// SetDaytime sets the "daytime" field.
+func (u *JournalUpsertBulk) SetDaytime(v int64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetDaytime(v)
+	})
+}
+
+// AddDaytime adds v to the "daytime" field.
+func (u *JournalUpsertBulk) AddDaytime(v int64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddDaytime(v)
+	})
+}
+
+// UpdateDaytime sets the "daytime" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateDaytime() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateDaytime()
+	})
+}
+
+// ClearDaytime clears the value of the "daytime" field.
+func (u *JournalUpsertBulk) ClearDaytime() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearDaytime()
+	})
+}
+
+// SetPortion sets the "portion" field.
+func (u *JournalUpsertBulk) SetPortion(v string) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetPortion(v)
+	})
+}
+
+// UpdatePortion sets the "portion" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdatePortion() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdatePortion()
+	})
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (u *JournalUpsertBulk) SetPortioncount(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetPortioncount(v)
+	})
+}
+
+// AddPortioncount adds v to the "portioncount" field.
+func (u *JournalUpsertBulk) AddPortioncount(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddPortioncount(v)
+	})
+}
+
+// UpdatePortioncount sets the "portioncount" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdatePortioncount() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdatePortioncount()
+	})
+}
+
+// SetCal100 sets the "cal100" field.
+func (u *JournalUpsertBulk) SetCal100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetCal100(v)
+	})
+}
+
+// AddCal100 adds v to the "cal100" field.
+func (u *JournalUpsertBulk) AddCal100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddCal100(v)
+	})
+}
+
+// UpdateCal100 sets the "cal100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateCal100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateCal100()
+	})
+}
+
+// ClearCal100 clears the value of the "cal100" field.
+func (u *JournalUpsertBulk) ClearCal100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearCal100()
+	})
+}
+
+// SetProt100 sets the "prot100" field.
+func (u *JournalUpsertBulk) SetProt100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetProt100(v)
+	})
+}
+
+// AddProt100 adds v to the "prot100" field.
+func (u *JournalUpsertBulk) AddProt100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddProt100(v)
+	})
+}
+
+// UpdateProt100 sets the "prot100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateProt100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateProt100()
+	})
+}
+
+// ClearProt100 clears the value of the "prot100" field.
+func (u *JournalUpsertBulk) ClearProt100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearProt100()
+	})
+}
+
+// SetFat100 sets the "fat100" field.
+func (u *JournalUpsertBulk) SetFat100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetFat100(v)
+	})
+}
+
+// AddFat100 adds v to the "fat100" field.
+func (u *JournalUpsertBulk) AddFat100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddFat100(v)
+	})
+}
+
+// UpdateFat100 sets the "fat100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateFat100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateFat100()
+	})
+}
+
+// ClearFat100 clears the value of the "fat100" field.
+func (u *JournalUpsertBulk) ClearFat100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearFat100()
+	})
+}
+
+// SetCarb100 sets the "carb100" field.
+func (u *JournalUpsertBulk) SetCarb100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetCarb100(v)
+	})
+}
+
+// AddCarb100 adds v to the "carb100" field.
+func (u *JournalUpsertBulk) AddCarb100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddCarb100(v)
+	})
+}
+
+// UpdateCarb100 sets the "carb100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateCarb100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateCarb100()
+	})
+}
+
+// ClearCarb100 clears the value of the "carb100" field.
+func (u *JournalUpsertBulk) ClearCarb100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearCarb100()
+	})
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (u *JournalUpsertBulk) SetFiber100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetFiber100(v)
+	})
+}
+
+// AddFiber100 adds v to the "fiber100" field.
+func (u *JournalUpsertBulk) AddFiber100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddFiber100(v)
+	})
+}
+
+// UpdateFiber100 sets the "fiber100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateFiber100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateFiber100()
+	})
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (u *JournalUpsertBulk) ClearFiber100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearFiber100()
+	})
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (u *JournalUpsertBulk) SetSugar100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetSugar100(v)
+	})
+}
+
+// AddSugar100 adds v to the "sugar100" field.
+func (u *JournalUpsertBulk) AddSugar100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddSugar100(v)
+	})
+}
+
+// UpdateSugar100 sets the "sugar100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateSugar100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateSugar100()
+	})
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (u *JournalUpsertBulk) ClearSugar100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearSugar100()
+	})
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (u *JournalUpsertBulk) SetSatfat100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetSatfat100(v)
+	})
+}
+
+// AddSatfat100 adds v to the "satfat100" field.
+func (u *JournalUpsertBulk) AddSatfat100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddSatfat100(v)
+	})
+}
+
+// UpdateSatfat100 sets the "satfat100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateSatfat100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateSatfat100()
+	})
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (u *JournalUpsertBulk) ClearSatfat100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearSatfat100()
+	})
+}
+
+// SetSalt100 sets the "salt100" field.
+func (u *JournalUpsertBulk) SetSalt100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.SetSalt100(v)
+	})
+}
+
+// AddSalt100 adds v to the "salt100" field.
+func (u *JournalUpsertBulk) AddSalt100(v float64) *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.AddSalt100(v)
+	})
+}
+
+// UpdateSalt100 sets the "salt100" field to the value that was provided on create.
+func (u *JournalUpsertBulk) UpdateSalt100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.UpdateSalt100()
+	})
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (u *JournalUpsertBulk) ClearSalt100() *JournalUpsertBulk {
+	return u.Update(func(s *JournalUpsert) {
+		s.ClearSalt100()
+	})
+}
+
 // Exec executes the query.
 func (u *JournalUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -107,6 +107,284 @@ func (ju *JournalUpdate) AddFoodweight(f float64) *JournalUpdate {
 	return ju
 }
 
+// SetDaytime sets the "daytime" field.
+func (ju *JournalUpdate) SetDaytime(i int64) *JournalUpdate {
+	ju.mutation.ResetDaytime()
+	ju.mutation.SetDaytime(i)
+	return ju
+}
+
+// SetNillableDaytime sets the "daytime" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableDaytime(i *int64) *JournalUpdate {
+	if i != nil {
+		ju.SetDaytime(*i)
+	}
+	return ju
+}
+
+// AddDaytime adds i to the "daytime" field.
+func (ju *JournalUpdate) AddDaytime(i int64) *JournalUpdate {
+	ju.mutation.AddDaytime(i)
+	return ju
+}
+
+// ClearDaytime clears the value of the "daytime" field.
+func (ju *JournalUpdate) ClearDaytime() *JournalUpdate {
+	ju.mutation.ClearDaytime()
+	return ju
+}
+
+// SetPortion sets the "portion" field.
+func (ju *JournalUpdate) SetPortion(s string) *JournalUpdate {
+	ju.mutation.SetPortion(s)
+	return ju
+}
+
+// SetNillablePortion sets the "portion" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillablePortion(s *string) *JournalUpdate {
+	if s != nil {
+		ju.SetPortion(*s)
+	}
+	return ju
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (ju *JournalUpdate) SetPortioncount(f float64) *JournalUpdate {
+	ju.mutation.ResetPortioncount()
+	ju.mutation.SetPortioncount(f)
+	return ju
+}
+
+// SetNillablePortioncount sets the "portioncount" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillablePortioncount(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetPortioncount(*f)
+	}
+	return ju
+}
+
+// AddPortioncount adds f to the "portioncount" field.
+func (ju *JournalUpdate) AddPortioncount(f float64) *JournalUpdate {
+	ju.mutation.AddPortioncount(f)
+	return ju
+}
+
+// SetCal100 sets the "cal100" field.
+func (ju *JournalUpdate) SetCal100(f float64) *JournalUpdate {
+	ju.mutation.ResetCal100()
+	ju.mutation.SetCal100(f)
+	return ju
+}
+
+// SetNillableCal100 sets the "cal100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableCal100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetCal100(*f)
+	}
+	return ju
+}
+
+// AddCal100 adds f to the "cal100" field.
+func (ju *JournalUpdate) AddCal100(f float64) *JournalUpdate {
+	ju.mutation.AddCal100(f)
+	return ju
+}
+
+// ClearCal100 clears the value of the "cal100" field.
+func (ju *JournalUpdate) ClearCal100() *JournalUpdate {
+	ju.mutation.ClearCal100()
+	return ju
+}
+
+// SetProt100 sets the "prot100" field.
+func (ju *JournalUpdate) SetProt100(f float64) *JournalUpdate {
+	ju.mutation.ResetProt100()
+	ju.mutation.SetProt100(f)
+	return ju
+}
+
+// SetNillableProt100 sets the "prot100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableProt100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetProt100(*f)
+	}
+	return ju
+}
+
+// AddProt100 adds f to the "prot100" field.
+func (ju *JournalUpdate) AddProt100(f float64) *JournalUpdate {
+	ju.mutation.AddProt100(f)
+	return ju
+}
+
+// ClearProt100 clears the value of the "prot100" field.
+func (ju *JournalUpdate) ClearProt100() *JournalUpdate {
+	ju.mutation.ClearProt100()
+	return ju
+}
+
+// SetFat100 sets the "fat100" field.
+func (ju *JournalUpdate) SetFat100(f float64) *JournalUpdate {
+	ju.mutation.ResetFat100()
+	ju.mutation.SetFat100(f)
+	return ju
+}
+
+// SetNillableFat100 sets the "fat100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableFat100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetFat100(*f)
+	}
+	return ju
+}
+
+// AddFat100 adds f to the "fat100" field.
+func (ju *JournalUpdate) AddFat100(f float64) *JournalUpdate {
+	ju.mutation.AddFat100(f)
+	return ju
+}
+
+// ClearFat100 clears the value of the "fat100" field.
+func (ju *JournalUpdate) ClearFat100() *JournalUpdate {
+	ju.mutation.ClearFat100()
+	return ju
+}
+
+// SetCarb100 sets the "carb100" field.
+func (ju *JournalUpdate) SetCarb100(f float64) *JournalUpdate {
+	ju.mutation.ResetCarb100()
+	ju.mutation.SetCarb100(f)
+	return ju
+}
+
+// SetNillableCarb100 sets the "carb100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableCarb100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetCarb100(*f)
+	}
+	return ju
+}
+
+// AddCarb100 adds f to the "carb100" field.
+func (ju *JournalUpdate) AddCarb100(f float64) *JournalUpdate {
+	ju.mutation.AddCarb100(f)
+	return ju
+}
+
+// ClearCarb100 clears the value of the "carb100" field.
+func (ju *JournalUpdate) ClearCarb100() *JournalUpdate {
+	ju.mutation.ClearCarb100()
+	return ju
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (ju *JournalUpdate) SetFiber100(f float64) *JournalUpdate {
+	ju.mutation.ResetFiber100()
+	ju.mutation.SetFiber100(f)
+	return ju
+}
+
+// SetNillableFiber100 sets the "fiber100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableFiber100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetFiber100(*f)
+	}
+	return ju
+}
+
+// AddFiber100 adds f to the "fiber100" field.
+func (ju *JournalUpdate) AddFiber100(f float64) *JournalUpdate {
+	ju.mutation.AddFiber100(f)
+	return ju
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (ju *JournalUpdate) ClearFiber100() *JournalUpdate {
+	ju.mutation.ClearFiber100()
+	return ju
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (ju *JournalUpdate) SetSugar100(f float64) *JournalUpdate {
+	ju.mutation.ResetSugar100()
+	ju.mutation.SetSugar100(f)
+	return ju
+}
+
+// SetNillableSugar100 sets the "sugar100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableSugar100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetSugar100(*f)
+	}
+	return ju
+}
+
+// AddSugar100 adds f to the "sugar100" field.
+func (ju *JournalUpdate) AddSugar100(f float64) *JournalUpdate {
+	ju.mutation.AddSugar100(f)
+	return ju
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (ju *JournalUpdate) ClearSugar100() *JournalUpdate {
+	ju.mutation.ClearSugar100()
+	return ju
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (ju *JournalUpdate) SetSatfat100(f float64) *JournalUpdate {
+	ju.mutation.ResetSatfat100()
+	ju.mutation.SetSatfat100(f)
+	return ju
+}
+
+// SetNillableSatfat100 sets the "satfat100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableSatfat100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetSatfat100(*f)
+	}
+	return ju
+}
+
+// AddSatfat100 adds f to the "satfat100" field.
+func (ju *JournalUpdate) AddSatfat100(f float64) *JournalUpdate {
+	ju.mutation.AddSatfat100(f)
+	return ju
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (ju *JournalUpdate) ClearSatfat100() *JournalUpdate {
+	ju.mutation.ClearSatfat100()
+	return ju
+}
+
+// SetSalt100 sets the "salt100" field.
+func (ju *JournalUpdate) SetSalt100(f float64) *JournalUpdate {
+	ju.mutation.ResetSalt100()
+	ju.mutation.SetSalt100(f)
+	return ju
+}
+
+// SetNillableSalt100 sets the "salt100" field if the given value is not nil.
+func (ju *JournalUpdate) SetNillableSalt100(f *float64) *JournalUpdate {
+	if f != nil {
+		ju.SetSalt100(*f)
+	}
+	return ju
+}
+
+// AddSalt100 adds f to the "salt100" field.
+func (ju *JournalUpdate) AddSalt100(f float64) *JournalUpdate {
+	ju.mutation.AddSalt100(f)
+	return ju
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (ju *JournalUpdate) ClearSalt100() *JournalUpdate {
+	ju.mutation.ClearSalt100()
+	return ju
+}
+
 // SetFoodID sets the "food" edge to the Food entity by ID.
 func (ju *JournalUpdate) SetFoodID(id int) *JournalUpdate {
 	ju.mutation.SetFoodID(id)
@@ -203,6 +481,96 @@ func (ju *JournalUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ju.mutation.AddedFoodweight(); ok {
 		_spec.AddField(journal.FieldFoodweight, field.TypeFloat64, value)
 	}
+	if value, ok := ju.mutation.Daytime(); ok {
+		_spec.SetField(journal.FieldDaytime, field.TypeInt64, value)
+	}
+	if value, ok := ju.mutation.AddedDaytime(); ok {
+		_spec.AddField(journal.FieldDaytime, field.TypeInt64, value)
+	}
+	if ju.mutation.DaytimeCleared() {
+		_spec.ClearField(journal.FieldDaytime, field.TypeInt64)
+	}
+	if value, ok := ju.mutation.Portion(); ok {
+		_spec.SetField(journal.FieldPortion, field.TypeString, value)
+	}
+	if value, ok := ju.mutation.Portioncount(); ok {
+		_spec.SetField(journal.FieldPortioncount, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedPortioncount(); ok {
+		_spec.AddField(journal.FieldPortioncount, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.Cal100(); ok {
+		_spec.SetField(journal.FieldCal100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedCal100(); ok {
+		_spec.AddField(journal.FieldCal100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Cal100Cleared() {
+		_spec.ClearField(journal.FieldCal100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Prot100(); ok {
+		_spec.SetField(journal.FieldProt100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedProt100(); ok {
+		_spec.AddField(journal.FieldProt100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Prot100Cleared() {
+		_spec.ClearField(journal.FieldProt100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Fat100(); ok {
+		_spec.SetField(journal.FieldFat100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedFat100(); ok {
+		_spec.AddField(journal.FieldFat100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Fat100Cleared() {
+		_spec.ClearField(journal.FieldFat100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Carb100(); ok {
+		_spec.SetField(journal.FieldCarb100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedCarb100(); ok {
+		_spec.AddField(journal.FieldCarb100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Carb100Cleared() {
+		_spec.ClearField(journal.FieldCarb100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Fiber100(); ok {
+		_spec.SetField(journal.FieldFiber100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedFiber100(); ok {
+		_spec.AddField(journal.FieldFiber100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Fiber100Cleared() {
+		_spec.ClearField(journal.FieldFiber100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Sugar100(); ok {
+		_spec.SetField(journal.FieldSugar100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedSugar100(); ok {
+		_spec.AddField(journal.FieldSugar100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Sugar100Cleared() {
+		_spec.ClearField(journal.FieldSugar100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Satfat100(); ok {
+		_spec.SetField(journal.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedSatfat100(); ok {
+		_spec.AddField(journal.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Satfat100Cleared() {
+		_spec.ClearField(journal.FieldSatfat100, field.TypeFloat64)
+	}
+	if value, ok := ju.mutation.Salt100(); ok {
+		_spec.SetField(journal.FieldSalt100, field.TypeFloat64, value)
+	}
+	if value, ok := ju.mutation.AddedSalt100(); ok {
+		_spec.AddField(journal.FieldSalt100, field.TypeFloat64, value)
+	}
+	if ju.mutation.Salt100Cleared() {
+		_spec.ClearField(journal.FieldSalt100, field.TypeFloat64)
+	}
 	if ju.mutation.FoodCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -331,6 +699,284 @@ func (juo *JournalUpdateOne) AddFoodweight(f float64) *JournalUpdateOne {
 	return juo
 }
 
+// SetDaytime sets the "daytime" field.
+func (juo *JournalUpdateOne) SetDaytime(i int64) *JournalUpdateOne {
+	juo.mutation.ResetDaytime()
+	juo.mutation.SetDaytime(i)
+	return juo
+}
+
+// SetNillableDaytime sets the "daytime" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableDaytime(i *int64) *JournalUpdateOne {
+	if i != nil {
+		juo.SetDaytime(*i)
+	}
+	return juo
+}
+
+// AddDaytime adds i to the "daytime" field.
+func (juo *JournalUpdateOne) AddDaytime(i int64) *JournalUpdateOne {
+	juo.mutation.AddDaytime(i)
+	return juo
+}
+
+// ClearDaytime clears the value of the "daytime" field.
+func (juo *JournalUpdateOne) ClearDaytime() *JournalUpdateOne {
+	juo.mutation.ClearDaytime()
+	return juo
+}
+
+// SetPortion sets the "portion" field.
+func (juo *JournalUpdateOne) SetPortion(s string) *JournalUpdateOne {
+	juo.mutation.SetPortion(s)
+	return juo
+}
+
+// SetNillablePortion sets the "portion" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillablePortion(s *string) *JournalUpdateOne {
+	if s != nil {
+		juo.SetPortion(*s)
+	}
+	return juo
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (juo *JournalUpdateOne) SetPortioncount(f float64) *JournalUpdateOne {
+	juo.mutation.ResetPortioncount()
+	juo.mutation.SetPortioncount(f)
+	return juo
+}
+
+// SetNillablePortioncount sets the "portioncount" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillablePortioncount(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetPortioncount(*f)
+	}
+	return juo
+}
+
+// AddPortioncount adds f to the "portioncount" field.
+func (juo *JournalUpdateOne) AddPortioncount(f float64) *JournalUpdateOne {
+	juo.mutation.AddPortioncount(f)
+	return juo
+}
+
+// SetCal100 sets the "cal100" field.
+func (juo *JournalUpdateOne) SetCal100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetCal100()
+	juo.mutation.SetCal100(f)
+	return juo
+}
+
+// SetNillableCal100 sets the "cal100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableCal100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetCal100(*f)
+	}
+	return juo
+}
+
+// AddCal100 adds f to the "cal100" field.
+func (juo *JournalUpdateOne) AddCal100(f float64) *JournalUpdateOne {
+	juo.mutation.AddCal100(f)
+	return juo
+}
+
+// ClearCal100 clears the value of the "cal100" field.
+func (juo *JournalUpdateOne) ClearCal100() *JournalUpdateOne {
+	juo.mutation.ClearCal100()
+	return juo
+}
+
+// SetProt100 sets the "prot100" field.
+func (juo *JournalUpdateOne) SetProt100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetProt100()
+	juo.mutation.SetProt100(f)
+	return juo
+}
+
+// SetNillableProt100 sets the "prot100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableProt100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetProt100(*f)
+	}
+	return juo
+}
+
+// AddProt100 adds f to the "prot100" field.
+func (juo *JournalUpdateOne) AddProt100(f float64) *JournalUpdateOne {
+	juo.mutation.AddProt100(f)
+	return juo
+}
+
+// ClearProt100 clears the value of the "prot100" field.
+func (juo *JournalUpdateOne) ClearProt100() *JournalUpdateOne {
+	juo.mutation.ClearProt100()
+	return juo
+}
+
+// SetFat100 sets the "fat100" field.
+func (juo *JournalUpdateOne) SetFat100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetFat100()
+	juo.mutation.SetFat100(f)
+	return juo
+}
+
+// SetNillableFat100 sets the "fat100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableFat100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetFat100(*f)
+	}
+	return juo
+}
+
+// AddFat100 adds f to the "fat100" field.
+func (juo *JournalUpdateOne) AddFat100(f float64) *JournalUpdateOne {
+	juo.mutation.AddFat100(f)
+	return juo
+}
+
+// ClearFat100 clears the value of the "fat100" field.
+func (juo *JournalUpdateOne) ClearFat100() *JournalUpdateOne {
+	juo.mutation.ClearFat100()
+	return juo
+}
+
+// SetCarb100 sets the "carb100" field.
+func (juo *JournalUpdateOne) SetCarb100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetCarb100()
+	juo.mutation.SetCarb100(f)
+	return juo
+}
+
+// SetNillableCarb100 sets the "carb100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableCarb100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetCarb100(*f)
+	}
+	return juo
+}
+
+// AddCarb100 adds f to the "carb100" field.
+func (juo *JournalUpdateOne) AddCarb100(f float64) *JournalUpdateOne {
+	juo.mutation.AddCarb100(f)
+	return juo
+}
+
+// ClearCarb100 clears the value of the "carb100" field.
+func (juo *JournalUpdateOne) ClearCarb100() *JournalUpdateOne {
+	juo.mutation.ClearCarb100()
+	return juo
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (juo *JournalUpdateOne) SetFiber100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetFiber100()
+	juo.mutation.SetFiber100(f)
+	return juo
+}
+
+// SetNillableFiber100 sets the "fiber100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableFiber100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetFiber100(*f)
+	}
+	return juo
+}
+
+// AddFiber100 adds f to the "fiber100" field.
+func (juo *JournalUpdateOne) AddFiber100(f float64) *JournalUpdateOne {
+	juo.mutation.AddFiber100(f)
+	return juo
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (juo *JournalUpdateOne) ClearFiber100() *JournalUpdateOne {
+	juo.mutation.ClearFiber100()
+	return juo
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (juo *JournalUpdateOne) SetSugar100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetSugar100()
+	juo.mutation.SetSugar100(f)
+	return juo
+}
+
+// SetNillableSugar100 sets the "sugar100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableSugar100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetSugar100(*f)
+	}
+	return juo
+}
+
+// AddSugar100 adds f to the "sugar100" field.
+func (juo *JournalUpdateOne) AddSugar100(f float64) *JournalUpdateOne {
+	juo.mutation.AddSugar100(f)
+	return juo
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (juo *JournalUpdateOne) ClearSugar100() *JournalUpdateOne {
+	juo.mutation.ClearSugar100()
+	return juo
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (juo *JournalUpdateOne) SetSatfat100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetSatfat100()
+	juo.mutation.SetSatfat100(f)
+	return juo
+}
+
+// SetNillableSatfat100 sets the "satfat100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableSatfat100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetSatfat100(*f)
+	}
+	return juo
+}
+
+// AddSatfat100 adds f to the "satfat100" field.
+func (juo *JournalUpdateOne) AddSatfat100(f float64) *JournalUpdateOne {
+	juo.mutation.AddSatfat100(f)
+	return juo
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (juo *JournalUpdateOne) ClearSatfat100() *JournalUpdateOne {
+	juo.mutation.ClearSatfat100()
+	return juo
+}
+
+// SetSalt100 sets the "salt100" field.
+func (juo *JournalUpdateOne) SetSalt100(f float64) *JournalUpdateOne {
+	juo.mutation.ResetSalt100()
+	juo.mutation.SetSalt100(f)
+	return juo
+}
+
+// SetNillableSalt100 sets the "salt100" field if the given value is not nil.
+func (juo *JournalUpdateOne) SetNillableSalt100(f *float64) *JournalUpdateOne {
+	if f != nil {
+		juo.SetSalt100(*f)
+	}
+	return juo
+}
+
+// AddSalt100 adds f to the "salt100" field.
+func (juo *JournalUpdateOne) AddSalt100(f float64) *JournalUpdateOne {
+	juo.mutation.AddSalt100(f)
+	return juo
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (juo *JournalUpdateOne) ClearSalt100() *JournalUpdateOne {
+	juo.mutation.ClearSalt100()
+	return juo
+}
+
 // SetFoodID sets the "food" edge to the Food entity by ID.
 func (juo *JournalUpdateOne) SetFoodID(id int) *JournalUpdateOne {
 	juo.mutation.SetFoodID(id)
@@ -457,6 +1103,96 @@ func (juo *JournalUpdateOne) sqlSave(ctx context.Context) (_node *Journal, err e
 	if value, ok := juo.mutation.AddedFoodweight(); ok {
 		_spec.AddField(journal.FieldFoodweight, field.TypeFloat64, value)
 	}
+	if value, ok := juo.mutation.Daytime(); ok {
+		_spec.SetField(journal.FieldDaytime, field.TypeInt64, value)
+	}
+	if value, ok := juo.mutation.AddedDaytime(); ok {
+		_spec.AddField(journal.FieldDaytime, field.TypeInt64, value)
+	}
+	if juo.mutation.DaytimeCleared() {
+		_spec.ClearField(journal.FieldDaytime, field.TypeInt64)
+	}
+	if value, ok := juo.mutation.Portion(); ok {
+		_spec.SetField(journal.FieldPortion, field.TypeString, value)
+	}
+	if value, ok := juo.mutation.Portioncount(); ok {
+		_spec.SetField(journal.FieldPortioncount, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedPortioncount(); ok {
+		_spec.AddField(journal.FieldPortioncount, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.Cal100(); ok {
+		_spec.SetField(journal.FieldCal100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedCal100(); ok {
+		_spec.AddField(journal.FieldCal100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Cal100Cleared() {
+		_spec.ClearField(journal.FieldCal100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Prot100(); ok {
+		_spec.SetField(journal.FieldProt100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedProt100(); ok {
+		_spec.AddField(journal.FieldProt100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Prot100Cleared() {
+		_spec.ClearField(journal.FieldProt100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Fat100(); ok {
+		_spec.SetField(journal.FieldFat100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedFat100(); ok {
+		_spec.AddField(journal.FieldFat100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Fat100Cleared() {
+		_spec.ClearField(journal.FieldFat100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Carb100(); ok {
+		_spec.SetField(journal.FieldCarb100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedCarb100(); ok {
+		_spec.AddField(journal.FieldCarb100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Carb100Cleared() {
+		_spec.ClearField(journal.FieldCarb100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Fiber100(); ok {
+		_spec.SetField(journal.FieldFiber100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedFiber100(); ok {
+		_spec.AddField(journal.FieldFiber100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Fiber100Cleared() {
+		_spec.ClearField(journal.FieldFiber100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Sugar100(); ok {
+		_spec.SetField(journal.FieldSugar100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedSugar100(); ok {
+		_spec.AddField(journal.FieldSugar100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Sugar100Cleared() {
+		_spec.ClearField(journal.FieldSugar100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Satfat100(); ok {
+		_spec.SetField(journal.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedSatfat100(); ok {
+		_spec.AddField(journal.FieldSatfat100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Satfat100Cleared() {
+		_spec.ClearField(journal.FieldSatfat100, field.TypeFloat64)
+	}
+	if value, ok := juo.mutation.Salt100(); ok {
+		_spec.SetField(journal.FieldSalt100, field.TypeFloat64, value)
+	}
+	if value, ok := juo.mutation.AddedSalt100(); ok {
+		_spec.AddField(journal.FieldSalt100, field.TypeFloat64, value)
+	}
+	if juo.mutation.Salt100Cleared() {
+		_spec.ClearField(journal.FieldSalt100, field.TypeFloat64)
+	}
 	if juo.mutation.FoodCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
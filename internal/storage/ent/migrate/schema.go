@@ -51,20 +51,33 @@ var (
 	// FoodsColumns holds the columns for the "foods" table.
 	FoodsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "key", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "brand", Type: field.TypeString, Nullable: true},
 		{Name: "cal100", Type: field.TypeFloat64},
 		{Name: "prot100", Type: field.TypeFloat64},
 		{Name: "fat100", Type: field.TypeFloat64},
 		{Name: "carb100", Type: field.TypeFloat64},
+		{Name: "fiber100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "sugar100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "satfat100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "salt100", Type: field.TypeFloat64, Nullable: true},
 		{Name: "comment", Type: field.TypeString, Nullable: true},
+		{Name: "portions", Type: field.TypeJSON, Nullable: true},
+		{Name: "userid", Type: field.TypeInt64, Default: 0},
 	}
 	// FoodsTable holds the schema information for the "foods" table.
 	FoodsTable = &schema.Table{
 		Name:       "foods",
 		Columns:    FoodsColumns,
 		PrimaryKey: []*schema.Column{FoodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "food_key_userid",
+				Unique:  true,
+				Columns: []*schema.Column{FoodsColumns[1], FoodsColumns[14]},
+			},
+		},
 	}
 	// JournalsColumns holds the columns for the "journals" table.
 	JournalsColumns = []*schema.Column{
@@ -73,6 +86,17 @@ var (
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "meal", Type: field.TypeInt64},
 		{Name: "foodweight", Type: field.TypeFloat64},
+		{Name: "daytime", Type: field.TypeInt64, Nullable: true},
+		{Name: "portion", Type: field.TypeString, Default: ""},
+		{Name: "portioncount", Type: field.TypeFloat64, Default: 0},
+		{Name: "cal100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "prot100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "fat100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "carb100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "fiber100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "sugar100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "satfat100", Type: field.TypeFloat64, Nullable: true},
+		{Name: "salt100", Type: field.TypeFloat64, Nullable: true},
 		{Name: "food_journals", Type: field.TypeInt},
 	}
 	// JournalsTable holds the schema information for the "journals" table.
@@ -83,16 +107,60 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "journals_foods_journals",
-				Columns:    []*schema.Column{JournalsColumns[5]},
+				Columns:    []*schema.Column{JournalsColumns[16]},
 				RefColumns: []*schema.Column{FoodsColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "journal_userid_timestamp_meal_food_journals",
+				Name:    "journal_userid_timestamp_meal_daytime_food_journals",
+				Unique:  true,
+				Columns: []*schema.Column{JournalsColumns[1], JournalsColumns[2], JournalsColumns[3], JournalsColumns[5], JournalsColumns[16]},
+			},
+		},
+	}
+	// RecipesColumns holds the columns for the "recipes" table.
+	RecipesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "userid", Type: field.TypeInt64},
+		{Name: "key", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "ingredients", Type: field.TypeJSON},
+		{Name: "cookedweight", Type: field.TypeFloat64},
+		{Name: "tare", Type: field.TypeFloat64, Default: 0},
+	}
+	// RecipesTable holds the schema information for the "recipes" table.
+	RecipesTable = &schema.Table{
+		Name:       "recipes",
+		Columns:    RecipesColumns,
+		PrimaryKey: []*schema.Column{RecipesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recipe_userid_key",
+				Unique:  true,
+				Columns: []*schema.Column{RecipesColumns[1], RecipesColumns[2]},
+			},
+		},
+	}
+	// UserMealsColumns holds the columns for the "user_meals" table.
+	UserMealsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "userid", Type: field.TypeInt64},
+		{Name: "meal", Type: field.TypeInt64},
+		{Name: "name", Type: field.TypeString},
+		{Name: "aliases", Type: field.TypeJSON},
+	}
+	// UserMealsTable holds the schema information for the "user_meals" table.
+	UserMealsTable = &schema.Table{
+		Name:       "user_meals",
+		Columns:    UserMealsColumns,
+		PrimaryKey: []*schema.Column{UserMealsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usermeal_userid_meal",
 				Unique:  true,
-				Columns: []*schema.Column{JournalsColumns[1], JournalsColumns[2], JournalsColumns[3], JournalsColumns[5]},
+				Columns: []*schema.Column{UserMealsColumns[1], UserMealsColumns[2]},
 			},
 		},
 	}
@@ -135,6 +203,8 @@ var (
 		BundlesTable,
 		FoodsTable,
 		JournalsTable,
+		RecipesTable,
+		UserMealsTable,
 		UserSettingsTable,
 		WeightsTable,
 	}
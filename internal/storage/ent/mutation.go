@@ -16,6 +16,8 @@ import (
 	"github.com/devldavydov/myfood/internal/storage/ent/food"
 	"github.com/devldavydov/myfood/internal/storage/ent/journal"
 	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
 	"github.com/devldavydov/myfood/internal/storage/ent/usersettings"
 	"github.com/devldavydov/myfood/internal/storage/ent/weight"
 )
@@ -33,6 +35,8 @@ const (
 	TypeBundle       = "Bundle"
 	TypeFood         = "Food"
 	TypeJournal      = "Journal"
+	TypeRecipe       = "Recipe"
+	TypeUserMeal     = "UserMeal"
 	TypeUserSettings = "UserSettings"
 	TypeWeight       = "Weight"
 )
@@ -1027,7 +1031,18 @@ type FoodMutation struct {
 	addfat100       *float64
 	carb100         *float64
 	addcarb100      *float64
+	fiber100        *float64
+	addfiber100     *float64
+	sugar100        *float64
+	addsugar100     *float64
+	satfat100       *float64
+	addsatfat100    *float64
+	salt100         *float64
+	addsalt100      *float64
 	comment         *string
+	portions        *map[string]float64
+	userid          *int64
+	adduserid       *int64
 	clearedFields   map[string]struct{}
 	journals        map[int]struct{}
 	removedjournals map[int]struct{}
@@ -1480,6 +1495,286 @@ func (m *FoodMutation) ResetCarb100() {
 	m.addcarb100 = nil
 }
 
+// SetFiber100 sets the "fiber100" field.
+func (m *FoodMutation) SetFiber100(f float64) {
+	m.fiber100 = &f
+	m.addfiber100 = nil
+}
+
+// Fiber100 returns the value of the "fiber100" field in the mutation.
+func (m *FoodMutation) Fiber100() (r float64, exists bool) {
+	v := m.fiber100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFiber100 returns the old "fiber100" field's value of the Food entity.
+// If the Food object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FoodMutation) OldFiber100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFiber100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFiber100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFiber100: %w", err)
+	}
+	return oldValue.Fiber100, nil
+}
+
+// AddFiber100 adds f to the "fiber100" field.
+func (m *FoodMutation) AddFiber100(f float64) {
+	if m.addfiber100 != nil {
+		*m.addfiber100 += f
+	} else {
+		m.addfiber100 = &f
+	}
+}
+
+// AddedFiber100 returns the value that was added to the "fiber100" field in this mutation.
+func (m *FoodMutation) AddedFiber100() (r float64, exists bool) {
+	v := m.addfiber100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (m *FoodMutation) ClearFiber100() {
+	m.fiber100 = nil
+	m.addfiber100 = nil
+	m.clearedFields[food.FieldFiber100] = struct{}{}
+}
+
+// Fiber100Cleared returns if the "fiber100" field was cleared in this mutation.
+func (m *FoodMutation) Fiber100Cleared() bool {
+	_, ok := m.clearedFields[food.FieldFiber100]
+	return ok
+}
+
+// ResetFiber100 resets all changes to the "fiber100" field.
+func (m *FoodMutation) ResetFiber100() {
+	m.fiber100 = nil
+	m.addfiber100 = nil
+	delete(m.clearedFields, food.FieldFiber100)
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (m *FoodMutation) SetSugar100(f float64) {
+	m.sugar100 = &f
+	m.addsugar100 = nil
+}
+
+// Sugar100 returns the value of the "sugar100" field in the mutation.
+func (m *FoodMutation) Sugar100() (r float64, exists bool) {
+	v := m.sugar100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSugar100 returns the old "sugar100" field's value of the Food entity.
+// If the Food object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FoodMutation) OldSugar100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSugar100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSugar100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSugar100: %w", err)
+	}
+	return oldValue.Sugar100, nil
+}
+
+// AddSugar100 adds f to the "sugar100" field.
+func (m *FoodMutation) AddSugar100(f float64) {
+	if m.addsugar100 != nil {
+		*m.addsugar100 += f
+	} else {
+		m.addsugar100 = &f
+	}
+}
+
+// AddedSugar100 returns the value that was added to the "sugar100" field in this mutation.
+func (m *FoodMutation) AddedSugar100() (r float64, exists bool) {
+	v := m.addsugar100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (m *FoodMutation) ClearSugar100() {
+	m.sugar100 = nil
+	m.addsugar100 = nil
+	m.clearedFields[food.FieldSugar100] = struct{}{}
+}
+
+// Sugar100Cleared returns if the "sugar100" field was cleared in this mutation.
+func (m *FoodMutation) Sugar100Cleared() bool {
+	_, ok := m.clearedFields[food.FieldSugar100]
+	return ok
+}
+
+// ResetSugar100 resets all changes to the "sugar100" field.
+func (m *FoodMutation) ResetSugar100() {
+	m.sugar100 = nil
+	m.addsugar100 = nil
+	delete(m.clearedFields, food.FieldSugar100)
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (m *FoodMutation) SetSatfat100(f float64) {
+	m.satfat100 = &f
+	m.addsatfat100 = nil
+}
+
+// Satfat100 returns the value of the "satfat100" field in the mutation.
+func (m *FoodMutation) Satfat100() (r float64, exists bool) {
+	v := m.satfat100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSatfat100 returns the old "satfat100" field's value of the Food entity.
+// If the Food object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FoodMutation) OldSatfat100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSatfat100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSatfat100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSatfat100: %w", err)
+	}
+	return oldValue.Satfat100, nil
+}
+
+// AddSatfat100 adds f to the "satfat100" field.
+func (m *FoodMutation) AddSatfat100(f float64) {
+	if m.addsatfat100 != nil {
+		*m.addsatfat100 += f
+	} else {
+		m.addsatfat100 = &f
+	}
+}
+
+// AddedSatfat100 returns the value that was added to the "satfat100" field in this mutation.
+func (m *FoodMutation) AddedSatfat100() (r float64, exists bool) {
+	v := m.addsatfat100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (m *FoodMutation) ClearSatfat100() {
+	m.satfat100 = nil
+	m.addsatfat100 = nil
+	m.clearedFields[food.FieldSatfat100] = struct{}{}
+}
+
+// Satfat100Cleared returns if the "satfat100" field was cleared in this mutation.
+func (m *FoodMutation) Satfat100Cleared() bool {
+	_, ok := m.clearedFields[food.FieldSatfat100]
+	return ok
+}
+
+// ResetSatfat100 resets all changes to the "satfat100" field.
+func (m *FoodMutation) ResetSatfat100() {
+	m.satfat100 = nil
+	m.addsatfat100 = nil
+	delete(m.clearedFields, food.FieldSatfat100)
+}
+
+// SetSalt100 sets the "salt100" field.
+func (m *FoodMutation) SetSalt100(f float64) {
+	m.salt100 = &f
+	m.addsalt100 = nil
+}
+
+// Salt100 returns the value of the "salt100" field in the mutation.
+func (m *FoodMutation) Salt100() (r float64, exists bool) {
+	v := m.salt100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalt100 returns the old "salt100" field's value of the Food entity.
+// If the Food object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FoodMutation) OldSalt100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalt100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalt100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalt100: %w", err)
+	}
+	return oldValue.Salt100, nil
+}
+
+// AddSalt100 adds f to the "salt100" field.
+func (m *FoodMutation) AddSalt100(f float64) {
+	if m.addsalt100 != nil {
+		*m.addsalt100 += f
+	} else {
+		m.addsalt100 = &f
+	}
+}
+
+// AddedSalt100 returns the value that was added to the "salt100" field in this mutation.
+func (m *FoodMutation) AddedSalt100() (r float64, exists bool) {
+	v := m.addsalt100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (m *FoodMutation) ClearSalt100() {
+	m.salt100 = nil
+	m.addsalt100 = nil
+	m.clearedFields[food.FieldSalt100] = struct{}{}
+}
+
+// Salt100Cleared returns if the "salt100" field was cleared in this mutation.
+func (m *FoodMutation) Salt100Cleared() bool {
+	_, ok := m.clearedFields[food.FieldSalt100]
+	return ok
+}
+
+// ResetSalt100 resets all changes to the "salt100" field.
+func (m *FoodMutation) ResetSalt100() {
+	m.salt100 = nil
+	m.addsalt100 = nil
+	delete(m.clearedFields, food.FieldSalt100)
+}
+
 // SetComment sets the "comment" field.
 func (m *FoodMutation) SetComment(s string) {
 	m.comment = &s
@@ -1529,6 +1824,111 @@ func (m *FoodMutation) ResetComment() {
 	delete(m.clearedFields, food.FieldComment)
 }
 
+// SetPortions sets the "portions" field.
+func (m *FoodMutation) SetPortions(value map[string]float64) {
+	m.portions = &value
+}
+
+// Portions returns the value of the "portions" field in the mutation.
+func (m *FoodMutation) Portions() (r map[string]float64, exists bool) {
+	v := m.portions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortions returns the old "portions" field's value of the Food entity.
+// If the Food object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FoodMutation) OldPortions(ctx context.Context) (v map[string]float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortions: %w", err)
+	}
+	return oldValue.Portions, nil
+}
+
+// ClearPortions clears the value of the "portions" field.
+func (m *FoodMutation) ClearPortions() {
+	m.portions = nil
+	m.clearedFields[food.FieldPortions] = struct{}{}
+}
+
+// PortionsCleared returns if the "portions" field was cleared in this mutation.
+func (m *FoodMutation) PortionsCleared() bool {
+	_, ok := m.clearedFields[food.FieldPortions]
+	return ok
+}
+
+// ResetPortions resets all changes to the "portions" field.
+func (m *FoodMutation) ResetPortions() {
+	m.portions = nil
+	delete(m.clearedFields, food.FieldPortions)
+}
+
+// SetUserid sets the "userid" field.
+func (m *FoodMutation) SetUserid(i int64) {
+	m.userid = &i
+	m.adduserid = nil
+}
+
+// Userid returns the value of the "userid" field in the mutation.
+func (m *FoodMutation) Userid() (r int64, exists bool) {
+	v := m.userid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserid returns the old "userid" field's value of the Food entity.
+// If the Food object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FoodMutation) OldUserid(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserid: %w", err)
+	}
+	return oldValue.Userid, nil
+}
+
+// AddUserid adds i to the "userid" field.
+func (m *FoodMutation) AddUserid(i int64) {
+	if m.adduserid != nil {
+		*m.adduserid += i
+	} else {
+		m.adduserid = &i
+	}
+}
+
+// AddedUserid returns the value that was added to the "userid" field in this mutation.
+func (m *FoodMutation) AddedUserid() (r int64, exists bool) {
+	v := m.adduserid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserid resets all changes to the "userid" field.
+func (m *FoodMutation) ResetUserid() {
+	m.userid = nil
+	m.adduserid = nil
+}
+
 // AddJournalIDs adds the "journals" edge to the Journal entity by ids.
 func (m *FoodMutation) AddJournalIDs(ids ...int) {
 	if m.journals == nil {
@@ -1617,7 +2017,7 @@ func (m *FoodMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FoodMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 14)
 	if m.key != nil {
 		fields = append(fields, food.FieldKey)
 	}
@@ -1639,9 +2039,27 @@ func (m *FoodMutation) Fields() []string {
 	if m.carb100 != nil {
 		fields = append(fields, food.FieldCarb100)
 	}
+	if m.fiber100 != nil {
+		fields = append(fields, food.FieldFiber100)
+	}
+	if m.sugar100 != nil {
+		fields = append(fields, food.FieldSugar100)
+	}
+	if m.satfat100 != nil {
+		fields = append(fields, food.FieldSatfat100)
+	}
+	if m.salt100 != nil {
+		fields = append(fields, food.FieldSalt100)
+	}
 	if m.comment != nil {
 		fields = append(fields, food.FieldComment)
 	}
+	if m.portions != nil {
+		fields = append(fields, food.FieldPortions)
+	}
+	if m.userid != nil {
+		fields = append(fields, food.FieldUserid)
+	}
 	return fields
 }
 
@@ -1664,8 +2082,20 @@ func (m *FoodMutation) Field(name string) (ent.Value, bool) {
 		return m.Fat100()
 	case food.FieldCarb100:
 		return m.Carb100()
+	case food.FieldFiber100:
+		return m.Fiber100()
+	case food.FieldSugar100:
+		return m.Sugar100()
+	case food.FieldSatfat100:
+		return m.Satfat100()
+	case food.FieldSalt100:
+		return m.Salt100()
 	case food.FieldComment:
 		return m.Comment()
+	case food.FieldPortions:
+		return m.Portions()
+	case food.FieldUserid:
+		return m.Userid()
 	}
 	return nil, false
 }
@@ -1689,8 +2119,20 @@ func (m *FoodMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldFat100(ctx)
 	case food.FieldCarb100:
 		return m.OldCarb100(ctx)
+	case food.FieldFiber100:
+		return m.OldFiber100(ctx)
+	case food.FieldSugar100:
+		return m.OldSugar100(ctx)
+	case food.FieldSatfat100:
+		return m.OldSatfat100(ctx)
+	case food.FieldSalt100:
+		return m.OldSalt100(ctx)
 	case food.FieldComment:
 		return m.OldComment(ctx)
+	case food.FieldPortions:
+		return m.OldPortions(ctx)
+	case food.FieldUserid:
+		return m.OldUserid(ctx)
 	}
 	return nil, fmt.Errorf("unknown Food field %s", name)
 }
@@ -1749,6 +2191,34 @@ func (m *FoodMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCarb100(v)
 		return nil
+	case food.FieldFiber100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFiber100(v)
+		return nil
+	case food.FieldSugar100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSugar100(v)
+		return nil
+	case food.FieldSatfat100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSatfat100(v)
+		return nil
+	case food.FieldSalt100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSalt100(v)
+		return nil
 	case food.FieldComment:
 		v, ok := value.(string)
 		if !ok {
@@ -1756,14 +2226,28 @@ func (m *FoodMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetComment(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Food field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *FoodMutation) AddedFields() []string {
-	var fields []string
+	case food.FieldPortions:
+		v, ok := value.(map[string]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPortions(v)
+		return nil
+	case food.FieldUserid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserid(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Food field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FoodMutation) AddedFields() []string {
+	var fields []string
 	if m.addcal100 != nil {
 		fields = append(fields, food.FieldCal100)
 	}
@@ -1776,6 +2260,21 @@ func (m *FoodMutation) AddedFields() []string {
 	if m.addcarb100 != nil {
 		fields = append(fields, food.FieldCarb100)
 	}
+	if m.addfiber100 != nil {
+		fields = append(fields, food.FieldFiber100)
+	}
+	if m.addsugar100 != nil {
+		fields = append(fields, food.FieldSugar100)
+	}
+	if m.addsatfat100 != nil {
+		fields = append(fields, food.FieldSatfat100)
+	}
+	if m.addsalt100 != nil {
+		fields = append(fields, food.FieldSalt100)
+	}
+	if m.adduserid != nil {
+		fields = append(fields, food.FieldUserid)
+	}
 	return fields
 }
 
@@ -1792,6 +2291,16 @@ func (m *FoodMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedFat100()
 	case food.FieldCarb100:
 		return m.AddedCarb100()
+	case food.FieldFiber100:
+		return m.AddedFiber100()
+	case food.FieldSugar100:
+		return m.AddedSugar100()
+	case food.FieldSatfat100:
+		return m.AddedSatfat100()
+	case food.FieldSalt100:
+		return m.AddedSalt100()
+	case food.FieldUserid:
+		return m.AddedUserid()
 	}
 	return nil, false
 }
@@ -1829,6 +2338,41 @@ func (m *FoodMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddCarb100(v)
 		return nil
+	case food.FieldFiber100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFiber100(v)
+		return nil
+	case food.FieldSugar100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSugar100(v)
+		return nil
+	case food.FieldSatfat100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSatfat100(v)
+		return nil
+	case food.FieldSalt100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSalt100(v)
+		return nil
+	case food.FieldUserid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserid(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Food numeric field %s", name)
 }
@@ -1840,9 +2384,24 @@ func (m *FoodMutation) ClearedFields() []string {
 	if m.FieldCleared(food.FieldBrand) {
 		fields = append(fields, food.FieldBrand)
 	}
+	if m.FieldCleared(food.FieldFiber100) {
+		fields = append(fields, food.FieldFiber100)
+	}
+	if m.FieldCleared(food.FieldSugar100) {
+		fields = append(fields, food.FieldSugar100)
+	}
+	if m.FieldCleared(food.FieldSatfat100) {
+		fields = append(fields, food.FieldSatfat100)
+	}
+	if m.FieldCleared(food.FieldSalt100) {
+		fields = append(fields, food.FieldSalt100)
+	}
 	if m.FieldCleared(food.FieldComment) {
 		fields = append(fields, food.FieldComment)
 	}
+	if m.FieldCleared(food.FieldPortions) {
+		fields = append(fields, food.FieldPortions)
+	}
 	return fields
 }
 
@@ -1860,9 +2419,24 @@ func (m *FoodMutation) ClearField(name string) error {
 	case food.FieldBrand:
 		m.ClearBrand()
 		return nil
+	case food.FieldFiber100:
+		m.ClearFiber100()
+		return nil
+	case food.FieldSugar100:
+		m.ClearSugar100()
+		return nil
+	case food.FieldSatfat100:
+		m.ClearSatfat100()
+		return nil
+	case food.FieldSalt100:
+		m.ClearSalt100()
+		return nil
 	case food.FieldComment:
 		m.ClearComment()
 		return nil
+	case food.FieldPortions:
+		m.ClearPortions()
+		return nil
 	}
 	return fmt.Errorf("unknown Food nullable field %s", name)
 }
@@ -1892,9 +2466,27 @@ func (m *FoodMutation) ResetField(name string) error {
 	case food.FieldCarb100:
 		m.ResetCarb100()
 		return nil
+	case food.FieldFiber100:
+		m.ResetFiber100()
+		return nil
+	case food.FieldSugar100:
+		m.ResetSugar100()
+		return nil
+	case food.FieldSatfat100:
+		m.ResetSatfat100()
+		return nil
+	case food.FieldSalt100:
+		m.ResetSalt100()
+		return nil
 	case food.FieldComment:
 		m.ResetComment()
 		return nil
+	case food.FieldPortions:
+		m.ResetPortions()
+		return nil
+	case food.FieldUserid:
+		m.ResetUserid()
+		return nil
 	}
 	return fmt.Errorf("unknown Food field %s", name)
 }
@@ -1986,22 +2578,43 @@ func (m *FoodMutation) ResetEdge(name string) error {
 // JournalMutation represents an operation that mutates the Journal nodes in the graph.
 type JournalMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	userid        *int64
-	adduserid     *int64
-	timestamp     *time.Time
-	meal          *int64
-	addmeal       *int64
-	foodweight    *float64
-	addfoodweight *float64
-	clearedFields map[string]struct{}
-	food          *int
-	clearedfood   bool
-	done          bool
-	oldValue      func(context.Context) (*Journal, error)
-	predicates    []predicate.Journal
+	op              Op
+	typ             string
+	id              *int
+	userid          *int64
+	adduserid       *int64
+	timestamp       *time.Time
+	meal            *int64
+	addmeal         *int64
+	foodweight      *float64
+	addfoodweight   *float64
+	daytime         *int64
+	adddaytime      *int64
+	portion         *string
+	portioncount    *float64
+	addportioncount *float64
+	cal100          *float64
+	addcal100       *float64
+	prot100         *float64
+	addprot100      *float64
+	fat100          *float64
+	addfat100       *float64
+	carb100         *float64
+	addcarb100      *float64
+	fiber100        *float64
+	addfiber100     *float64
+	sugar100        *float64
+	addsugar100     *float64
+	satfat100       *float64
+	addsatfat100    *float64
+	salt100         *float64
+	addsalt100      *float64
+	clearedFields   map[string]struct{}
+	food            *int
+	clearedfood     bool
+	done            bool
+	oldValue        func(context.Context) (*Journal, error)
+	predicates      []predicate.Journal
 }
 
 var _ ent.Mutation = (*JournalMutation)(nil)
@@ -2172,36 +2785,2439 @@ func (m *JournalMutation) Timestamp() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the Journal entity.
-// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *JournalMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetMeal sets the "meal" field.
+func (m *JournalMutation) SetMeal(i int64) {
+	m.meal = &i
+	m.addmeal = nil
+}
+
+// Meal returns the value of the "meal" field in the mutation.
+func (m *JournalMutation) Meal() (r int64, exists bool) {
+	v := m.meal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeal returns the old "meal" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldMeal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeal: %w", err)
+	}
+	return oldValue.Meal, nil
+}
+
+// AddMeal adds i to the "meal" field.
+func (m *JournalMutation) AddMeal(i int64) {
+	if m.addmeal != nil {
+		*m.addmeal += i
+	} else {
+		m.addmeal = &i
+	}
+}
+
+// AddedMeal returns the value that was added to the "meal" field in this mutation.
+func (m *JournalMutation) AddedMeal() (r int64, exists bool) {
+	v := m.addmeal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMeal resets all changes to the "meal" field.
+func (m *JournalMutation) ResetMeal() {
+	m.meal = nil
+	m.addmeal = nil
+}
+
+// SetFoodweight sets the "foodweight" field.
+func (m *JournalMutation) SetFoodweight(f float64) {
+	m.foodweight = &f
+	m.addfoodweight = nil
+}
+
+// Foodweight returns the value of the "foodweight" field in the mutation.
+func (m *JournalMutation) Foodweight() (r float64, exists bool) {
+	v := m.foodweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFoodweight returns the old "foodweight" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldFoodweight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFoodweight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFoodweight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFoodweight: %w", err)
+	}
+	return oldValue.Foodweight, nil
+}
+
+// AddFoodweight adds f to the "foodweight" field.
+func (m *JournalMutation) AddFoodweight(f float64) {
+	if m.addfoodweight != nil {
+		*m.addfoodweight += f
+	} else {
+		m.addfoodweight = &f
+	}
+}
+
+// AddedFoodweight returns the value that was added to the "foodweight" field in this mutation.
+func (m *JournalMutation) AddedFoodweight() (r float64, exists bool) {
+	v := m.addfoodweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFoodweight resets all changes to the "foodweight" field.
+func (m *JournalMutation) ResetFoodweight() {
+	m.foodweight = nil
+	m.addfoodweight = nil
+}
+
+// SetDaytime sets the "daytime" field.
+func (m *JournalMutation) SetDaytime(i int64) {
+	m.daytime = &i
+	m.adddaytime = nil
+}
+
+// Daytime returns the value of the "daytime" field in the mutation.
+func (m *JournalMutation) Daytime() (r int64, exists bool) {
+	v := m.daytime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaytime returns the old "daytime" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldDaytime(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaytime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaytime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaytime: %w", err)
+	}
+	return oldValue.Daytime, nil
+}
+
+// AddDaytime adds i to the "daytime" field.
+func (m *JournalMutation) AddDaytime(i int64) {
+	if m.adddaytime != nil {
+		*m.adddaytime += i
+	} else {
+		m.adddaytime = &i
+	}
+}
+
+// AddedDaytime returns the value that was added to the "daytime" field in this mutation.
+func (m *JournalMutation) AddedDaytime() (r int64, exists bool) {
+	v := m.adddaytime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDaytime clears the value of the "daytime" field.
+func (m *JournalMutation) ClearDaytime() {
+	m.daytime = nil
+	m.adddaytime = nil
+	m.clearedFields[journal.FieldDaytime] = struct{}{}
+}
+
+// DaytimeCleared returns if the "daytime" field was cleared in this mutation.
+func (m *JournalMutation) DaytimeCleared() bool {
+	_, ok := m.clearedFields[journal.FieldDaytime]
+	return ok
+}
+
+// ResetDaytime resets all changes to the "daytime" field.
+func (m *JournalMutation) ResetDaytime() {
+	m.daytime = nil
+	m.adddaytime = nil
+	delete(m.clearedFields, journal.FieldDaytime)
+}
+
+// SetPortion sets the "portion" field.
+func (m *JournalMutation) SetPortion(s string) {
+	m.portion = &s
+}
+
+// Portion returns the value of the "portion" field in the mutation.
+func (m *JournalMutation) Portion() (r string, exists bool) {
+	v := m.portion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortion returns the old "portion" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldPortion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortion: %w", err)
+	}
+	return oldValue.Portion, nil
+}
+
+// ResetPortion resets all changes to the "portion" field.
+func (m *JournalMutation) ResetPortion() {
+	m.portion = nil
+}
+
+// SetPortioncount sets the "portioncount" field.
+func (m *JournalMutation) SetPortioncount(f float64) {
+	m.portioncount = &f
+	m.addportioncount = nil
+}
+
+// Portioncount returns the value of the "portioncount" field in the mutation.
+func (m *JournalMutation) Portioncount() (r float64, exists bool) {
+	v := m.portioncount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortioncount returns the old "portioncount" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldPortioncount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortioncount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortioncount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortioncount: %w", err)
+	}
+	return oldValue.Portioncount, nil
+}
+
+// AddPortioncount adds f to the "portioncount" field.
+func (m *JournalMutation) AddPortioncount(f float64) {
+	if m.addportioncount != nil {
+		*m.addportioncount += f
+	} else {
+		m.addportioncount = &f
+	}
+}
+
+// AddedPortioncount returns the value that was added to the "portioncount" field in this mutation.
+func (m *JournalMutation) AddedPortioncount() (r float64, exists bool) {
+	v := m.addportioncount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPortioncount resets all changes to the "portioncount" field.
+func (m *JournalMutation) ResetPortioncount() {
+	m.portioncount = nil
+	m.addportioncount = nil
+}
+
+// SetCal100 sets the "cal100" field.
+func (m *JournalMutation) SetCal100(f float64) {
+	m.cal100 = &f
+	m.addcal100 = nil
+}
+
+// Cal100 returns the value of the "cal100" field in the mutation.
+func (m *JournalMutation) Cal100() (r float64, exists bool) {
+	v := m.cal100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCal100 returns the old "cal100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldCal100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCal100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCal100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCal100: %w", err)
+	}
+	return oldValue.Cal100, nil
+}
+
+// AddCal100 adds f to the "cal100" field.
+func (m *JournalMutation) AddCal100(f float64) {
+	if m.addcal100 != nil {
+		*m.addcal100 += f
+	} else {
+		m.addcal100 = &f
+	}
+}
+
+// AddedCal100 returns the value that was added to the "cal100" field in this mutation.
+func (m *JournalMutation) AddedCal100() (r float64, exists bool) {
+	v := m.addcal100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCal100 clears the value of the "cal100" field.
+func (m *JournalMutation) ClearCal100() {
+	m.cal100 = nil
+	m.addcal100 = nil
+	m.clearedFields[journal.FieldCal100] = struct{}{}
+}
+
+// Cal100Cleared returns if the "cal100" field was cleared in this mutation.
+func (m *JournalMutation) Cal100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldCal100]
+	return ok
+}
+
+// ResetCal100 resets all changes to the "cal100" field.
+func (m *JournalMutation) ResetCal100() {
+	m.cal100 = nil
+	m.addcal100 = nil
+	delete(m.clearedFields, journal.FieldCal100)
+}
+
+// SetProt100 sets the "prot100" field.
+func (m *JournalMutation) SetProt100(f float64) {
+	m.prot100 = &f
+	m.addprot100 = nil
+}
+
+// Prot100 returns the value of the "prot100" field in the mutation.
+func (m *JournalMutation) Prot100() (r float64, exists bool) {
+	v := m.prot100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProt100 returns the old "prot100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldProt100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProt100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProt100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProt100: %w", err)
+	}
+	return oldValue.Prot100, nil
+}
+
+// AddProt100 adds f to the "prot100" field.
+func (m *JournalMutation) AddProt100(f float64) {
+	if m.addprot100 != nil {
+		*m.addprot100 += f
+	} else {
+		m.addprot100 = &f
+	}
+}
+
+// AddedProt100 returns the value that was added to the "prot100" field in this mutation.
+func (m *JournalMutation) AddedProt100() (r float64, exists bool) {
+	v := m.addprot100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProt100 clears the value of the "prot100" field.
+func (m *JournalMutation) ClearProt100() {
+	m.prot100 = nil
+	m.addprot100 = nil
+	m.clearedFields[journal.FieldProt100] = struct{}{}
+}
+
+// Prot100Cleared returns if the "prot100" field was cleared in this mutation.
+func (m *JournalMutation) Prot100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldProt100]
+	return ok
+}
+
+// ResetProt100 resets all changes to the "prot100" field.
+func (m *JournalMutation) ResetProt100() {
+	m.prot100 = nil
+	m.addprot100 = nil
+	delete(m.clearedFields, journal.FieldProt100)
+}
+
+// SetFat100 sets the "fat100" field.
+func (m *JournalMutation) SetFat100(f float64) {
+	m.fat100 = &f
+	m.addfat100 = nil
+}
+
+// Fat100 returns the value of the "fat100" field in the mutation.
+func (m *JournalMutation) Fat100() (r float64, exists bool) {
+	v := m.fat100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFat100 returns the old "fat100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldFat100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFat100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFat100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFat100: %w", err)
+	}
+	return oldValue.Fat100, nil
+}
+
+// AddFat100 adds f to the "fat100" field.
+func (m *JournalMutation) AddFat100(f float64) {
+	if m.addfat100 != nil {
+		*m.addfat100 += f
+	} else {
+		m.addfat100 = &f
+	}
+}
+
+// AddedFat100 returns the value that was added to the "fat100" field in this mutation.
+func (m *JournalMutation) AddedFat100() (r float64, exists bool) {
+	v := m.addfat100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFat100 clears the value of the "fat100" field.
+func (m *JournalMutation) ClearFat100() {
+	m.fat100 = nil
+	m.addfat100 = nil
+	m.clearedFields[journal.FieldFat100] = struct{}{}
+}
+
+// Fat100Cleared returns if the "fat100" field was cleared in this mutation.
+func (m *JournalMutation) Fat100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldFat100]
+	return ok
+}
+
+// ResetFat100 resets all changes to the "fat100" field.
+func (m *JournalMutation) ResetFat100() {
+	m.fat100 = nil
+	m.addfat100 = nil
+	delete(m.clearedFields, journal.FieldFat100)
+}
+
+// SetCarb100 sets the "carb100" field.
+func (m *JournalMutation) SetCarb100(f float64) {
+	m.carb100 = &f
+	m.addcarb100 = nil
+}
+
+// Carb100 returns the value of the "carb100" field in the mutation.
+func (m *JournalMutation) Carb100() (r float64, exists bool) {
+	v := m.carb100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarb100 returns the old "carb100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldCarb100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarb100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarb100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarb100: %w", err)
+	}
+	return oldValue.Carb100, nil
+}
+
+// AddCarb100 adds f to the "carb100" field.
+func (m *JournalMutation) AddCarb100(f float64) {
+	if m.addcarb100 != nil {
+		*m.addcarb100 += f
+	} else {
+		m.addcarb100 = &f
+	}
+}
+
+// AddedCarb100 returns the value that was added to the "carb100" field in this mutation.
+func (m *JournalMutation) AddedCarb100() (r float64, exists bool) {
+	v := m.addcarb100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCarb100 clears the value of the "carb100" field.
+func (m *JournalMutation) ClearCarb100() {
+	m.carb100 = nil
+	m.addcarb100 = nil
+	m.clearedFields[journal.FieldCarb100] = struct{}{}
+}
+
+// Carb100Cleared returns if the "carb100" field was cleared in this mutation.
+func (m *JournalMutation) Carb100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldCarb100]
+	return ok
+}
+
+// ResetCarb100 resets all changes to the "carb100" field.
+func (m *JournalMutation) ResetCarb100() {
+	m.carb100 = nil
+	m.addcarb100 = nil
+	delete(m.clearedFields, journal.FieldCarb100)
+}
+
+// SetFiber100 sets the "fiber100" field.
+func (m *JournalMutation) SetFiber100(f float64) {
+	m.fiber100 = &f
+	m.addfiber100 = nil
+}
+
+// Fiber100 returns the value of the "fiber100" field in the mutation.
+func (m *JournalMutation) Fiber100() (r float64, exists bool) {
+	v := m.fiber100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFiber100 returns the old "fiber100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldFiber100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFiber100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFiber100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFiber100: %w", err)
+	}
+	return oldValue.Fiber100, nil
+}
+
+// AddFiber100 adds f to the "fiber100" field.
+func (m *JournalMutation) AddFiber100(f float64) {
+	if m.addfiber100 != nil {
+		*m.addfiber100 += f
+	} else {
+		m.addfiber100 = &f
+	}
+}
+
+// AddedFiber100 returns the value that was added to the "fiber100" field in this mutation.
+func (m *JournalMutation) AddedFiber100() (r float64, exists bool) {
+	v := m.addfiber100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFiber100 clears the value of the "fiber100" field.
+func (m *JournalMutation) ClearFiber100() {
+	m.fiber100 = nil
+	m.addfiber100 = nil
+	m.clearedFields[journal.FieldFiber100] = struct{}{}
+}
+
+// Fiber100Cleared returns if the "fiber100" field was cleared in this mutation.
+func (m *JournalMutation) Fiber100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldFiber100]
+	return ok
+}
+
+// ResetFiber100 resets all changes to the "fiber100" field.
+func (m *JournalMutation) ResetFiber100() {
+	m.fiber100 = nil
+	m.addfiber100 = nil
+	delete(m.clearedFields, journal.FieldFiber100)
+}
+
+// SetSugar100 sets the "sugar100" field.
+func (m *JournalMutation) SetSugar100(f float64) {
+	m.sugar100 = &f
+	m.addsugar100 = nil
+}
+
+// Sugar100 returns the value of the "sugar100" field in the mutation.
+func (m *JournalMutation) Sugar100() (r float64, exists bool) {
+	v := m.sugar100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSugar100 returns the old "sugar100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldSugar100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSugar100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSugar100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSugar100: %w", err)
+	}
+	return oldValue.Sugar100, nil
+}
+
+// AddSugar100 adds f to the "sugar100" field.
+func (m *JournalMutation) AddSugar100(f float64) {
+	if m.addsugar100 != nil {
+		*m.addsugar100 += f
+	} else {
+		m.addsugar100 = &f
+	}
+}
+
+// AddedSugar100 returns the value that was added to the "sugar100" field in this mutation.
+func (m *JournalMutation) AddedSugar100() (r float64, exists bool) {
+	v := m.addsugar100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSugar100 clears the value of the "sugar100" field.
+func (m *JournalMutation) ClearSugar100() {
+	m.sugar100 = nil
+	m.addsugar100 = nil
+	m.clearedFields[journal.FieldSugar100] = struct{}{}
+}
+
+// Sugar100Cleared returns if the "sugar100" field was cleared in this mutation.
+func (m *JournalMutation) Sugar100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldSugar100]
+	return ok
+}
+
+// ResetSugar100 resets all changes to the "sugar100" field.
+func (m *JournalMutation) ResetSugar100() {
+	m.sugar100 = nil
+	m.addsugar100 = nil
+	delete(m.clearedFields, journal.FieldSugar100)
+}
+
+// SetSatfat100 sets the "satfat100" field.
+func (m *JournalMutation) SetSatfat100(f float64) {
+	m.satfat100 = &f
+	m.addsatfat100 = nil
+}
+
+// Satfat100 returns the value of the "satfat100" field in the mutation.
+func (m *JournalMutation) Satfat100() (r float64, exists bool) {
+	v := m.satfat100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSatfat100 returns the old "satfat100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldSatfat100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSatfat100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSatfat100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSatfat100: %w", err)
+	}
+	return oldValue.Satfat100, nil
+}
+
+// AddSatfat100 adds f to the "satfat100" field.
+func (m *JournalMutation) AddSatfat100(f float64) {
+	if m.addsatfat100 != nil {
+		*m.addsatfat100 += f
+	} else {
+		m.addsatfat100 = &f
+	}
+}
+
+// AddedSatfat100 returns the value that was added to the "satfat100" field in this mutation.
+func (m *JournalMutation) AddedSatfat100() (r float64, exists bool) {
+	v := m.addsatfat100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSatfat100 clears the value of the "satfat100" field.
+func (m *JournalMutation) ClearSatfat100() {
+	m.satfat100 = nil
+	m.addsatfat100 = nil
+	m.clearedFields[journal.FieldSatfat100] = struct{}{}
+}
+
+// Satfat100Cleared returns if the "satfat100" field was cleared in this mutation.
+func (m *JournalMutation) Satfat100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldSatfat100]
+	return ok
+}
+
+// ResetSatfat100 resets all changes to the "satfat100" field.
+func (m *JournalMutation) ResetSatfat100() {
+	m.satfat100 = nil
+	m.addsatfat100 = nil
+	delete(m.clearedFields, journal.FieldSatfat100)
+}
+
+// SetSalt100 sets the "salt100" field.
+func (m *JournalMutation) SetSalt100(f float64) {
+	m.salt100 = &f
+	m.addsalt100 = nil
+}
+
+// Salt100 returns the value of the "salt100" field in the mutation.
+func (m *JournalMutation) Salt100() (r float64, exists bool) {
+	v := m.salt100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalt100 returns the old "salt100" field's value of the Journal entity.
+// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalMutation) OldSalt100(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalt100 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalt100 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalt100: %w", err)
+	}
+	return oldValue.Salt100, nil
+}
+
+// AddSalt100 adds f to the "salt100" field.
+func (m *JournalMutation) AddSalt100(f float64) {
+	if m.addsalt100 != nil {
+		*m.addsalt100 += f
+	} else {
+		m.addsalt100 = &f
+	}
+}
+
+// AddedSalt100 returns the value that was added to the "salt100" field in this mutation.
+func (m *JournalMutation) AddedSalt100() (r float64, exists bool) {
+	v := m.addsalt100
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSalt100 clears the value of the "salt100" field.
+func (m *JournalMutation) ClearSalt100() {
+	m.salt100 = nil
+	m.addsalt100 = nil
+	m.clearedFields[journal.FieldSalt100] = struct{}{}
+}
+
+// Salt100Cleared returns if the "salt100" field was cleared in this mutation.
+func (m *JournalMutation) Salt100Cleared() bool {
+	_, ok := m.clearedFields[journal.FieldSalt100]
+	return ok
+}
+
+// ResetSalt100 resets all changes to the "salt100" field.
+func (m *JournalMutation) ResetSalt100() {
+	m.salt100 = nil
+	m.addsalt100 = nil
+	delete(m.clearedFields, journal.FieldSalt100)
+}
+
+// SetFoodID sets the "food" edge to the Food entity by id.
+func (m *JournalMutation) SetFoodID(id int) {
+	m.food = &id
+}
+
+// ClearFood clears the "food" edge to the Food entity.
+func (m *JournalMutation) ClearFood() {
+	m.clearedfood = true
+}
+
+// FoodCleared reports if the "food" edge to the Food entity was cleared.
+func (m *JournalMutation) FoodCleared() bool {
+	return m.clearedfood
+}
+
+// FoodID returns the "food" edge ID in the mutation.
+func (m *JournalMutation) FoodID() (id int, exists bool) {
+	if m.food != nil {
+		return *m.food, true
+	}
+	return
+}
+
+// FoodIDs returns the "food" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FoodID instead. It exists only for internal usage by the builders.
+func (m *JournalMutation) FoodIDs() (ids []int) {
+	if id := m.food; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFood resets all changes to the "food" edge.
+func (m *JournalMutation) ResetFood() {
+	m.food = nil
+	m.clearedfood = false
+}
+
+// Where appends a list predicates to the JournalMutation builder.
+func (m *JournalMutation) Where(ps ...predicate.Journal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JournalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JournalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Journal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JournalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JournalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Journal).
+func (m *JournalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JournalMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.userid != nil {
+		fields = append(fields, journal.FieldUserid)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, journal.FieldTimestamp)
+	}
+	if m.meal != nil {
+		fields = append(fields, journal.FieldMeal)
+	}
+	if m.foodweight != nil {
+		fields = append(fields, journal.FieldFoodweight)
+	}
+	if m.daytime != nil {
+		fields = append(fields, journal.FieldDaytime)
+	}
+	if m.portion != nil {
+		fields = append(fields, journal.FieldPortion)
+	}
+	if m.portioncount != nil {
+		fields = append(fields, journal.FieldPortioncount)
+	}
+	if m.cal100 != nil {
+		fields = append(fields, journal.FieldCal100)
+	}
+	if m.prot100 != nil {
+		fields = append(fields, journal.FieldProt100)
+	}
+	if m.fat100 != nil {
+		fields = append(fields, journal.FieldFat100)
+	}
+	if m.carb100 != nil {
+		fields = append(fields, journal.FieldCarb100)
+	}
+	if m.fiber100 != nil {
+		fields = append(fields, journal.FieldFiber100)
+	}
+	if m.sugar100 != nil {
+		fields = append(fields, journal.FieldSugar100)
+	}
+	if m.satfat100 != nil {
+		fields = append(fields, journal.FieldSatfat100)
+	}
+	if m.salt100 != nil {
+		fields = append(fields, journal.FieldSalt100)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JournalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case journal.FieldUserid:
+		return m.Userid()
+	case journal.FieldTimestamp:
+		return m.Timestamp()
+	case journal.FieldMeal:
+		return m.Meal()
+	case journal.FieldFoodweight:
+		return m.Foodweight()
+	case journal.FieldDaytime:
+		return m.Daytime()
+	case journal.FieldPortion:
+		return m.Portion()
+	case journal.FieldPortioncount:
+		return m.Portioncount()
+	case journal.FieldCal100:
+		return m.Cal100()
+	case journal.FieldProt100:
+		return m.Prot100()
+	case journal.FieldFat100:
+		return m.Fat100()
+	case journal.FieldCarb100:
+		return m.Carb100()
+	case journal.FieldFiber100:
+		return m.Fiber100()
+	case journal.FieldSugar100:
+		return m.Sugar100()
+	case journal.FieldSatfat100:
+		return m.Satfat100()
+	case journal.FieldSalt100:
+		return m.Salt100()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JournalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case journal.FieldUserid:
+		return m.OldUserid(ctx)
+	case journal.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case journal.FieldMeal:
+		return m.OldMeal(ctx)
+	case journal.FieldFoodweight:
+		return m.OldFoodweight(ctx)
+	case journal.FieldDaytime:
+		return m.OldDaytime(ctx)
+	case journal.FieldPortion:
+		return m.OldPortion(ctx)
+	case journal.FieldPortioncount:
+		return m.OldPortioncount(ctx)
+	case journal.FieldCal100:
+		return m.OldCal100(ctx)
+	case journal.FieldProt100:
+		return m.OldProt100(ctx)
+	case journal.FieldFat100:
+		return m.OldFat100(ctx)
+	case journal.FieldCarb100:
+		return m.OldCarb100(ctx)
+	case journal.FieldFiber100:
+		return m.OldFiber100(ctx)
+	case journal.FieldSugar100:
+		return m.OldSugar100(ctx)
+	case journal.FieldSatfat100:
+		return m.OldSatfat100(ctx)
+	case journal.FieldSalt100:
+		return m.OldSalt100(ctx)
+	}
+	return nil, fmt.Errorf("unknown Journal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JournalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case journal.FieldUserid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserid(v)
+		return nil
+	case journal.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case journal.FieldMeal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeal(v)
+		return nil
+	case journal.FieldFoodweight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFoodweight(v)
+		return nil
+	case journal.FieldDaytime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaytime(v)
+		return nil
+	case journal.FieldPortion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPortion(v)
+		return nil
+	case journal.FieldPortioncount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPortioncount(v)
+		return nil
+	case journal.FieldCal100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCal100(v)
+		return nil
+	case journal.FieldProt100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProt100(v)
+		return nil
+	case journal.FieldFat100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFat100(v)
+		return nil
+	case journal.FieldCarb100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarb100(v)
+		return nil
+	case journal.FieldFiber100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFiber100(v)
+		return nil
+	case journal.FieldSugar100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSugar100(v)
+		return nil
+	case journal.FieldSatfat100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSatfat100(v)
+		return nil
+	case journal.FieldSalt100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSalt100(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Journal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JournalMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserid != nil {
+		fields = append(fields, journal.FieldUserid)
+	}
+	if m.addmeal != nil {
+		fields = append(fields, journal.FieldMeal)
+	}
+	if m.addfoodweight != nil {
+		fields = append(fields, journal.FieldFoodweight)
+	}
+	if m.adddaytime != nil {
+		fields = append(fields, journal.FieldDaytime)
+	}
+	if m.addportioncount != nil {
+		fields = append(fields, journal.FieldPortioncount)
+	}
+	if m.addcal100 != nil {
+		fields = append(fields, journal.FieldCal100)
+	}
+	if m.addprot100 != nil {
+		fields = append(fields, journal.FieldProt100)
+	}
+	if m.addfat100 != nil {
+		fields = append(fields, journal.FieldFat100)
+	}
+	if m.addcarb100 != nil {
+		fields = append(fields, journal.FieldCarb100)
+	}
+	if m.addfiber100 != nil {
+		fields = append(fields, journal.FieldFiber100)
+	}
+	if m.addsugar100 != nil {
+		fields = append(fields, journal.FieldSugar100)
+	}
+	if m.addsatfat100 != nil {
+		fields = append(fields, journal.FieldSatfat100)
+	}
+	if m.addsalt100 != nil {
+		fields = append(fields, journal.FieldSalt100)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JournalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case journal.FieldUserid:
+		return m.AddedUserid()
+	case journal.FieldMeal:
+		return m.AddedMeal()
+	case journal.FieldFoodweight:
+		return m.AddedFoodweight()
+	case journal.FieldDaytime:
+		return m.AddedDaytime()
+	case journal.FieldPortioncount:
+		return m.AddedPortioncount()
+	case journal.FieldCal100:
+		return m.AddedCal100()
+	case journal.FieldProt100:
+		return m.AddedProt100()
+	case journal.FieldFat100:
+		return m.AddedFat100()
+	case journal.FieldCarb100:
+		return m.AddedCarb100()
+	case journal.FieldFiber100:
+		return m.AddedFiber100()
+	case journal.FieldSugar100:
+		return m.AddedSugar100()
+	case journal.FieldSatfat100:
+		return m.AddedSatfat100()
+	case journal.FieldSalt100:
+		return m.AddedSalt100()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JournalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case journal.FieldUserid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserid(v)
+		return nil
+	case journal.FieldMeal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMeal(v)
+		return nil
+	case journal.FieldFoodweight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFoodweight(v)
+		return nil
+	case journal.FieldDaytime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDaytime(v)
+		return nil
+	case journal.FieldPortioncount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPortioncount(v)
+		return nil
+	case journal.FieldCal100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCal100(v)
+		return nil
+	case journal.FieldProt100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProt100(v)
+		return nil
+	case journal.FieldFat100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFat100(v)
+		return nil
+	case journal.FieldCarb100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCarb100(v)
+		return nil
+	case journal.FieldFiber100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFiber100(v)
+		return nil
+	case journal.FieldSugar100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSugar100(v)
+		return nil
+	case journal.FieldSatfat100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSatfat100(v)
+		return nil
+	case journal.FieldSalt100:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSalt100(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Journal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JournalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(journal.FieldDaytime) {
+		fields = append(fields, journal.FieldDaytime)
+	}
+	if m.FieldCleared(journal.FieldCal100) {
+		fields = append(fields, journal.FieldCal100)
+	}
+	if m.FieldCleared(journal.FieldProt100) {
+		fields = append(fields, journal.FieldProt100)
+	}
+	if m.FieldCleared(journal.FieldFat100) {
+		fields = append(fields, journal.FieldFat100)
+	}
+	if m.FieldCleared(journal.FieldCarb100) {
+		fields = append(fields, journal.FieldCarb100)
+	}
+	if m.FieldCleared(journal.FieldFiber100) {
+		fields = append(fields, journal.FieldFiber100)
+	}
+	if m.FieldCleared(journal.FieldSugar100) {
+		fields = append(fields, journal.FieldSugar100)
+	}
+	if m.FieldCleared(journal.FieldSatfat100) {
+		fields = append(fields, journal.FieldSatfat100)
+	}
+	if m.FieldCleared(journal.FieldSalt100) {
+		fields = append(fields, journal.FieldSalt100)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JournalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JournalMutation) ClearField(name string) error {
+	switch name {
+	case journal.FieldDaytime:
+		m.ClearDaytime()
+		return nil
+	case journal.FieldCal100:
+		m.ClearCal100()
+		return nil
+	case journal.FieldProt100:
+		m.ClearProt100()
+		return nil
+	case journal.FieldFat100:
+		m.ClearFat100()
+		return nil
+	case journal.FieldCarb100:
+		m.ClearCarb100()
+		return nil
+	case journal.FieldFiber100:
+		m.ClearFiber100()
+		return nil
+	case journal.FieldSugar100:
+		m.ClearSugar100()
+		return nil
+	case journal.FieldSatfat100:
+		m.ClearSatfat100()
+		return nil
+	case journal.FieldSalt100:
+		m.ClearSalt100()
+		return nil
+	}
+	return fmt.Errorf("unknown Journal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JournalMutation) ResetField(name string) error {
+	switch name {
+	case journal.FieldUserid:
+		m.ResetUserid()
+		return nil
+	case journal.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case journal.FieldMeal:
+		m.ResetMeal()
+		return nil
+	case journal.FieldFoodweight:
+		m.ResetFoodweight()
+		return nil
+	case journal.FieldDaytime:
+		m.ResetDaytime()
+		return nil
+	case journal.FieldPortion:
+		m.ResetPortion()
+		return nil
+	case journal.FieldPortioncount:
+		m.ResetPortioncount()
+		return nil
+	case journal.FieldCal100:
+		m.ResetCal100()
+		return nil
+	case journal.FieldProt100:
+		m.ResetProt100()
+		return nil
+	case journal.FieldFat100:
+		m.ResetFat100()
+		return nil
+	case journal.FieldCarb100:
+		m.ResetCarb100()
+		return nil
+	case journal.FieldFiber100:
+		m.ResetFiber100()
+		return nil
+	case journal.FieldSugar100:
+		m.ResetSugar100()
+		return nil
+	case journal.FieldSatfat100:
+		m.ResetSatfat100()
+		return nil
+	case journal.FieldSalt100:
+		m.ResetSalt100()
+		return nil
+	}
+	return fmt.Errorf("unknown Journal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JournalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.food != nil {
+		edges = append(edges, journal.EdgeFood)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JournalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case journal.EdgeFood:
+		if id := m.food; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JournalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JournalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JournalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfood {
+		edges = append(edges, journal.EdgeFood)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JournalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case journal.EdgeFood:
+		return m.clearedfood
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JournalMutation) ClearEdge(name string) error {
+	switch name {
+	case journal.EdgeFood:
+		m.ClearFood()
+		return nil
+	}
+	return fmt.Errorf("unknown Journal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JournalMutation) ResetEdge(name string) error {
+	switch name {
+	case journal.EdgeFood:
+		m.ResetFood()
+		return nil
+	}
+	return fmt.Errorf("unknown Journal edge %s", name)
+}
+
+// RecipeMutation represents an operation that mutates the Recipe nodes in the graph.
+type RecipeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	userid          *int64
+	adduserid       *int64
+	key             *string
+	name            *string
+	ingredients     *map[string]float64
+	cookedweight    *float64
+	addcookedweight *float64
+	tare            *float64
+	addtare         *float64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Recipe, error)
+	predicates      []predicate.Recipe
+}
+
+var _ ent.Mutation = (*RecipeMutation)(nil)
+
+// recipeOption allows management of the mutation configuration using functional options.
+type recipeOption func(*RecipeMutation)
+
+// newRecipeMutation creates new mutation for the Recipe entity.
+func newRecipeMutation(c config, op Op, opts ...recipeOption) *RecipeMutation {
+	m := &RecipeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecipe,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecipeID sets the ID field of the mutation.
+func withRecipeID(id int) recipeOption {
+	return func(m *RecipeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Recipe
+		)
+		m.oldValue = func(ctx context.Context) (*Recipe, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Recipe.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecipe sets the old Recipe of the mutation.
+func withRecipe(node *Recipe) recipeOption {
+	return func(m *RecipeMutation) {
+		m.oldValue = func(context.Context) (*Recipe, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecipeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecipeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecipeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecipeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Recipe.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserid sets the "userid" field.
+func (m *RecipeMutation) SetUserid(i int64) {
+	m.userid = &i
+	m.adduserid = nil
+}
+
+// Userid returns the value of the "userid" field in the mutation.
+func (m *RecipeMutation) Userid() (r int64, exists bool) {
+	v := m.userid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserid returns the old "userid" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldUserid(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserid: %w", err)
+	}
+	return oldValue.Userid, nil
+}
+
+// AddUserid adds i to the "userid" field.
+func (m *RecipeMutation) AddUserid(i int64) {
+	if m.adduserid != nil {
+		*m.adduserid += i
+	} else {
+		m.adduserid = &i
+	}
+}
+
+// AddedUserid returns the value that was added to the "userid" field in this mutation.
+func (m *RecipeMutation) AddedUserid() (r int64, exists bool) {
+	v := m.adduserid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserid resets all changes to the "userid" field.
+func (m *RecipeMutation) ResetUserid() {
+	m.userid = nil
+	m.adduserid = nil
+}
+
+// SetKey sets the "key" field.
+func (m *RecipeMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *RecipeMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *RecipeMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetName sets the "name" field.
+func (m *RecipeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RecipeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RecipeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetIngredients sets the "ingredients" field.
+func (m *RecipeMutation) SetIngredients(value map[string]float64) {
+	m.ingredients = &value
+}
+
+// Ingredients returns the value of the "ingredients" field in the mutation.
+func (m *RecipeMutation) Ingredients() (r map[string]float64, exists bool) {
+	v := m.ingredients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngredients returns the old "ingredients" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldIngredients(ctx context.Context) (v map[string]float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngredients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngredients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngredients: %w", err)
+	}
+	return oldValue.Ingredients, nil
+}
+
+// ResetIngredients resets all changes to the "ingredients" field.
+func (m *RecipeMutation) ResetIngredients() {
+	m.ingredients = nil
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (m *RecipeMutation) SetCookedweight(f float64) {
+	m.cookedweight = &f
+	m.addcookedweight = nil
+}
+
+// Cookedweight returns the value of the "cookedweight" field in the mutation.
+func (m *RecipeMutation) Cookedweight() (r float64, exists bool) {
+	v := m.cookedweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCookedweight returns the old "cookedweight" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldCookedweight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCookedweight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCookedweight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCookedweight: %w", err)
+	}
+	return oldValue.Cookedweight, nil
+}
+
+// AddCookedweight adds f to the "cookedweight" field.
+func (m *RecipeMutation) AddCookedweight(f float64) {
+	if m.addcookedweight != nil {
+		*m.addcookedweight += f
+	} else {
+		m.addcookedweight = &f
+	}
+}
+
+// AddedCookedweight returns the value that was added to the "cookedweight" field in this mutation.
+func (m *RecipeMutation) AddedCookedweight() (r float64, exists bool) {
+	v := m.addcookedweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCookedweight resets all changes to the "cookedweight" field.
+func (m *RecipeMutation) ResetCookedweight() {
+	m.cookedweight = nil
+	m.addcookedweight = nil
+}
+
+// SetTare sets the "tare" field.
+func (m *RecipeMutation) SetTare(f float64) {
+	m.tare = &f
+	m.addtare = nil
+}
+
+// Tare returns the value of the "tare" field in the mutation.
+func (m *RecipeMutation) Tare() (r float64, exists bool) {
+	v := m.tare
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTare returns the old "tare" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldTare(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTare is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTare requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTare: %w", err)
+	}
+	return oldValue.Tare, nil
+}
+
+// AddTare adds f to the "tare" field.
+func (m *RecipeMutation) AddTare(f float64) {
+	if m.addtare != nil {
+		*m.addtare += f
+	} else {
+		m.addtare = &f
+	}
+}
+
+// AddedTare returns the value that was added to the "tare" field in this mutation.
+func (m *RecipeMutation) AddedTare() (r float64, exists bool) {
+	v := m.addtare
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTare resets all changes to the "tare" field.
+func (m *RecipeMutation) ResetTare() {
+	m.tare = nil
+	m.addtare = nil
+}
+
+// Where appends a list predicates to the RecipeMutation builder.
+func (m *RecipeMutation) Where(ps ...predicate.Recipe) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecipeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecipeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Recipe, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecipeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecipeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Recipe).
+func (m *RecipeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecipeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.userid != nil {
+		fields = append(fields, recipe.FieldUserid)
+	}
+	if m.key != nil {
+		fields = append(fields, recipe.FieldKey)
+	}
+	if m.name != nil {
+		fields = append(fields, recipe.FieldName)
+	}
+	if m.ingredients != nil {
+		fields = append(fields, recipe.FieldIngredients)
+	}
+	if m.cookedweight != nil {
+		fields = append(fields, recipe.FieldCookedweight)
+	}
+	if m.tare != nil {
+		fields = append(fields, recipe.FieldTare)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecipeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recipe.FieldUserid:
+		return m.Userid()
+	case recipe.FieldKey:
+		return m.Key()
+	case recipe.FieldName:
+		return m.Name()
+	case recipe.FieldIngredients:
+		return m.Ingredients()
+	case recipe.FieldCookedweight:
+		return m.Cookedweight()
+	case recipe.FieldTare:
+		return m.Tare()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecipeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recipe.FieldUserid:
+		return m.OldUserid(ctx)
+	case recipe.FieldKey:
+		return m.OldKey(ctx)
+	case recipe.FieldName:
+		return m.OldName(ctx)
+	case recipe.FieldIngredients:
+		return m.OldIngredients(ctx)
+	case recipe.FieldCookedweight:
+		return m.OldCookedweight(ctx)
+	case recipe.FieldTare:
+		return m.OldTare(ctx)
+	}
+	return nil, fmt.Errorf("unknown Recipe field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecipeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recipe.FieldUserid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserid(v)
+		return nil
+	case recipe.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case recipe.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case recipe.FieldIngredients:
+		v, ok := value.(map[string]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngredients(v)
+		return nil
+	case recipe.FieldCookedweight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCookedweight(v)
+		return nil
+	case recipe.FieldTare:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTare(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecipeMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserid != nil {
+		fields = append(fields, recipe.FieldUserid)
+	}
+	if m.addcookedweight != nil {
+		fields = append(fields, recipe.FieldCookedweight)
+	}
+	if m.addtare != nil {
+		fields = append(fields, recipe.FieldTare)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecipeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recipe.FieldUserid:
+		return m.AddedUserid()
+	case recipe.FieldCookedweight:
+		return m.AddedCookedweight()
+	case recipe.FieldTare:
+		return m.AddedTare()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecipeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recipe.FieldUserid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserid(v)
+		return nil
+	case recipe.FieldCookedweight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCookedweight(v)
+		return nil
+	case recipe.FieldTare:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTare(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecipeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecipeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecipeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Recipe nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecipeMutation) ResetField(name string) error {
+	switch name {
+	case recipe.FieldUserid:
+		m.ResetUserid()
+		return nil
+	case recipe.FieldKey:
+		m.ResetKey()
+		return nil
+	case recipe.FieldName:
+		m.ResetName()
+		return nil
+	case recipe.FieldIngredients:
+		m.ResetIngredients()
+		return nil
+	case recipe.FieldCookedweight:
+		m.ResetCookedweight()
+		return nil
+	case recipe.FieldTare:
+		m.ResetTare()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecipeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecipeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecipeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecipeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecipeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecipeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecipeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Recipe unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecipeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Recipe edge %s", name)
+}
+
+// UserMealMutation represents an operation that mutates the UserMeal nodes in the graph.
+type UserMealMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	userid        *int64
+	adduserid     *int64
+	meal          *int64
+	addmeal       *int64
+	name          *string
+	aliases       *[]string
+	appendaliases []string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UserMeal, error)
+	predicates    []predicate.UserMeal
+}
+
+var _ ent.Mutation = (*UserMealMutation)(nil)
+
+// usermealOption allows management of the mutation configuration using functional options.
+type usermealOption func(*UserMealMutation)
+
+// newUserMealMutation creates new mutation for the UserMeal entity.
+func newUserMealMutation(c config, op Op, opts ...usermealOption) *UserMealMutation {
+	m := &UserMealMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserMeal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserMealID sets the ID field of the mutation.
+func withUserMealID(id int) usermealOption {
+	return func(m *UserMealMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserMeal
+		)
+		m.oldValue = func(ctx context.Context) (*UserMeal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserMeal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserMeal sets the old UserMeal of the mutation.
+func withUserMeal(node *UserMeal) usermealOption {
+	return func(m *UserMealMutation) {
+		m.oldValue = func(context.Context) (*UserMeal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMealMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMealMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMealMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMealMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserMeal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserid sets the "userid" field.
+func (m *UserMealMutation) SetUserid(i int64) {
+	m.userid = &i
+	m.adduserid = nil
+}
+
+// Userid returns the value of the "userid" field in the mutation.
+func (m *UserMealMutation) Userid() (r int64, exists bool) {
+	v := m.userid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserid returns the old "userid" field's value of the UserMeal entity.
+// If the UserMeal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JournalMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *UserMealMutation) OldUserid(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldUserid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserid: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.Userid, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *JournalMutation) ResetTimestamp() {
-	m.timestamp = nil
+// AddUserid adds i to the "userid" field.
+func (m *UserMealMutation) AddUserid(i int64) {
+	if m.adduserid != nil {
+		*m.adduserid += i
+	} else {
+		m.adduserid = &i
+	}
+}
+
+// AddedUserid returns the value that was added to the "userid" field in this mutation.
+func (m *UserMealMutation) AddedUserid() (r int64, exists bool) {
+	v := m.adduserid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserid resets all changes to the "userid" field.
+func (m *UserMealMutation) ResetUserid() {
+	m.userid = nil
+	m.adduserid = nil
 }
 
 // SetMeal sets the "meal" field.
-func (m *JournalMutation) SetMeal(i int64) {
+func (m *UserMealMutation) SetMeal(i int64) {
 	m.meal = &i
 	m.addmeal = nil
 }
 
 // Meal returns the value of the "meal" field in the mutation.
-func (m *JournalMutation) Meal() (r int64, exists bool) {
+func (m *UserMealMutation) Meal() (r int64, exists bool) {
 	v := m.meal
 	if v == nil {
 		return
@@ -2209,10 +5225,10 @@ func (m *JournalMutation) Meal() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldMeal returns the old "meal" field's value of the Journal entity.
-// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// OldMeal returns the old "meal" field's value of the UserMeal entity.
+// If the UserMeal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JournalMutation) OldMeal(ctx context.Context) (v int64, err error) {
+func (m *UserMealMutation) OldMeal(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMeal is only allowed on UpdateOne operations")
 	}
@@ -2227,7 +5243,7 @@ func (m *JournalMutation) OldMeal(ctx context.Context) (v int64, err error) {
 }
 
 // AddMeal adds i to the "meal" field.
-func (m *JournalMutation) AddMeal(i int64) {
+func (m *UserMealMutation) AddMeal(i int64) {
 	if m.addmeal != nil {
 		*m.addmeal += i
 	} else {
@@ -2236,7 +5252,7 @@ func (m *JournalMutation) AddMeal(i int64) {
 }
 
 // AddedMeal returns the value that was added to the "meal" field in this mutation.
-func (m *JournalMutation) AddedMeal() (r int64, exists bool) {
+func (m *UserMealMutation) AddedMeal() (r int64, exists bool) {
 	v := m.addmeal
 	if v == nil {
 		return
@@ -2245,115 +5261,107 @@ func (m *JournalMutation) AddedMeal() (r int64, exists bool) {
 }
 
 // ResetMeal resets all changes to the "meal" field.
-func (m *JournalMutation) ResetMeal() {
+func (m *UserMealMutation) ResetMeal() {
 	m.meal = nil
 	m.addmeal = nil
 }
 
-// SetFoodweight sets the "foodweight" field.
-func (m *JournalMutation) SetFoodweight(f float64) {
-	m.foodweight = &f
-	m.addfoodweight = nil
+// SetName sets the "name" field.
+func (m *UserMealMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Foodweight returns the value of the "foodweight" field in the mutation.
-func (m *JournalMutation) Foodweight() (r float64, exists bool) {
-	v := m.foodweight
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMealMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFoodweight returns the old "foodweight" field's value of the Journal entity.
-// If the Journal object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the UserMeal entity.
+// If the UserMeal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JournalMutation) OldFoodweight(ctx context.Context) (v float64, err error) {
+func (m *UserMealMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFoodweight is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFoodweight requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFoodweight: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Foodweight, nil
+	return oldValue.Name, nil
 }
 
-// AddFoodweight adds f to the "foodweight" field.
-func (m *JournalMutation) AddFoodweight(f float64) {
-	if m.addfoodweight != nil {
-		*m.addfoodweight += f
-	} else {
-		m.addfoodweight = &f
-	}
+// ResetName resets all changes to the "name" field.
+func (m *UserMealMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedFoodweight returns the value that was added to the "foodweight" field in this mutation.
-func (m *JournalMutation) AddedFoodweight() (r float64, exists bool) {
-	v := m.addfoodweight
+// SetAliases sets the "aliases" field.
+func (m *UserMealMutation) SetAliases(s []string) {
+	m.aliases = &s
+	m.appendaliases = nil
+}
+
+// Aliases returns the value of the "aliases" field in the mutation.
+func (m *UserMealMutation) Aliases() (r []string, exists bool) {
+	v := m.aliases
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFoodweight resets all changes to the "foodweight" field.
-func (m *JournalMutation) ResetFoodweight() {
-	m.foodweight = nil
-	m.addfoodweight = nil
-}
-
-// SetFoodID sets the "food" edge to the Food entity by id.
-func (m *JournalMutation) SetFoodID(id int) {
-	m.food = &id
-}
-
-// ClearFood clears the "food" edge to the Food entity.
-func (m *JournalMutation) ClearFood() {
-	m.clearedfood = true
-}
-
-// FoodCleared reports if the "food" edge to the Food entity was cleared.
-func (m *JournalMutation) FoodCleared() bool {
-	return m.clearedfood
+// OldAliases returns the old "aliases" field's value of the UserMeal entity.
+// If the UserMeal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMealMutation) OldAliases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliases: %w", err)
+	}
+	return oldValue.Aliases, nil
 }
 
-// FoodID returns the "food" edge ID in the mutation.
-func (m *JournalMutation) FoodID() (id int, exists bool) {
-	if m.food != nil {
-		return *m.food, true
-	}
-	return
+// AppendAliases adds s to the "aliases" field.
+func (m *UserMealMutation) AppendAliases(s []string) {
+	m.appendaliases = append(m.appendaliases, s...)
 }
 
-// FoodIDs returns the "food" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FoodID instead. It exists only for internal usage by the builders.
-func (m *JournalMutation) FoodIDs() (ids []int) {
-	if id := m.food; id != nil {
-		ids = append(ids, *id)
+// AppendedAliases returns the list of values that were appended to the "aliases" field in this mutation.
+func (m *UserMealMutation) AppendedAliases() ([]string, bool) {
+	if len(m.appendaliases) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendaliases, true
 }
 
-// ResetFood resets all changes to the "food" edge.
-func (m *JournalMutation) ResetFood() {
-	m.food = nil
-	m.clearedfood = false
+// ResetAliases resets all changes to the "aliases" field.
+func (m *UserMealMutation) ResetAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
 }
 
-// Where appends a list predicates to the JournalMutation builder.
-func (m *JournalMutation) Where(ps ...predicate.Journal) {
+// Where appends a list predicates to the UserMealMutation builder.
+func (m *UserMealMutation) Where(ps ...predicate.UserMeal) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JournalMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMealMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JournalMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Journal, len(ps))
+func (m *UserMealMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserMeal, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2361,36 +5369,36 @@ func (m *JournalMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JournalMutation) Op() Op {
+func (m *UserMealMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JournalMutation) SetOp(op Op) {
+func (m *UserMealMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Journal).
-func (m *JournalMutation) Type() string {
+// Type returns the node type of this mutation (UserMeal).
+func (m *UserMealMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JournalMutation) Fields() []string {
+func (m *UserMealMutation) Fields() []string {
 	fields := make([]string, 0, 4)
 	if m.userid != nil {
-		fields = append(fields, journal.FieldUserid)
-	}
-	if m.timestamp != nil {
-		fields = append(fields, journal.FieldTimestamp)
+		fields = append(fields, usermeal.FieldUserid)
 	}
 	if m.meal != nil {
-		fields = append(fields, journal.FieldMeal)
+		fields = append(fields, usermeal.FieldMeal)
 	}
-	if m.foodweight != nil {
-		fields = append(fields, journal.FieldFoodweight)
+	if m.name != nil {
+		fields = append(fields, usermeal.FieldName)
+	}
+	if m.aliases != nil {
+		fields = append(fields, usermeal.FieldAliases)
 	}
 	return fields
 }
@@ -2398,16 +5406,16 @@ func (m *JournalMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JournalMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMealMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case journal.FieldUserid:
+	case usermeal.FieldUserid:
 		return m.Userid()
-	case journal.FieldTimestamp:
-		return m.Timestamp()
-	case journal.FieldMeal:
+	case usermeal.FieldMeal:
 		return m.Meal()
-	case journal.FieldFoodweight:
-		return m.Foodweight()
+	case usermeal.FieldName:
+		return m.Name()
+	case usermeal.FieldAliases:
+		return m.Aliases()
 	}
 	return nil, false
 }
@@ -2415,69 +5423,66 @@ func (m *JournalMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JournalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMealMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case journal.FieldUserid:
+	case usermeal.FieldUserid:
 		return m.OldUserid(ctx)
-	case journal.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case journal.FieldMeal:
+	case usermeal.FieldMeal:
 		return m.OldMeal(ctx)
-	case journal.FieldFoodweight:
-		return m.OldFoodweight(ctx)
+	case usermeal.FieldName:
+		return m.OldName(ctx)
+	case usermeal.FieldAliases:
+		return m.OldAliases(ctx)
 	}
-	return nil, fmt.Errorf("unknown Journal field %s", name)
+	return nil, fmt.Errorf("unknown UserMeal field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JournalMutation) SetField(name string, value ent.Value) error {
+func (m *UserMealMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case journal.FieldUserid:
+	case usermeal.FieldUserid:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserid(v)
 		return nil
-	case journal.FieldTimestamp:
-		v, ok := value.(time.Time)
+	case usermeal.FieldMeal:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetMeal(v)
 		return nil
-	case journal.FieldMeal:
-		v, ok := value.(int64)
+	case usermeal.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMeal(v)
+		m.SetName(v)
 		return nil
-	case journal.FieldFoodweight:
-		v, ok := value.(float64)
+	case usermeal.FieldAliases:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFoodweight(v)
+		m.SetAliases(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Journal field %s", name)
+	return fmt.Errorf("unknown UserMeal field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JournalMutation) AddedFields() []string {
+func (m *UserMealMutation) AddedFields() []string {
 	var fields []string
 	if m.adduserid != nil {
-		fields = append(fields, journal.FieldUserid)
+		fields = append(fields, usermeal.FieldUserid)
 	}
 	if m.addmeal != nil {
-		fields = append(fields, journal.FieldMeal)
-	}
-	if m.addfoodweight != nil {
-		fields = append(fields, journal.FieldFoodweight)
+		fields = append(fields, usermeal.FieldMeal)
 	}
 	return fields
 }
@@ -2485,14 +5490,12 @@ func (m *JournalMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JournalMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMealMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case journal.FieldUserid:
+	case usermeal.FieldUserid:
 		return m.AddedUserid()
-	case journal.FieldMeal:
+	case usermeal.FieldMeal:
 		return m.AddedMeal()
-	case journal.FieldFoodweight:
-		return m.AddedFoodweight()
 	}
 	return nil, false
 }
@@ -2500,144 +5503,111 @@ func (m *JournalMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JournalMutation) AddField(name string, value ent.Value) error {
+func (m *UserMealMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case journal.FieldUserid:
+	case usermeal.FieldUserid:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUserid(v)
 		return nil
-	case journal.FieldMeal:
+	case usermeal.FieldMeal:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddMeal(v)
 		return nil
-	case journal.FieldFoodweight:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFoodweight(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Journal numeric field %s", name)
+	return fmt.Errorf("unknown UserMeal numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JournalMutation) ClearedFields() []string {
+func (m *UserMealMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JournalMutation) FieldCleared(name string) bool {
+func (m *UserMealMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JournalMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Journal nullable field %s", name)
+func (m *UserMealMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserMeal nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JournalMutation) ResetField(name string) error {
+func (m *UserMealMutation) ResetField(name string) error {
 	switch name {
-	case journal.FieldUserid:
+	case usermeal.FieldUserid:
 		m.ResetUserid()
 		return nil
-	case journal.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case journal.FieldMeal:
+	case usermeal.FieldMeal:
 		m.ResetMeal()
 		return nil
-	case journal.FieldFoodweight:
-		m.ResetFoodweight()
+	case usermeal.FieldName:
+		m.ResetName()
+		return nil
+	case usermeal.FieldAliases:
+		m.ResetAliases()
 		return nil
 	}
-	return fmt.Errorf("unknown Journal field %s", name)
+	return fmt.Errorf("unknown UserMeal field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JournalMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.food != nil {
-		edges = append(edges, journal.EdgeFood)
-	}
+func (m *UserMealMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JournalMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case journal.EdgeFood:
-		if id := m.food; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *UserMealMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JournalMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMealMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JournalMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMealMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JournalMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfood {
-		edges = append(edges, journal.EdgeFood)
-	}
+func (m *UserMealMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JournalMutation) EdgeCleared(name string) bool {
-	switch name {
-	case journal.EdgeFood:
-		return m.clearedfood
-	}
+func (m *UserMealMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JournalMutation) ClearEdge(name string) error {
-	switch name {
-	case journal.EdgeFood:
-		m.ClearFood()
-		return nil
-	}
-	return fmt.Errorf("unknown Journal unique edge %s", name)
+func (m *UserMealMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserMeal unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JournalMutation) ResetEdge(name string) error {
-	switch name {
-	case journal.EdgeFood:
-		m.ResetFood()
-		return nil
-	}
-	return fmt.Errorf("unknown Journal edge %s", name)
+func (m *UserMealMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserMeal edge %s", name)
 }
 
 // UserSettingsMutation represents an operation that mutates the UserSettings nodes in the graph.
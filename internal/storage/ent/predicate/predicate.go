@@ -18,6 +18,12 @@ type Food func(*sql.Selector)
 // Journal is the predicate function for journal builders.
 type Journal func(*sql.Selector)
 
+// Recipe is the predicate function for recipe builders.
+type Recipe func(*sql.Selector)
+
+// UserMeal is the predicate function for usermeal builders.
+type UserMeal func(*sql.Selector)
+
 // UserSettings is the predicate function for usersettings builders.
 type UserSettings func(*sql.Selector)
 
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+)
+
+// Recipe is the model entity for the Recipe schema.
+type Recipe struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Userid holds the value of the "userid" field.
+	Userid int64 `json:"userid,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Ingredients holds the value of the "ingredients" field.
+	Ingredients map[string]float64 `json:"ingredients,omitempty"`
+	// Cookedweight holds the value of the "cookedweight" field.
+	Cookedweight float64 `json:"cookedweight,omitempty"`
+	// Tare holds the value of the "tare" field.
+	Tare         float64 `json:"tare,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Recipe) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recipe.FieldIngredients:
+			values[i] = new([]byte)
+		case recipe.FieldCookedweight, recipe.FieldTare:
+			values[i] = new(sql.NullFloat64)
+		case recipe.FieldID, recipe.FieldUserid:
+			values[i] = new(sql.NullInt64)
+		case recipe.FieldKey, recipe.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Recipe fields.
+func (r *Recipe) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recipe.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case recipe.FieldUserid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userid", values[i])
+			} else if value.Valid {
+				r.Userid = value.Int64
+			}
+		case recipe.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				r.Key = value.String
+			}
+		case recipe.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				r.Name = value.String
+			}
+		case recipe.FieldIngredients:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ingredients", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Ingredients); err != nil {
+					return fmt.Errorf("unmarshal field ingredients: %w", err)
+				}
+			}
+		case recipe.FieldCookedweight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cookedweight", values[i])
+			} else if value.Valid {
+				r.Cookedweight = value.Float64
+			}
+		case recipe.FieldTare:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tare", values[i])
+			} else if value.Valid {
+				r.Tare = value.Float64
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Recipe.
+// This includes values selected through modifiers, order, etc.
+func (r *Recipe) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Recipe.
+// Note that you need to call Recipe.Unwrap() before calling this method if this Recipe
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Recipe) Update() *RecipeUpdateOne {
+	return NewRecipeClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Recipe entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Recipe) Unwrap() *Recipe {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Recipe is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Recipe) String() string {
+	var builder strings.Builder
+	builder.WriteString("Recipe(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("userid=")
+	builder.WriteString(fmt.Sprintf("%v", r.Userid))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(r.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	builder.WriteString("ingredients=")
+	builder.WriteString(fmt.Sprintf("%v", r.Ingredients))
+	builder.WriteString(", ")
+	builder.WriteString("cookedweight=")
+	builder.WriteString(fmt.Sprintf("%v", r.Cookedweight))
+	builder.WriteString(", ")
+	builder.WriteString("tare=")
+	builder.WriteString(fmt.Sprintf("%v", r.Tare))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Recipes is a parsable slice of Recipe.
+type Recipes []*Recipe
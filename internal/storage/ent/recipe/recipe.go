@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recipe
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the recipe type in the database.
+	Label = "recipe"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserid holds the string denoting the userid field in the database.
+	FieldUserid = "userid"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldIngredients holds the string denoting the ingredients field in the database.
+	FieldIngredients = "ingredients"
+	// FieldCookedweight holds the string denoting the cookedweight field in the database.
+	FieldCookedweight = "cookedweight"
+	// FieldTare holds the string denoting the tare field in the database.
+	FieldTare = "tare"
+	// Table holds the table name of the recipe in the database.
+	Table = "recipes"
+)
+
+// Columns holds all SQL columns for recipe fields.
+var Columns = []string{
+	FieldID,
+	FieldUserid,
+	FieldKey,
+	FieldName,
+	FieldIngredients,
+	FieldCookedweight,
+	FieldTare,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTare holds the default value on creation for the "tare" field.
+	DefaultTare float64
+)
+
+// OrderOption defines the ordering options for the Recipe queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserid orders the results by the userid field.
+func ByUserid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserid, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCookedweight orders the results by the cookedweight field.
+func ByCookedweight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCookedweight, opts...).ToFunc()
+}
+
+// ByTare orders the results by the tare field.
+func ByTare(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTare, opts...).ToFunc()
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recipe
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLTE(FieldID, id))
+}
+
+// Userid applies equality check predicate on the "userid" field. It's identical to UseridEQ.
+func Userid(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldUserid, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldName, v))
+}
+
+// Cookedweight applies equality check predicate on the "cookedweight" field. It's identical to CookedweightEQ.
+func Cookedweight(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldCookedweight, v))
+}
+
+// Tare applies equality check predicate on the "tare" field. It's identical to TareEQ.
+func Tare(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldTare, v))
+}
+
+// UseridEQ applies the EQ predicate on the "userid" field.
+func UseridEQ(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldUserid, v))
+}
+
+// UseridNEQ applies the NEQ predicate on the "userid" field.
+func UseridNEQ(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNEQ(FieldUserid, v))
+}
+
+// UseridIn applies the In predicate on the "userid" field.
+func UseridIn(vs ...int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldIn(FieldUserid, vs...))
+}
+
+// UseridNotIn applies the NotIn predicate on the "userid" field.
+func UseridNotIn(vs ...int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNotIn(FieldUserid, vs...))
+}
+
+// UseridGT applies the GT predicate on the "userid" field.
+func UseridGT(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGT(FieldUserid, v))
+}
+
+// UseridGTE applies the GTE predicate on the "userid" field.
+func UseridGTE(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGTE(FieldUserid, v))
+}
+
+// UseridLT applies the LT predicate on the "userid" field.
+func UseridLT(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLT(FieldUserid, v))
+}
+
+// UseridLTE applies the LTE predicate on the "userid" field.
+func UseridLTE(v int64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLTE(FieldUserid, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldContainsFold(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Recipe {
+	return predicate.Recipe(sql.FieldContainsFold(FieldName, v))
+}
+
+// CookedweightEQ applies the EQ predicate on the "cookedweight" field.
+func CookedweightEQ(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldCookedweight, v))
+}
+
+// CookedweightNEQ applies the NEQ predicate on the "cookedweight" field.
+func CookedweightNEQ(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNEQ(FieldCookedweight, v))
+}
+
+// CookedweightIn applies the In predicate on the "cookedweight" field.
+func CookedweightIn(vs ...float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldIn(FieldCookedweight, vs...))
+}
+
+// CookedweightNotIn applies the NotIn predicate on the "cookedweight" field.
+func CookedweightNotIn(vs ...float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNotIn(FieldCookedweight, vs...))
+}
+
+// CookedweightGT applies the GT predicate on the "cookedweight" field.
+func CookedweightGT(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGT(FieldCookedweight, v))
+}
+
+// CookedweightGTE applies the GTE predicate on the "cookedweight" field.
+func CookedweightGTE(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGTE(FieldCookedweight, v))
+}
+
+// CookedweightLT applies the LT predicate on the "cookedweight" field.
+func CookedweightLT(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLT(FieldCookedweight, v))
+}
+
+// CookedweightLTE applies the LTE predicate on the "cookedweight" field.
+func CookedweightLTE(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLTE(FieldCookedweight, v))
+}
+
+// TareEQ applies the EQ predicate on the "tare" field.
+func TareEQ(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldEQ(FieldTare, v))
+}
+
+// TareNEQ applies the NEQ predicate on the "tare" field.
+func TareNEQ(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNEQ(FieldTare, v))
+}
+
+// TareIn applies the In predicate on the "tare" field.
+func TareIn(vs ...float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldIn(FieldTare, vs...))
+}
+
+// TareNotIn applies the NotIn predicate on the "tare" field.
+func TareNotIn(vs ...float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldNotIn(FieldTare, vs...))
+}
+
+// TareGT applies the GT predicate on the "tare" field.
+func TareGT(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGT(FieldTare, v))
+}
+
+// TareGTE applies the GTE predicate on the "tare" field.
+func TareGTE(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldGTE(FieldTare, v))
+}
+
+// TareLT applies the LT predicate on the "tare" field.
+func TareLT(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLT(FieldTare, v))
+}
+
+// TareLTE applies the LTE predicate on the "tare" field.
+func TareLTE(v float64) predicate.Recipe {
+	return predicate.Recipe(sql.FieldLTE(FieldTare, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Recipe) predicate.Recipe {
+	return predicate.Recipe(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Recipe) predicate.Recipe {
+	return predicate.Recipe(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Recipe) predicate.Recipe {
+	return predicate.Recipe(sql.NotPredicates(p))
+}
@@ -0,0 +1,797 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+)
+
+// RecipeCreate is the builder for creating a Recipe entity.
+type RecipeCreate struct {
+	config
+	mutation *RecipeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserid sets the "userid" field.
+func (rc *RecipeCreate) SetUserid(i int64) *RecipeCreate {
+	rc.mutation.SetUserid(i)
+	return rc
+}
+
+// SetKey sets the "key" field.
+func (rc *RecipeCreate) SetKey(s string) *RecipeCreate {
+	rc.mutation.SetKey(s)
+	return rc
+}
+
+// SetName sets the "name" field.
+func (rc *RecipeCreate) SetName(s string) *RecipeCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetIngredients sets the "ingredients" field.
+func (rc *RecipeCreate) SetIngredients(m map[string]float64) *RecipeCreate {
+	rc.mutation.SetIngredients(m)
+	return rc
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (rc *RecipeCreate) SetCookedweight(f float64) *RecipeCreate {
+	rc.mutation.SetCookedweight(f)
+	return rc
+}
+
+// SetTare sets the "tare" field.
+func (rc *RecipeCreate) SetTare(f float64) *RecipeCreate {
+	rc.mutation.SetTare(f)
+	return rc
+}
+
+// SetNillableTare sets the "tare" field if the given value is not nil.
+func (rc *RecipeCreate) SetNillableTare(f *float64) *RecipeCreate {
+	if f != nil {
+		rc.SetTare(*f)
+	}
+	return rc
+}
+
+// Mutation returns the RecipeMutation object of the builder.
+func (rc *RecipeCreate) Mutation() *RecipeMutation {
+	return rc.mutation
+}
+
+// Save creates the Recipe in the database.
+func (rc *RecipeCreate) Save(ctx context.Context) (*Recipe, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RecipeCreate) SaveX(ctx context.Context) *Recipe {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RecipeCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RecipeCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RecipeCreate) defaults() {
+	if _, ok := rc.mutation.Tare(); !ok {
+		v := recipe.DefaultTare
+		rc.mutation.SetTare(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RecipeCreate) check() error {
+	if _, ok := rc.mutation.Userid(); !ok {
+		return &ValidationError{Name: "userid", err: errors.New(`ent: missing required field "Recipe.userid"`)}
+	}
+	if _, ok := rc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Recipe.key"`)}
+	}
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Recipe.name"`)}
+	}
+	if _, ok := rc.mutation.Ingredients(); !ok {
+		return &ValidationError{Name: "ingredients", err: errors.New(`ent: missing required field "Recipe.ingredients"`)}
+	}
+	if _, ok := rc.mutation.Cookedweight(); !ok {
+		return &ValidationError{Name: "cookedweight", err: errors.New(`ent: missing required field "Recipe.cookedweight"`)}
+	}
+	if _, ok := rc.mutation.Tare(); !ok {
+		return &ValidationError{Name: "tare", err: errors.New(`ent: missing required field "Recipe.tare"`)}
+	}
+	return nil
+}
+
+func (rc *RecipeCreate) sqlSave(ctx context.Context) (*Recipe, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RecipeCreate) createSpec() (*Recipe, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Recipe{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(recipe.Table, sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rc.conflict
+	if value, ok := rc.mutation.Userid(); ok {
+		_spec.SetField(recipe.FieldUserid, field.TypeInt64, value)
+		_node.Userid = value
+	}
+	if value, ok := rc.mutation.Key(); ok {
+		_spec.SetField(recipe.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.SetField(recipe.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.Ingredients(); ok {
+		_spec.SetField(recipe.FieldIngredients, field.TypeJSON, value)
+		_node.Ingredients = value
+	}
+	if value, ok := rc.mutation.Cookedweight(); ok {
+		_spec.SetField(recipe.FieldCookedweight, field.TypeFloat64, value)
+		_node.Cookedweight = value
+	}
+	if value, ok := rc.mutation.Tare(); ok {
+		_spec.SetField(recipe.FieldTare, field.TypeFloat64, value)
+		_node.Tare = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Recipe.Create().
+//		SetUserid(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RecipeUpsert) {
+//			SetUserid(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *RecipeCreate) OnConflict(opts ...sql.ConflictOption) *RecipeUpsertOne {
+	rc.conflict = opts
+	return &RecipeUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Recipe.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *RecipeCreate) OnConflictColumns(columns ...string) *RecipeUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &RecipeUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// RecipeUpsertOne is the builder for "upsert"-ing
+	//  one Recipe node.
+	RecipeUpsertOne struct {
+		create *RecipeCreate
+	}
+
+	// RecipeUpsert is the "OnConflict" setter.
+	RecipeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserid sets the "userid" field.
+func (u *RecipeUpsert) SetUserid(v int64) *RecipeUpsert {
+	u.Set(recipe.FieldUserid, v)
+	return u
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *RecipeUpsert) UpdateUserid() *RecipeUpsert {
+	u.SetExcluded(recipe.FieldUserid)
+	return u
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *RecipeUpsert) AddUserid(v int64) *RecipeUpsert {
+	u.Add(recipe.FieldUserid, v)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *RecipeUpsert) SetKey(v string) *RecipeUpsert {
+	u.Set(recipe.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *RecipeUpsert) UpdateKey() *RecipeUpsert {
+	u.SetExcluded(recipe.FieldKey)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RecipeUpsert) SetName(v string) *RecipeUpsert {
+	u.Set(recipe.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RecipeUpsert) UpdateName() *RecipeUpsert {
+	u.SetExcluded(recipe.FieldName)
+	return u
+}
+
+// SetIngredients sets the "ingredients" field.
+func (u *RecipeUpsert) SetIngredients(v map[string]float64) *RecipeUpsert {
+	u.Set(recipe.FieldIngredients, v)
+	return u
+}
+
+// UpdateIngredients sets the "ingredients" field to the value that was provided on create.
+func (u *RecipeUpsert) UpdateIngredients() *RecipeUpsert {
+	u.SetExcluded(recipe.FieldIngredients)
+	return u
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (u *RecipeUpsert) SetCookedweight(v float64) *RecipeUpsert {
+	u.Set(recipe.FieldCookedweight, v)
+	return u
+}
+
+// UpdateCookedweight sets the "cookedweight" field to the value that was provided on create.
+func (u *RecipeUpsert) UpdateCookedweight() *RecipeUpsert {
+	u.SetExcluded(recipe.FieldCookedweight)
+	return u
+}
+
+// AddCookedweight adds v to the "cookedweight" field.
+func (u *RecipeUpsert) AddCookedweight(v float64) *RecipeUpsert {
+	u.Add(recipe.FieldCookedweight, v)
+	return u
+}
+
+// SetTare sets the "tare" field.
+func (u *RecipeUpsert) SetTare(v float64) *RecipeUpsert {
+	u.Set(recipe.FieldTare, v)
+	return u
+}
+
+// UpdateTare sets the "tare" field to the value that was provided on create.
+func (u *RecipeUpsert) UpdateTare() *RecipeUpsert {
+	u.SetExcluded(recipe.FieldTare)
+	return u
+}
+
+// AddTare adds v to the "tare" field.
+func (u *RecipeUpsert) AddTare(v float64) *RecipeUpsert {
+	u.Add(recipe.FieldTare, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Recipe.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RecipeUpsertOne) UpdateNewValues() *RecipeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Recipe.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RecipeUpsertOne) Ignore() *RecipeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RecipeUpsertOne) DoNothing() *RecipeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RecipeCreate.OnConflict
+// documentation for more info.
+func (u *RecipeUpsertOne) Update(set func(*RecipeUpsert)) *RecipeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RecipeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserid sets the "userid" field.
+func (u *RecipeUpsertOne) SetUserid(v int64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetUserid(v)
+	})
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *RecipeUpsertOne) AddUserid(v int64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.AddUserid(v)
+	})
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *RecipeUpsertOne) UpdateUserid() *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateUserid()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *RecipeUpsertOne) SetKey(v string) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *RecipeUpsertOne) UpdateKey() *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RecipeUpsertOne) SetName(v string) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RecipeUpsertOne) UpdateName() *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetIngredients sets the "ingredients" field.
+func (u *RecipeUpsertOne) SetIngredients(v map[string]float64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetIngredients(v)
+	})
+}
+
+// UpdateIngredients sets the "ingredients" field to the value that was provided on create.
+func (u *RecipeUpsertOne) UpdateIngredients() *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateIngredients()
+	})
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (u *RecipeUpsertOne) SetCookedweight(v float64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetCookedweight(v)
+	})
+}
+
+// AddCookedweight adds v to the "cookedweight" field.
+func (u *RecipeUpsertOne) AddCookedweight(v float64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.AddCookedweight(v)
+	})
+}
+
+// UpdateCookedweight sets the "cookedweight" field to the value that was provided on create.
+func (u *RecipeUpsertOne) UpdateCookedweight() *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateCookedweight()
+	})
+}
+
+// SetTare sets the "tare" field.
+func (u *RecipeUpsertOne) SetTare(v float64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetTare(v)
+	})
+}
+
+// AddTare adds v to the "tare" field.
+func (u *RecipeUpsertOne) AddTare(v float64) *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.AddTare(v)
+	})
+}
+
+// UpdateTare sets the "tare" field to the value that was provided on create.
+func (u *RecipeUpsertOne) UpdateTare() *RecipeUpsertOne {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateTare()
+	})
+}
+
+// Exec executes the query.
+func (u *RecipeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RecipeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RecipeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RecipeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RecipeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RecipeCreateBulk is the builder for creating many Recipe entities in bulk.
+type RecipeCreateBulk struct {
+	config
+	err      error
+	builders []*RecipeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Recipe entities in the database.
+func (rcb *RecipeCreateBulk) Save(ctx context.Context) ([]*Recipe, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Recipe, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecipeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RecipeCreateBulk) SaveX(ctx context.Context) []*Recipe {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RecipeCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RecipeCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Recipe.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RecipeUpsert) {
+//			SetUserid(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *RecipeCreateBulk) OnConflict(opts ...sql.ConflictOption) *RecipeUpsertBulk {
+	rcb.conflict = opts
+	return &RecipeUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Recipe.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *RecipeCreateBulk) OnConflictColumns(columns ...string) *RecipeUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &RecipeUpsertBulk{
+		create: rcb,
+	}
+}
+
+// RecipeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Recipe nodes.
+type RecipeUpsertBulk struct {
+	create *RecipeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Recipe.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RecipeUpsertBulk) UpdateNewValues() *RecipeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Recipe.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RecipeUpsertBulk) Ignore() *RecipeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RecipeUpsertBulk) DoNothing() *RecipeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RecipeCreateBulk.OnConflict
+// documentation for more info.
+func (u *RecipeUpsertBulk) Update(set func(*RecipeUpsert)) *RecipeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RecipeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserid sets the "userid" field.
+func (u *RecipeUpsertBulk) SetUserid(v int64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetUserid(v)
+	})
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *RecipeUpsertBulk) AddUserid(v int64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.AddUserid(v)
+	})
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *RecipeUpsertBulk) UpdateUserid() *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateUserid()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *RecipeUpsertBulk) SetKey(v string) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *RecipeUpsertBulk) UpdateKey() *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RecipeUpsertBulk) SetName(v string) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RecipeUpsertBulk) UpdateName() *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetIngredients sets the "ingredients" field.
+func (u *RecipeUpsertBulk) SetIngredients(v map[string]float64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetIngredients(v)
+	})
+}
+
+// UpdateIngredients sets the "ingredients" field to the value that was provided on create.
+func (u *RecipeUpsertBulk) UpdateIngredients() *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateIngredients()
+	})
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (u *RecipeUpsertBulk) SetCookedweight(v float64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetCookedweight(v)
+	})
+}
+
+// AddCookedweight adds v to the "cookedweight" field.
+func (u *RecipeUpsertBulk) AddCookedweight(v float64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.AddCookedweight(v)
+	})
+}
+
+// UpdateCookedweight sets the "cookedweight" field to the value that was provided on create.
+func (u *RecipeUpsertBulk) UpdateCookedweight() *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateCookedweight()
+	})
+}
+
+// SetTare sets the "tare" field.
+func (u *RecipeUpsertBulk) SetTare(v float64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.SetTare(v)
+	})
+}
+
+// AddTare adds v to the "tare" field.
+func (u *RecipeUpsertBulk) AddTare(v float64) *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.AddTare(v)
+	})
+}
+
+// UpdateTare sets the "tare" field to the value that was provided on create.
+func (u *RecipeUpsertBulk) UpdateTare() *RecipeUpsertBulk {
+	return u.Update(func(s *RecipeUpsert) {
+		s.UpdateTare()
+	})
+}
+
+// Exec executes the query.
+func (u *RecipeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RecipeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RecipeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RecipeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+)
+
+// RecipeUpdate is the builder for updating Recipe entities.
+type RecipeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RecipeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RecipeUpdate builder.
+func (ru *RecipeUpdate) Where(ps ...predicate.Recipe) *RecipeUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUserid sets the "userid" field.
+func (ru *RecipeUpdate) SetUserid(i int64) *RecipeUpdate {
+	ru.mutation.ResetUserid()
+	ru.mutation.SetUserid(i)
+	return ru
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableUserid(i *int64) *RecipeUpdate {
+	if i != nil {
+		ru.SetUserid(*i)
+	}
+	return ru
+}
+
+// AddUserid adds i to the "userid" field.
+func (ru *RecipeUpdate) AddUserid(i int64) *RecipeUpdate {
+	ru.mutation.AddUserid(i)
+	return ru
+}
+
+// SetKey sets the "key" field.
+func (ru *RecipeUpdate) SetKey(s string) *RecipeUpdate {
+	ru.mutation.SetKey(s)
+	return ru
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableKey(s *string) *RecipeUpdate {
+	if s != nil {
+		ru.SetKey(*s)
+	}
+	return ru
+}
+
+// SetName sets the "name" field.
+func (ru *RecipeUpdate) SetName(s string) *RecipeUpdate {
+	ru.mutation.SetName(s)
+	return ru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableName(s *string) *RecipeUpdate {
+	if s != nil {
+		ru.SetName(*s)
+	}
+	return ru
+}
+
+// SetIngredients sets the "ingredients" field.
+func (ru *RecipeUpdate) SetIngredients(m map[string]float64) *RecipeUpdate {
+	ru.mutation.SetIngredients(m)
+	return ru
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (ru *RecipeUpdate) SetCookedweight(f float64) *RecipeUpdate {
+	ru.mutation.ResetCookedweight()
+	ru.mutation.SetCookedweight(f)
+	return ru
+}
+
+// SetNillableCookedweight sets the "cookedweight" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableCookedweight(f *float64) *RecipeUpdate {
+	if f != nil {
+		ru.SetCookedweight(*f)
+	}
+	return ru
+}
+
+// AddCookedweight adds f to the "cookedweight" field.
+func (ru *RecipeUpdate) AddCookedweight(f float64) *RecipeUpdate {
+	ru.mutation.AddCookedweight(f)
+	return ru
+}
+
+// SetTare sets the "tare" field.
+func (ru *RecipeUpdate) SetTare(f float64) *RecipeUpdate {
+	ru.mutation.ResetTare()
+	ru.mutation.SetTare(f)
+	return ru
+}
+
+// SetNillableTare sets the "tare" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableTare(f *float64) *RecipeUpdate {
+	if f != nil {
+		ru.SetTare(*f)
+	}
+	return ru
+}
+
+// AddTare adds f to the "tare" field.
+func (ru *RecipeUpdate) AddTare(f float64) *RecipeUpdate {
+	ru.mutation.AddTare(f)
+	return ru
+}
+
+// Mutation returns the RecipeMutation object of the builder.
+func (ru *RecipeUpdate) Mutation() *RecipeMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RecipeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RecipeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RecipeUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RecipeUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *RecipeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecipeUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *RecipeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(recipe.Table, recipe.Columns, sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Userid(); ok {
+		_spec.SetField(recipe.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedUserid(); ok {
+		_spec.AddField(recipe.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.Key(); ok {
+		_spec.SetField(recipe.FieldKey, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Name(); ok {
+		_spec.SetField(recipe.FieldName, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Ingredients(); ok {
+		_spec.SetField(recipe.FieldIngredients, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.Cookedweight(); ok {
+		_spec.SetField(recipe.FieldCookedweight, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedCookedweight(); ok {
+		_spec.AddField(recipe.FieldCookedweight, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.Tare(); ok {
+		_spec.SetField(recipe.FieldTare, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedTare(); ok {
+		_spec.AddField(recipe.FieldTare, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RecipeUpdateOne is the builder for updating a single Recipe entity.
+type RecipeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RecipeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserid sets the "userid" field.
+func (ruo *RecipeUpdateOne) SetUserid(i int64) *RecipeUpdateOne {
+	ruo.mutation.ResetUserid()
+	ruo.mutation.SetUserid(i)
+	return ruo
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableUserid(i *int64) *RecipeUpdateOne {
+	if i != nil {
+		ruo.SetUserid(*i)
+	}
+	return ruo
+}
+
+// AddUserid adds i to the "userid" field.
+func (ruo *RecipeUpdateOne) AddUserid(i int64) *RecipeUpdateOne {
+	ruo.mutation.AddUserid(i)
+	return ruo
+}
+
+// SetKey sets the "key" field.
+func (ruo *RecipeUpdateOne) SetKey(s string) *RecipeUpdateOne {
+	ruo.mutation.SetKey(s)
+	return ruo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableKey(s *string) *RecipeUpdateOne {
+	if s != nil {
+		ruo.SetKey(*s)
+	}
+	return ruo
+}
+
+// SetName sets the "name" field.
+func (ruo *RecipeUpdateOne) SetName(s string) *RecipeUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableName(s *string) *RecipeUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetIngredients sets the "ingredients" field.
+func (ruo *RecipeUpdateOne) SetIngredients(m map[string]float64) *RecipeUpdateOne {
+	ruo.mutation.SetIngredients(m)
+	return ruo
+}
+
+// SetCookedweight sets the "cookedweight" field.
+func (ruo *RecipeUpdateOne) SetCookedweight(f float64) *RecipeUpdateOne {
+	ruo.mutation.ResetCookedweight()
+	ruo.mutation.SetCookedweight(f)
+	return ruo
+}
+
+// SetNillableCookedweight sets the "cookedweight" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableCookedweight(f *float64) *RecipeUpdateOne {
+	if f != nil {
+		ruo.SetCookedweight(*f)
+	}
+	return ruo
+}
+
+// AddCookedweight adds f to the "cookedweight" field.
+func (ruo *RecipeUpdateOne) AddCookedweight(f float64) *RecipeUpdateOne {
+	ruo.mutation.AddCookedweight(f)
+	return ruo
+}
+
+// SetTare sets the "tare" field.
+func (ruo *RecipeUpdateOne) SetTare(f float64) *RecipeUpdateOne {
+	ruo.mutation.ResetTare()
+	ruo.mutation.SetTare(f)
+	return ruo
+}
+
+// SetNillableTare sets the "tare" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableTare(f *float64) *RecipeUpdateOne {
+	if f != nil {
+		ruo.SetTare(*f)
+	}
+	return ruo
+}
+
+// AddTare adds f to the "tare" field.
+func (ruo *RecipeUpdateOne) AddTare(f float64) *RecipeUpdateOne {
+	ruo.mutation.AddTare(f)
+	return ruo
+}
+
+// Mutation returns the RecipeMutation object of the builder.
+func (ruo *RecipeUpdateOne) Mutation() *RecipeMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the RecipeUpdate builder.
+func (ruo *RecipeUpdateOne) Where(ps ...predicate.Recipe) *RecipeUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RecipeUpdateOne) Select(field string, fields ...string) *RecipeUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Recipe entity.
+func (ruo *RecipeUpdateOne) Save(ctx context.Context) (*Recipe, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RecipeUpdateOne) SaveX(ctx context.Context) *Recipe {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RecipeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RecipeUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *RecipeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecipeUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *RecipeUpdateOne) sqlSave(ctx context.Context) (_node *Recipe, err error) {
+	_spec := sqlgraph.NewUpdateSpec(recipe.Table, recipe.Columns, sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Recipe.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recipe.FieldID)
+		for _, f := range fields {
+			if !recipe.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recipe.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Userid(); ok {
+		_spec.SetField(recipe.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedUserid(); ok {
+		_spec.AddField(recipe.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.Key(); ok {
+		_spec.SetField(recipe.FieldKey, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Name(); ok {
+		_spec.SetField(recipe.FieldName, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Ingredients(); ok {
+		_spec.SetField(recipe.FieldIngredients, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.Cookedweight(); ok {
+		_spec.SetField(recipe.FieldCookedweight, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedCookedweight(); ok {
+		_spec.AddField(recipe.FieldCookedweight, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.Tare(); ok {
+		_spec.SetField(recipe.FieldTare, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedTare(); ok {
+		_spec.AddField(recipe.FieldTare, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Recipe{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -4,7 +4,10 @@ package ent
 
 import (
 	"github.com/devldavydov/myfood/internal/storage/ent/food"
+	"github.com/devldavydov/myfood/internal/storage/ent/journal"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
 	"github.com/devldavydov/myfood/internal/storage/ent/schema"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -17,4 +20,30 @@ func init() {
 	foodDescName := foodFields[1].Descriptor()
 	// food.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	food.NameValidator = foodDescName.Validators[0].(func(string) error)
+	// foodDescUserid is the schema descriptor for userid field.
+	foodDescUserid := foodFields[13].Descriptor()
+	// food.DefaultUserid holds the default value on creation for the userid field.
+	food.DefaultUserid = foodDescUserid.Default.(int64)
+	journalFields := schema.Journal{}.Fields()
+	_ = journalFields
+	// journalDescPortion is the schema descriptor for portion field.
+	journalDescPortion := journalFields[5].Descriptor()
+	// journal.DefaultPortion holds the default value on creation for the portion field.
+	journal.DefaultPortion = journalDescPortion.Default.(string)
+	// journalDescPortioncount is the schema descriptor for portioncount field.
+	journalDescPortioncount := journalFields[6].Descriptor()
+	// journal.DefaultPortioncount holds the default value on creation for the portioncount field.
+	journal.DefaultPortioncount = journalDescPortioncount.Default.(float64)
+	recipeFields := schema.Recipe{}.Fields()
+	_ = recipeFields
+	// recipeDescTare is the schema descriptor for tare field.
+	recipeDescTare := recipeFields[5].Descriptor()
+	// recipe.DefaultTare holds the default value on creation for the tare field.
+	recipe.DefaultTare = recipeDescTare.Default.(float64)
+	usermealFields := schema.UserMeal{}.Fields()
+	_ = usermealFields
+	// usermealDescName is the schema descriptor for name field.
+	usermealDescName := usermealFields[2].Descriptor()
+	// usermeal.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	usermeal.NameValidator = usermealDescName.Validators[0].(func(string) error)
 }
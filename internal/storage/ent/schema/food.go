@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Food holds the schema definition for the Food entity.
@@ -15,14 +16,23 @@ type Food struct {
 // Fields of the Food.
 func (Food) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("key").Unique(),
+		field.String("key"),
 		field.String("name").NotEmpty(),
 		field.String("brand").Optional(),
 		field.Float("cal100"),
 		field.Float("prot100"),
 		field.Float("fat100"),
 		field.Float("carb100"),
+		// Extended nutrients, nil if unknown.
+		field.Float("fiber100").Optional().Nillable(),
+		field.Float("sugar100").Optional().Nillable(),
+		field.Float("satfat100").Optional().Nillable(),
+		field.Float("salt100").Optional().Nillable(),
 		field.String("comment").Optional(),
+		// Map of portion name -> portion weight in grams.
+		field.JSON("portions", map[string]float64{}).Optional(),
+		// Owner user ID, 0 for global food.
+		field.Int64("userid").Default(0),
 	}
 }
 
@@ -34,3 +44,12 @@ func (Food) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Restrict)),
 	}
 }
+
+// Indexes of the Food
+func (Food) Indexes() []ent.Index {
+	return []ent.Index{
+		index.
+			Fields("key", "userid").
+			Unique(),
+	}
+}
@@ -19,6 +19,23 @@ func (Journal) Fields() []ent.Field {
 		field.Time("timestamp"),
 		field.Int64("meal"),
 		field.Float("foodweight"),
+		// Seconds since start of day, nil for entries logged before time of
+		// day was stored. Omitted time of new entry is current time.
+		field.Int64("daytime").Optional().Nillable(),
+		// Original quantity in food portions, empty portion if set in grams.
+		field.String("portion").Default(""),
+		field.Float("portioncount").Default(0),
+		// Food nutrition per 100 g at the moment of logging.
+		// Nil only for entries created before snapshot was added.
+		field.Float("cal100").Optional().Nillable(),
+		field.Float("prot100").Optional().Nillable(),
+		field.Float("fat100").Optional().Nillable(),
+		field.Float("carb100").Optional().Nillable(),
+		// Extended nutrients snapshot, nil if unknown for food.
+		field.Float("fiber100").Optional().Nillable(),
+		field.Float("sugar100").Optional().Nillable(),
+		field.Float("satfat100").Optional().Nillable(),
+		field.Float("salt100").Optional().Nillable(),
 	}
 }
 
@@ -37,7 +54,7 @@ func (Journal) Edges() []ent.Edge {
 func (Journal) Indexes() []ent.Index {
 	return []ent.Index{
 		index.
-			Fields("userid", "timestamp", "meal").
+			Fields("userid", "timestamp", "meal", "daytime").
 			Edges("food").
 			Unique(),
 	}
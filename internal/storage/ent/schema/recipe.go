@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Recipe holds the schema definition for the Recipe entity.
+type Recipe struct {
+	ent.Schema
+}
+
+// Fields of the Recipe.
+func (Recipe) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("userid"),
+		field.String("key"),
+		field.String("name"),
+		// Map of ingredient food key -> raw weight.
+		field.JSON("ingredients", map[string]float64{}),
+		field.Float("cookedweight"),
+		// Pot weight, included in cooked weight.
+		field.Float("tare").Default(0),
+	}
+}
+
+// Edges of the Recipe.
+func (Recipe) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Recipe
+func (Recipe) Indexes() []ent.Index {
+	return []ent.Index{
+		index.
+			Fields("userid", "key").
+			Unique(),
+	}
+}
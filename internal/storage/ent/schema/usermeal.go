@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserMeal holds the schema definition for the UserMeal entity.
+type UserMeal struct {
+	ent.Schema
+}
+
+// Fields of the UserMeal.
+func (UserMeal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("userid"),
+		// Meal number, also defines meal order.
+		field.Int64("meal"),
+		field.String("name").NotEmpty(),
+		field.JSON("aliases", []string{}),
+	}
+}
+
+// Edges of the UserMeal.
+func (UserMeal) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserMeal
+func (UserMeal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.
+			Fields("userid", "meal").
+			Unique(),
+	}
+}
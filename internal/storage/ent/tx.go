@@ -20,6 +20,10 @@ type Tx struct {
 	Food *FoodClient
 	// Journal is the client for interacting with the Journal builders.
 	Journal *JournalClient
+	// Recipe is the client for interacting with the Recipe builders.
+	Recipe *RecipeClient
+	// UserMeal is the client for interacting with the UserMeal builders.
+	UserMeal *UserMealClient
 	// UserSettings is the client for interacting with the UserSettings builders.
 	UserSettings *UserSettingsClient
 	// Weight is the client for interacting with the Weight builders.
@@ -159,6 +163,8 @@ func (tx *Tx) init() {
 	tx.Bundle = NewBundleClient(tx.config)
 	tx.Food = NewFoodClient(tx.config)
 	tx.Journal = NewJournalClient(tx.config)
+	tx.Recipe = NewRecipeClient(tx.config)
+	tx.UserMeal = NewUserMealClient(tx.config)
 	tx.UserSettings = NewUserSettingsClient(tx.config)
 	tx.Weight = NewWeightClient(tx.config)
 }
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
+)
+
+// UserMeal is the model entity for the UserMeal schema.
+type UserMeal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Userid holds the value of the "userid" field.
+	Userid int64 `json:"userid,omitempty"`
+	// Meal holds the value of the "meal" field.
+	Meal int64 `json:"meal,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Aliases holds the value of the "aliases" field.
+	Aliases      []string `json:"aliases,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserMeal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usermeal.FieldAliases:
+			values[i] = new([]byte)
+		case usermeal.FieldID, usermeal.FieldUserid, usermeal.FieldMeal:
+			values[i] = new(sql.NullInt64)
+		case usermeal.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserMeal fields.
+func (um *UserMeal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usermeal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			um.ID = int(value.Int64)
+		case usermeal.FieldUserid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userid", values[i])
+			} else if value.Valid {
+				um.Userid = value.Int64
+			}
+		case usermeal.FieldMeal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field meal", values[i])
+			} else if value.Valid {
+				um.Meal = value.Int64
+			}
+		case usermeal.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				um.Name = value.String
+			}
+		case usermeal.FieldAliases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &um.Aliases); err != nil {
+					return fmt.Errorf("unmarshal field aliases: %w", err)
+				}
+			}
+		default:
+			um.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserMeal.
+// This includes values selected through modifiers, order, etc.
+func (um *UserMeal) Value(name string) (ent.Value, error) {
+	return um.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserMeal.
+// Note that you need to call UserMeal.Unwrap() before calling this method if this UserMeal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (um *UserMeal) Update() *UserMealUpdateOne {
+	return NewUserMealClient(um.config).UpdateOne(um)
+}
+
+// Unwrap unwraps the UserMeal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (um *UserMeal) Unwrap() *UserMeal {
+	_tx, ok := um.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserMeal is not a transactional entity")
+	}
+	um.config.driver = _tx.drv
+	return um
+}
+
+// String implements the fmt.Stringer.
+func (um *UserMeal) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserMeal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", um.ID))
+	builder.WriteString("userid=")
+	builder.WriteString(fmt.Sprintf("%v", um.Userid))
+	builder.WriteString(", ")
+	builder.WriteString("meal=")
+	builder.WriteString(fmt.Sprintf("%v", um.Meal))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(um.Name)
+	builder.WriteString(", ")
+	builder.WriteString("aliases=")
+	builder.WriteString(fmt.Sprintf("%v", um.Aliases))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserMeals is a parsable slice of UserMeal.
+type UserMeals []*UserMeal
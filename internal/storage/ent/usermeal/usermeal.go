@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usermeal
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usermeal type in the database.
+	Label = "user_meal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserid holds the string denoting the userid field in the database.
+	FieldUserid = "userid"
+	// FieldMeal holds the string denoting the meal field in the database.
+	FieldMeal = "meal"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAliases holds the string denoting the aliases field in the database.
+	FieldAliases = "aliases"
+	// Table holds the table name of the usermeal in the database.
+	Table = "user_meals"
+)
+
+// Columns holds all SQL columns for usermeal fields.
+var Columns = []string{
+	FieldID,
+	FieldUserid,
+	FieldMeal,
+	FieldName,
+	FieldAliases,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the UserMeal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserid orders the results by the userid field.
+func ByUserid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserid, opts...).ToFunc()
+}
+
+// ByMeal orders the results by the meal field.
+func ByMeal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeal, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
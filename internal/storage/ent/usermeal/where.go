@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usermeal
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLTE(FieldID, id))
+}
+
+// Userid applies equality check predicate on the "userid" field. It's identical to UseridEQ.
+func Userid(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldUserid, v))
+}
+
+// Meal applies equality check predicate on the "meal" field. It's identical to MealEQ.
+func Meal(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldMeal, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldName, v))
+}
+
+// UseridEQ applies the EQ predicate on the "userid" field.
+func UseridEQ(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldUserid, v))
+}
+
+// UseridNEQ applies the NEQ predicate on the "userid" field.
+func UseridNEQ(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNEQ(FieldUserid, v))
+}
+
+// UseridIn applies the In predicate on the "userid" field.
+func UseridIn(vs ...int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldIn(FieldUserid, vs...))
+}
+
+// UseridNotIn applies the NotIn predicate on the "userid" field.
+func UseridNotIn(vs ...int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNotIn(FieldUserid, vs...))
+}
+
+// UseridGT applies the GT predicate on the "userid" field.
+func UseridGT(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGT(FieldUserid, v))
+}
+
+// UseridGTE applies the GTE predicate on the "userid" field.
+func UseridGTE(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGTE(FieldUserid, v))
+}
+
+// UseridLT applies the LT predicate on the "userid" field.
+func UseridLT(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLT(FieldUserid, v))
+}
+
+// UseridLTE applies the LTE predicate on the "userid" field.
+func UseridLTE(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLTE(FieldUserid, v))
+}
+
+// MealEQ applies the EQ predicate on the "meal" field.
+func MealEQ(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldMeal, v))
+}
+
+// MealNEQ applies the NEQ predicate on the "meal" field.
+func MealNEQ(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNEQ(FieldMeal, v))
+}
+
+// MealIn applies the In predicate on the "meal" field.
+func MealIn(vs ...int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldIn(FieldMeal, vs...))
+}
+
+// MealNotIn applies the NotIn predicate on the "meal" field.
+func MealNotIn(vs ...int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNotIn(FieldMeal, vs...))
+}
+
+// MealGT applies the GT predicate on the "meal" field.
+func MealGT(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGT(FieldMeal, v))
+}
+
+// MealGTE applies the GTE predicate on the "meal" field.
+func MealGTE(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGTE(FieldMeal, v))
+}
+
+// MealLT applies the LT predicate on the "meal" field.
+func MealLT(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLT(FieldMeal, v))
+}
+
+// MealLTE applies the LTE predicate on the "meal" field.
+func MealLTE(v int64) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLTE(FieldMeal, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.UserMeal {
+	return predicate.UserMeal(sql.FieldContainsFold(FieldName, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserMeal) predicate.UserMeal {
+	return predicate.UserMeal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserMeal) predicate.UserMeal {
+	return predicate.UserMeal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserMeal) predicate.UserMeal {
+	return predicate.UserMeal(sql.NotPredicates(p))
+}
@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
+)
+
+// UserMealCreate is the builder for creating a UserMeal entity.
+type UserMealCreate struct {
+	config
+	mutation *UserMealMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserid sets the "userid" field.
+func (umc *UserMealCreate) SetUserid(i int64) *UserMealCreate {
+	umc.mutation.SetUserid(i)
+	return umc
+}
+
+// SetMeal sets the "meal" field.
+func (umc *UserMealCreate) SetMeal(i int64) *UserMealCreate {
+	umc.mutation.SetMeal(i)
+	return umc
+}
+
+// SetName sets the "name" field.
+func (umc *UserMealCreate) SetName(s string) *UserMealCreate {
+	umc.mutation.SetName(s)
+	return umc
+}
+
+// SetAliases sets the "aliases" field.
+func (umc *UserMealCreate) SetAliases(s []string) *UserMealCreate {
+	umc.mutation.SetAliases(s)
+	return umc
+}
+
+// Mutation returns the UserMealMutation object of the builder.
+func (umc *UserMealCreate) Mutation() *UserMealMutation {
+	return umc.mutation
+}
+
+// Save creates the UserMeal in the database.
+func (umc *UserMealCreate) Save(ctx context.Context) (*UserMeal, error) {
+	return withHooks(ctx, umc.sqlSave, umc.mutation, umc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (umc *UserMealCreate) SaveX(ctx context.Context) *UserMeal {
+	v, err := umc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (umc *UserMealCreate) Exec(ctx context.Context) error {
+	_, err := umc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umc *UserMealCreate) ExecX(ctx context.Context) {
+	if err := umc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (umc *UserMealCreate) check() error {
+	if _, ok := umc.mutation.Userid(); !ok {
+		return &ValidationError{Name: "userid", err: errors.New(`ent: missing required field "UserMeal.userid"`)}
+	}
+	if _, ok := umc.mutation.Meal(); !ok {
+		return &ValidationError{Name: "meal", err: errors.New(`ent: missing required field "UserMeal.meal"`)}
+	}
+	if _, ok := umc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "UserMeal.name"`)}
+	}
+	if v, ok := umc.mutation.Name(); ok {
+		if err := usermeal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "UserMeal.name": %w`, err)}
+		}
+	}
+	if _, ok := umc.mutation.Aliases(); !ok {
+		return &ValidationError{Name: "aliases", err: errors.New(`ent: missing required field "UserMeal.aliases"`)}
+	}
+	return nil
+}
+
+func (umc *UserMealCreate) sqlSave(ctx context.Context) (*UserMeal, error) {
+	if err := umc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := umc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, umc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	umc.mutation.id = &_node.ID
+	umc.mutation.done = true
+	return _node, nil
+}
+
+func (umc *UserMealCreate) createSpec() (*UserMeal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserMeal{config: umc.config}
+		_spec = sqlgraph.NewCreateSpec(usermeal.Table, sqlgraph.NewFieldSpec(usermeal.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = umc.conflict
+	if value, ok := umc.mutation.Userid(); ok {
+		_spec.SetField(usermeal.FieldUserid, field.TypeInt64, value)
+		_node.Userid = value
+	}
+	if value, ok := umc.mutation.Meal(); ok {
+		_spec.SetField(usermeal.FieldMeal, field.TypeInt64, value)
+		_node.Meal = value
+	}
+	if value, ok := umc.mutation.Name(); ok {
+		_spec.SetField(usermeal.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := umc.mutation.Aliases(); ok {
+		_spec.SetField(usermeal.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserMeal.Create().
+//		SetUserid(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserMealUpsert) {
+//			SetUserid(v+v).
+//		}).
+//		Exec(ctx)
+func (umc *UserMealCreate) OnConflict(opts ...sql.ConflictOption) *UserMealUpsertOne {
+	umc.conflict = opts
+	return &UserMealUpsertOne{
+		create: umc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserMeal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (umc *UserMealCreate) OnConflictColumns(columns ...string) *UserMealUpsertOne {
+	umc.conflict = append(umc.conflict, sql.ConflictColumns(columns...))
+	return &UserMealUpsertOne{
+		create: umc,
+	}
+}
+
+type (
+	// UserMealUpsertOne is the builder for "upsert"-ing
+	//  one UserMeal node.
+	UserMealUpsertOne struct {
+		create *UserMealCreate
+	}
+
+	// UserMealUpsert is the "OnConflict" setter.
+	UserMealUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserid sets the "userid" field.
+func (u *UserMealUpsert) SetUserid(v int64) *UserMealUpsert {
+	u.Set(usermeal.FieldUserid, v)
+	return u
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *UserMealUpsert) UpdateUserid() *UserMealUpsert {
+	u.SetExcluded(usermeal.FieldUserid)
+	return u
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *UserMealUpsert) AddUserid(v int64) *UserMealUpsert {
+	u.Add(usermeal.FieldUserid, v)
+	return u
+}
+
+// SetMeal sets the "meal" field.
+func (u *UserMealUpsert) SetMeal(v int64) *UserMealUpsert {
+	u.Set(usermeal.FieldMeal, v)
+	return u
+}
+
+// UpdateMeal sets the "meal" field to the value that was provided on create.
+func (u *UserMealUpsert) UpdateMeal() *UserMealUpsert {
+	u.SetExcluded(usermeal.FieldMeal)
+	return u
+}
+
+// AddMeal adds v to the "meal" field.
+func (u *UserMealUpsert) AddMeal(v int64) *UserMealUpsert {
+	u.Add(usermeal.FieldMeal, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *UserMealUpsert) SetName(v string) *UserMealUpsert {
+	u.Set(usermeal.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserMealUpsert) UpdateName() *UserMealUpsert {
+	u.SetExcluded(usermeal.FieldName)
+	return u
+}
+
+// SetAliases sets the "aliases" field.
+func (u *UserMealUpsert) SetAliases(v []string) *UserMealUpsert {
+	u.Set(usermeal.FieldAliases, v)
+	return u
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *UserMealUpsert) UpdateAliases() *UserMealUpsert {
+	u.SetExcluded(usermeal.FieldAliases)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserMeal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserMealUpsertOne) UpdateNewValues() *UserMealUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserMeal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserMealUpsertOne) Ignore() *UserMealUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserMealUpsertOne) DoNothing() *UserMealUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserMealCreate.OnConflict
+// documentation for more info.
+func (u *UserMealUpsertOne) Update(set func(*UserMealUpsert)) *UserMealUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserMealUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserid sets the "userid" field.
+func (u *UserMealUpsertOne) SetUserid(v int64) *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetUserid(v)
+	})
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *UserMealUpsertOne) AddUserid(v int64) *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.AddUserid(v)
+	})
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *UserMealUpsertOne) UpdateUserid() *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateUserid()
+	})
+}
+
+// SetMeal sets the "meal" field.
+func (u *UserMealUpsertOne) SetMeal(v int64) *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetMeal(v)
+	})
+}
+
+// AddMeal adds v to the "meal" field.
+func (u *UserMealUpsertOne) AddMeal(v int64) *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.AddMeal(v)
+	})
+}
+
+// UpdateMeal sets the "meal" field to the value that was provided on create.
+func (u *UserMealUpsertOne) UpdateMeal() *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateMeal()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *UserMealUpsertOne) SetName(v string) *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserMealUpsertOne) UpdateName() *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *UserMealUpsertOne) SetAliases(v []string) *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *UserMealUpsertOne) UpdateAliases() *UserMealUpsertOne {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// Exec executes the query.
+func (u *UserMealUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserMealCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserMealUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserMealUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserMealUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserMealCreateBulk is the builder for creating many UserMeal entities in bulk.
+type UserMealCreateBulk struct {
+	config
+	err      error
+	builders []*UserMealCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserMeal entities in the database.
+func (umcb *UserMealCreateBulk) Save(ctx context.Context) ([]*UserMeal, error) {
+	if umcb.err != nil {
+		return nil, umcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(umcb.builders))
+	nodes := make([]*UserMeal, len(umcb.builders))
+	mutators := make([]Mutator, len(umcb.builders))
+	for i := range umcb.builders {
+		func(i int, root context.Context) {
+			builder := umcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserMealMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, umcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = umcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, umcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, umcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (umcb *UserMealCreateBulk) SaveX(ctx context.Context) []*UserMeal {
+	v, err := umcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (umcb *UserMealCreateBulk) Exec(ctx context.Context) error {
+	_, err := umcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umcb *UserMealCreateBulk) ExecX(ctx context.Context) {
+	if err := umcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserMeal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserMealUpsert) {
+//			SetUserid(v+v).
+//		}).
+//		Exec(ctx)
+func (umcb *UserMealCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserMealUpsertBulk {
+	umcb.conflict = opts
+	return &UserMealUpsertBulk{
+		create: umcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserMeal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (umcb *UserMealCreateBulk) OnConflictColumns(columns ...string) *UserMealUpsertBulk {
+	umcb.conflict = append(umcb.conflict, sql.ConflictColumns(columns...))
+	return &UserMealUpsertBulk{
+		create: umcb,
+	}
+}
+
+// UserMealUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserMeal nodes.
+type UserMealUpsertBulk struct {
+	create *UserMealCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserMeal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserMealUpsertBulk) UpdateNewValues() *UserMealUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserMeal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserMealUpsertBulk) Ignore() *UserMealUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserMealUpsertBulk) DoNothing() *UserMealUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserMealCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserMealUpsertBulk) Update(set func(*UserMealUpsert)) *UserMealUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserMealUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserid sets the "userid" field.
+func (u *UserMealUpsertBulk) SetUserid(v int64) *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetUserid(v)
+	})
+}
+
+// AddUserid adds v to the "userid" field.
+func (u *UserMealUpsertBulk) AddUserid(v int64) *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.AddUserid(v)
+	})
+}
+
+// UpdateUserid sets the "userid" field to the value that was provided on create.
+func (u *UserMealUpsertBulk) UpdateUserid() *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateUserid()
+	})
+}
+
+// SetMeal sets the "meal" field.
+func (u *UserMealUpsertBulk) SetMeal(v int64) *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetMeal(v)
+	})
+}
+
+// AddMeal adds v to the "meal" field.
+func (u *UserMealUpsertBulk) AddMeal(v int64) *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.AddMeal(v)
+	})
+}
+
+// UpdateMeal sets the "meal" field to the value that was provided on create.
+func (u *UserMealUpsertBulk) UpdateMeal() *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateMeal()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *UserMealUpsertBulk) SetName(v string) *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserMealUpsertBulk) UpdateName() *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *UserMealUpsertBulk) SetAliases(v []string) *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *UserMealUpsertBulk) UpdateAliases() *UserMealUpsertBulk {
+	return u.Update(func(s *UserMealUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// Exec executes the query.
+func (u *UserMealUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserMealCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserMealCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserMealUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
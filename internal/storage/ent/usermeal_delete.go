@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
+)
+
+// UserMealDelete is the builder for deleting a UserMeal entity.
+type UserMealDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserMealMutation
+}
+
+// Where appends a list predicates to the UserMealDelete builder.
+func (umd *UserMealDelete) Where(ps ...predicate.UserMeal) *UserMealDelete {
+	umd.mutation.Where(ps...)
+	return umd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (umd *UserMealDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, umd.sqlExec, umd.mutation, umd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umd *UserMealDelete) ExecX(ctx context.Context) int {
+	n, err := umd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (umd *UserMealDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usermeal.Table, sqlgraph.NewFieldSpec(usermeal.FieldID, field.TypeInt))
+	if ps := umd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, umd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	umd.mutation.done = true
+	return affected, err
+}
+
+// UserMealDeleteOne is the builder for deleting a single UserMeal entity.
+type UserMealDeleteOne struct {
+	umd *UserMealDelete
+}
+
+// Where appends a list predicates to the UserMealDelete builder.
+func (umdo *UserMealDeleteOne) Where(ps ...predicate.UserMeal) *UserMealDeleteOne {
+	umdo.umd.mutation.Where(ps...)
+	return umdo
+}
+
+// Exec executes the deletion query.
+func (umdo *UserMealDeleteOne) Exec(ctx context.Context) error {
+	n, err := umdo.umd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usermeal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umdo *UserMealDeleteOne) ExecX(ctx context.Context) {
+	if err := umdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
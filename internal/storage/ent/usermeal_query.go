@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
+)
+
+// UserMealQuery is the builder for querying UserMeal entities.
+type UserMealQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usermeal.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserMeal
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserMealQuery builder.
+func (umq *UserMealQuery) Where(ps ...predicate.UserMeal) *UserMealQuery {
+	umq.predicates = append(umq.predicates, ps...)
+	return umq
+}
+
+// Limit the number of records to be returned by this query.
+func (umq *UserMealQuery) Limit(limit int) *UserMealQuery {
+	umq.ctx.Limit = &limit
+	return umq
+}
+
+// Offset to start from.
+func (umq *UserMealQuery) Offset(offset int) *UserMealQuery {
+	umq.ctx.Offset = &offset
+	return umq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (umq *UserMealQuery) Unique(unique bool) *UserMealQuery {
+	umq.ctx.Unique = &unique
+	return umq
+}
+
+// Order specifies how the records should be ordered.
+func (umq *UserMealQuery) Order(o ...usermeal.OrderOption) *UserMealQuery {
+	umq.order = append(umq.order, o...)
+	return umq
+}
+
+// First returns the first UserMeal entity from the query.
+// Returns a *NotFoundError when no UserMeal was found.
+func (umq *UserMealQuery) First(ctx context.Context) (*UserMeal, error) {
+	nodes, err := umq.Limit(1).All(setContextOp(ctx, umq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usermeal.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (umq *UserMealQuery) FirstX(ctx context.Context) *UserMeal {
+	node, err := umq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserMeal ID from the query.
+// Returns a *NotFoundError when no UserMeal ID was found.
+func (umq *UserMealQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = umq.Limit(1).IDs(setContextOp(ctx, umq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usermeal.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (umq *UserMealQuery) FirstIDX(ctx context.Context) int {
+	id, err := umq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserMeal entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserMeal entity is found.
+// Returns a *NotFoundError when no UserMeal entities are found.
+func (umq *UserMealQuery) Only(ctx context.Context) (*UserMeal, error) {
+	nodes, err := umq.Limit(2).All(setContextOp(ctx, umq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usermeal.Label}
+	default:
+		return nil, &NotSingularError{usermeal.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (umq *UserMealQuery) OnlyX(ctx context.Context) *UserMeal {
+	node, err := umq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserMeal ID in the query.
+// Returns a *NotSingularError when more than one UserMeal ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (umq *UserMealQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = umq.Limit(2).IDs(setContextOp(ctx, umq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usermeal.Label}
+	default:
+		err = &NotSingularError{usermeal.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (umq *UserMealQuery) OnlyIDX(ctx context.Context) int {
+	id, err := umq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserMeals.
+func (umq *UserMealQuery) All(ctx context.Context) ([]*UserMeal, error) {
+	ctx = setContextOp(ctx, umq.ctx, "All")
+	if err := umq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserMeal, *UserMealQuery]()
+	return withInterceptors[[]*UserMeal](ctx, umq, qr, umq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (umq *UserMealQuery) AllX(ctx context.Context) []*UserMeal {
+	nodes, err := umq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserMeal IDs.
+func (umq *UserMealQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if umq.ctx.Unique == nil && umq.path != nil {
+		umq.Unique(true)
+	}
+	ctx = setContextOp(ctx, umq.ctx, "IDs")
+	if err = umq.Select(usermeal.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (umq *UserMealQuery) IDsX(ctx context.Context) []int {
+	ids, err := umq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (umq *UserMealQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, umq.ctx, "Count")
+	if err := umq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, umq, querierCount[*UserMealQuery](), umq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (umq *UserMealQuery) CountX(ctx context.Context) int {
+	count, err := umq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (umq *UserMealQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, umq.ctx, "Exist")
+	switch _, err := umq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (umq *UserMealQuery) ExistX(ctx context.Context) bool {
+	exist, err := umq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserMealQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (umq *UserMealQuery) Clone() *UserMealQuery {
+	if umq == nil {
+		return nil
+	}
+	return &UserMealQuery{
+		config:     umq.config,
+		ctx:        umq.ctx.Clone(),
+		order:      append([]usermeal.OrderOption{}, umq.order...),
+		inters:     append([]Interceptor{}, umq.inters...),
+		predicates: append([]predicate.UserMeal{}, umq.predicates...),
+		// clone intermediate query.
+		sql:  umq.sql.Clone(),
+		path: umq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Userid int64 `json:"userid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserMeal.Query().
+//		GroupBy(usermeal.FieldUserid).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (umq *UserMealQuery) GroupBy(field string, fields ...string) *UserMealGroupBy {
+	umq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserMealGroupBy{build: umq}
+	grbuild.flds = &umq.ctx.Fields
+	grbuild.label = usermeal.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Userid int64 `json:"userid,omitempty"`
+//	}
+//
+//	client.UserMeal.Query().
+//		Select(usermeal.FieldUserid).
+//		Scan(ctx, &v)
+func (umq *UserMealQuery) Select(fields ...string) *UserMealSelect {
+	umq.ctx.Fields = append(umq.ctx.Fields, fields...)
+	sbuild := &UserMealSelect{UserMealQuery: umq}
+	sbuild.label = usermeal.Label
+	sbuild.flds, sbuild.scan = &umq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserMealSelect configured with the given aggregations.
+func (umq *UserMealQuery) Aggregate(fns ...AggregateFunc) *UserMealSelect {
+	return umq.Select().Aggregate(fns...)
+}
+
+func (umq *UserMealQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range umq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, umq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range umq.ctx.Fields {
+		if !usermeal.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if umq.path != nil {
+		prev, err := umq.path(ctx)
+		if err != nil {
+			return err
+		}
+		umq.sql = prev
+	}
+	return nil
+}
+
+func (umq *UserMealQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserMeal, error) {
+	var (
+		nodes = []*UserMeal{}
+		_spec = umq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserMeal).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserMeal{config: umq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(umq.modifiers) > 0 {
+		_spec.Modifiers = umq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, umq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (umq *UserMealQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := umq.querySpec()
+	if len(umq.modifiers) > 0 {
+		_spec.Modifiers = umq.modifiers
+	}
+	_spec.Node.Columns = umq.ctx.Fields
+	if len(umq.ctx.Fields) > 0 {
+		_spec.Unique = umq.ctx.Unique != nil && *umq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, umq.driver, _spec)
+}
+
+func (umq *UserMealQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usermeal.Table, usermeal.Columns, sqlgraph.NewFieldSpec(usermeal.FieldID, field.TypeInt))
+	_spec.From = umq.sql
+	if unique := umq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if umq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := umq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usermeal.FieldID)
+		for i := range fields {
+			if fields[i] != usermeal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := umq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := umq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := umq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := umq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (umq *UserMealQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(umq.driver.Dialect())
+	t1 := builder.Table(usermeal.Table)
+	columns := umq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usermeal.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if umq.sql != nil {
+		selector = umq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if umq.ctx.Unique != nil && *umq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range umq.modifiers {
+		m(selector)
+	}
+	for _, p := range umq.predicates {
+		p(selector)
+	}
+	for _, p := range umq.order {
+		p(selector)
+	}
+	if offset := umq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := umq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (umq *UserMealQuery) Modify(modifiers ...func(s *sql.Selector)) *UserMealSelect {
+	umq.modifiers = append(umq.modifiers, modifiers...)
+	return umq.Select()
+}
+
+// UserMealGroupBy is the group-by builder for UserMeal entities.
+type UserMealGroupBy struct {
+	selector
+	build *UserMealQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (umgb *UserMealGroupBy) Aggregate(fns ...AggregateFunc) *UserMealGroupBy {
+	umgb.fns = append(umgb.fns, fns...)
+	return umgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (umgb *UserMealGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, umgb.build.ctx, "GroupBy")
+	if err := umgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserMealQuery, *UserMealGroupBy](ctx, umgb.build, umgb, umgb.build.inters, v)
+}
+
+func (umgb *UserMealGroupBy) sqlScan(ctx context.Context, root *UserMealQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(umgb.fns))
+	for _, fn := range umgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*umgb.flds)+len(umgb.fns))
+		for _, f := range *umgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*umgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := umgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserMealSelect is the builder for selecting fields of UserMeal entities.
+type UserMealSelect struct {
+	*UserMealQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ums *UserMealSelect) Aggregate(fns ...AggregateFunc) *UserMealSelect {
+	ums.fns = append(ums.fns, fns...)
+	return ums
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ums *UserMealSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ums.ctx, "Select")
+	if err := ums.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserMealQuery, *UserMealSelect](ctx, ums.UserMealQuery, ums, ums.inters, v)
+}
+
+func (ums *UserMealSelect) sqlScan(ctx context.Context, root *UserMealQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ums.fns))
+	for _, fn := range ums.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ums.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ums.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ums *UserMealSelect) Modify(modifiers ...func(s *sql.Selector)) *UserMealSelect {
+	ums.modifiers = append(ums.modifiers, modifiers...)
+	return ums
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
+)
+
+// UserMealUpdate is the builder for updating UserMeal entities.
+type UserMealUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserMealMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserMealUpdate builder.
+func (umu *UserMealUpdate) Where(ps ...predicate.UserMeal) *UserMealUpdate {
+	umu.mutation.Where(ps...)
+	return umu
+}
+
+// SetUserid sets the "userid" field.
+func (umu *UserMealUpdate) SetUserid(i int64) *UserMealUpdate {
+	umu.mutation.ResetUserid()
+	umu.mutation.SetUserid(i)
+	return umu
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (umu *UserMealUpdate) SetNillableUserid(i *int64) *UserMealUpdate {
+	if i != nil {
+		umu.SetUserid(*i)
+	}
+	return umu
+}
+
+// AddUserid adds i to the "userid" field.
+func (umu *UserMealUpdate) AddUserid(i int64) *UserMealUpdate {
+	umu.mutation.AddUserid(i)
+	return umu
+}
+
+// SetMeal sets the "meal" field.
+func (umu *UserMealUpdate) SetMeal(i int64) *UserMealUpdate {
+	umu.mutation.ResetMeal()
+	umu.mutation.SetMeal(i)
+	return umu
+}
+
+// SetNillableMeal sets the "meal" field if the given value is not nil.
+func (umu *UserMealUpdate) SetNillableMeal(i *int64) *UserMealUpdate {
+	if i != nil {
+		umu.SetMeal(*i)
+	}
+	return umu
+}
+
+// AddMeal adds i to the "meal" field.
+func (umu *UserMealUpdate) AddMeal(i int64) *UserMealUpdate {
+	umu.mutation.AddMeal(i)
+	return umu
+}
+
+// SetName sets the "name" field.
+func (umu *UserMealUpdate) SetName(s string) *UserMealUpdate {
+	umu.mutation.SetName(s)
+	return umu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (umu *UserMealUpdate) SetNillableName(s *string) *UserMealUpdate {
+	if s != nil {
+		umu.SetName(*s)
+	}
+	return umu
+}
+
+// SetAliases sets the "aliases" field.
+func (umu *UserMealUpdate) SetAliases(s []string) *UserMealUpdate {
+	umu.mutation.SetAliases(s)
+	return umu
+}
+
+// AppendAliases appends s to the "aliases" field.
+func (umu *UserMealUpdate) AppendAliases(s []string) *UserMealUpdate {
+	umu.mutation.AppendAliases(s)
+	return umu
+}
+
+// Mutation returns the UserMealMutation object of the builder.
+func (umu *UserMealUpdate) Mutation() *UserMealMutation {
+	return umu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (umu *UserMealUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, umu.sqlSave, umu.mutation, umu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (umu *UserMealUpdate) SaveX(ctx context.Context) int {
+	affected, err := umu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (umu *UserMealUpdate) Exec(ctx context.Context) error {
+	_, err := umu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umu *UserMealUpdate) ExecX(ctx context.Context) {
+	if err := umu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (umu *UserMealUpdate) check() error {
+	if v, ok := umu.mutation.Name(); ok {
+		if err := usermeal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "UserMeal.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (umu *UserMealUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserMealUpdate {
+	umu.modifiers = append(umu.modifiers, modifiers...)
+	return umu
+}
+
+func (umu *UserMealUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := umu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usermeal.Table, usermeal.Columns, sqlgraph.NewFieldSpec(usermeal.FieldID, field.TypeInt))
+	if ps := umu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := umu.mutation.Userid(); ok {
+		_spec.SetField(usermeal.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := umu.mutation.AddedUserid(); ok {
+		_spec.AddField(usermeal.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := umu.mutation.Meal(); ok {
+		_spec.SetField(usermeal.FieldMeal, field.TypeInt64, value)
+	}
+	if value, ok := umu.mutation.AddedMeal(); ok {
+		_spec.AddField(usermeal.FieldMeal, field.TypeInt64, value)
+	}
+	if value, ok := umu.mutation.Name(); ok {
+		_spec.SetField(usermeal.FieldName, field.TypeString, value)
+	}
+	if value, ok := umu.mutation.Aliases(); ok {
+		_spec.SetField(usermeal.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := umu.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usermeal.FieldAliases, value)
+		})
+	}
+	_spec.AddModifiers(umu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, umu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usermeal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	umu.mutation.done = true
+	return n, nil
+}
+
+// UserMealUpdateOne is the builder for updating a single UserMeal entity.
+type UserMealUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMealMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserid sets the "userid" field.
+func (umuo *UserMealUpdateOne) SetUserid(i int64) *UserMealUpdateOne {
+	umuo.mutation.ResetUserid()
+	umuo.mutation.SetUserid(i)
+	return umuo
+}
+
+// SetNillableUserid sets the "userid" field if the given value is not nil.
+func (umuo *UserMealUpdateOne) SetNillableUserid(i *int64) *UserMealUpdateOne {
+	if i != nil {
+		umuo.SetUserid(*i)
+	}
+	return umuo
+}
+
+// AddUserid adds i to the "userid" field.
+func (umuo *UserMealUpdateOne) AddUserid(i int64) *UserMealUpdateOne {
+	umuo.mutation.AddUserid(i)
+	return umuo
+}
+
+// SetMeal sets the "meal" field.
+func (umuo *UserMealUpdateOne) SetMeal(i int64) *UserMealUpdateOne {
+	umuo.mutation.ResetMeal()
+	umuo.mutation.SetMeal(i)
+	return umuo
+}
+
+// SetNillableMeal sets the "meal" field if the given value is not nil.
+func (umuo *UserMealUpdateOne) SetNillableMeal(i *int64) *UserMealUpdateOne {
+	if i != nil {
+		umuo.SetMeal(*i)
+	}
+	return umuo
+}
+
+// AddMeal adds i to the "meal" field.
+func (umuo *UserMealUpdateOne) AddMeal(i int64) *UserMealUpdateOne {
+	umuo.mutation.AddMeal(i)
+	return umuo
+}
+
+// SetName sets the "name" field.
+func (umuo *UserMealUpdateOne) SetName(s string) *UserMealUpdateOne {
+	umuo.mutation.SetName(s)
+	return umuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (umuo *UserMealUpdateOne) SetNillableName(s *string) *UserMealUpdateOne {
+	if s != nil {
+		umuo.SetName(*s)
+	}
+	return umuo
+}
+
+// SetAliases sets the "aliases" field.
+func (umuo *UserMealUpdateOne) SetAliases(s []string) *UserMealUpdateOne {
+	umuo.mutation.SetAliases(s)
+	return umuo
+}
+
+// AppendAliases appends s to the "aliases" field.
+func (umuo *UserMealUpdateOne) AppendAliases(s []string) *UserMealUpdateOne {
+	umuo.mutation.AppendAliases(s)
+	return umuo
+}
+
+// Mutation returns the UserMealMutation object of the builder.
+func (umuo *UserMealUpdateOne) Mutation() *UserMealMutation {
+	return umuo.mutation
+}
+
+// Where appends a list predicates to the UserMealUpdate builder.
+func (umuo *UserMealUpdateOne) Where(ps ...predicate.UserMeal) *UserMealUpdateOne {
+	umuo.mutation.Where(ps...)
+	return umuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (umuo *UserMealUpdateOne) Select(field string, fields ...string) *UserMealUpdateOne {
+	umuo.fields = append([]string{field}, fields...)
+	return umuo
+}
+
+// Save executes the query and returns the updated UserMeal entity.
+func (umuo *UserMealUpdateOne) Save(ctx context.Context) (*UserMeal, error) {
+	return withHooks(ctx, umuo.sqlSave, umuo.mutation, umuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (umuo *UserMealUpdateOne) SaveX(ctx context.Context) *UserMeal {
+	node, err := umuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (umuo *UserMealUpdateOne) Exec(ctx context.Context) error {
+	_, err := umuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (umuo *UserMealUpdateOne) ExecX(ctx context.Context) {
+	if err := umuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (umuo *UserMealUpdateOne) check() error {
+	if v, ok := umuo.mutation.Name(); ok {
+		if err := usermeal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "UserMeal.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (umuo *UserMealUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserMealUpdateOne {
+	umuo.modifiers = append(umuo.modifiers, modifiers...)
+	return umuo
+}
+
+func (umuo *UserMealUpdateOne) sqlSave(ctx context.Context) (_node *UserMeal, err error) {
+	if err := umuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usermeal.Table, usermeal.Columns, sqlgraph.NewFieldSpec(usermeal.FieldID, field.TypeInt))
+	id, ok := umuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserMeal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := umuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usermeal.FieldID)
+		for _, f := range fields {
+			if !usermeal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usermeal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := umuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := umuo.mutation.Userid(); ok {
+		_spec.SetField(usermeal.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := umuo.mutation.AddedUserid(); ok {
+		_spec.AddField(usermeal.FieldUserid, field.TypeInt64, value)
+	}
+	if value, ok := umuo.mutation.Meal(); ok {
+		_spec.SetField(usermeal.FieldMeal, field.TypeInt64, value)
+	}
+	if value, ok := umuo.mutation.AddedMeal(); ok {
+		_spec.AddField(usermeal.FieldMeal, field.TypeInt64, value)
+	}
+	if value, ok := umuo.mutation.Name(); ok {
+		_spec.SetField(usermeal.FieldName, field.TypeString, value)
+	}
+	if value, ok := umuo.mutation.Aliases(); ok {
+		_spec.SetField(usermeal.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := umuo.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usermeal.FieldAliases, value)
+		})
+	}
+	_spec.AddModifiers(umuo.modifiers...)
+	_node = &UserMeal{config: umuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, umuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usermeal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	umuo.mutation.done = true
+	return _node, nil
+}
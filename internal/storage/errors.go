@@ -4,10 +4,14 @@ import "errors"
 
 var (
 	// Food
-	ErrFoodNotFound  = errors.New("food not found")
-	ErrFoodInvalid   = errors.New("invalid food")
-	ErrFoodEmptyList = errors.New("empty food list")
-	ErrFoodIsUsed    = errors.New("food is used")
+	ErrFoodNotFound        = errors.New("food not found")
+	ErrFoodInvalid         = errors.New("invalid food")
+	ErrFoodEmptyList       = errors.New("empty food list")
+	ErrFoodIsUsed          = errors.New("food is used")
+	ErrFoodExists          = errors.New("food already exists")
+	ErrFoodMerge           = errors.New("invalid food merge")
+	ErrFoodIsRecipe        = errors.New("food is recipe")
+	ErrFoodPortionNotFound = errors.New("food portion not found")
 
 	// Bundle
 	ErrBundleInvalid           = errors.New("invalid bundle")
@@ -17,6 +21,14 @@ var (
 	ErrBundleDepFoodNotFound   = errors.New("dependent food not found")
 	ErrBundleDepRecursive      = errors.New("dependent recursive bundle not allowed")
 	ErrBundleIsUsed            = errors.New("bundle is used")
+	ErrBundleExists            = errors.New("bundle already exists")
+
+	// Recipe
+	ErrRecipeInvalid             = errors.New("invalid recipe")
+	ErrRecipeNotFound            = errors.New("recipe not found")
+	ErrRecipeEmptyList           = errors.New("empty recipe list")
+	ErrRecipeIngredientNotFound  = errors.New("recipe ingredient not found")
+	ErrRecipeIngredientRecursive = errors.New("recursive recipe ingredient not allowed")
 
 	// Journal
 	ErrJournalInvalid         = errors.New("journal invalid")
@@ -39,4 +51,14 @@ var (
 	ErrActivityNotFound  = errors.New("activity not found")
 	ErrActivityInvalid   = errors.New("activity invalid")
 	ErrActivityEmptyList = errors.New("activity empty list")
+
+	// UserMeal
+	ErrUserMealInvalid  = errors.New("invalid user meal")
+	ErrUserMealNotFound = errors.New("user meal not found")
+	ErrUserMealConflict = errors.New("user meal name conflict")
+	ErrUserMealIsUsed   = errors.New("user meal is used")
+	ErrUserMealIsLast   = errors.New("user meal is last")
+
+	// Backup
+	ErrBackupInvalid = errors.New("invalid backup")
 )
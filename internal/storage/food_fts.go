@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/devldavydov/myfood/internal/storage/ent"
+	"github.com/devldavydov/myfood/internal/storage/ent/food"
+)
+
+// Food full-text index is external content FTS5 table over foods, it is kept
+// in sync by triggers. FTS5 is available only if sqlite3 is built with
+// sqlite_fts5 tag, otherwise food search falls back to substring match.
+var _foodFTSCreate = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS foods_fts USING fts5(
+		key, name, brand, comment,
+		content='foods', content_rowid='id',
+		tokenize='unicode61 remove_diacritics 2'
+	)`,
+	`CREATE TRIGGER IF NOT EXISTS foods_fts_ai AFTER INSERT ON foods BEGIN
+		INSERT INTO foods_fts(rowid, key, name, brand, comment)
+		VALUES (new.id, new.key, new.name, new.brand, new.comment);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS foods_fts_ad AFTER DELETE ON foods BEGIN
+		INSERT INTO foods_fts(foods_fts, rowid, key, name, brand, comment)
+		VALUES ('delete', old.id, old.key, old.name, old.brand, old.comment);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS foods_fts_au AFTER UPDATE ON foods BEGIN
+		INSERT INTO foods_fts(foods_fts, rowid, key, name, brand, comment)
+		VALUES ('delete', old.id, old.key, old.name, old.brand, old.comment);
+		INSERT INTO foods_fts(rowid, key, name, brand, comment)
+		VALUES (new.id, new.key, new.name, new.brand, new.comment);
+	END`,
+	// Rank is bm25 with weights of key, name, brand and comment columns.
+	`INSERT INTO foods_fts(foods_fts, rank) VALUES ('rank', 'bm25(10.0, 5.0, 2.0, 1.0)')`,
+}
+
+// Index is filled from foods when it is created or triggers were removed by
+// build without FTS5, otherwise triggers keep it in sync.
+const _foodFTSRebuild = `INSERT INTO foods_fts(foods_fts) VALUES ('rebuild')`
+
+// Without FTS5 triggers must be removed, otherwise any food change fails.
+var _foodFTSDrop = []string{
+	`DROP TRIGGER IF EXISTS foods_fts_ai`,
+	`DROP TRIGGER IF EXISTS foods_fts_ad`,
+	`DROP TRIGGER IF EXISTS foods_fts_au`,
+}
+
+func (r *StorageSQLite) initFoodFTS(ctx context.Context, db *sql.DB) error {
+	var enabled bool
+	if err := db.QueryRowContext(ctx, "SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled); err != nil {
+		return err
+	}
+
+	stmts := _foodFTSDrop
+	if enabled {
+		stmts = _foodFTSCreate
+
+		var synced bool
+		if err := db.QueryRowContext(ctx,
+			"SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'trigger' AND name = 'foods_fts_ai')",
+		).Scan(&synced); err != nil {
+			return err
+		}
+		if !synced {
+			stmts = append(stmts[:len(stmts):len(stmts)], _foodFTSRebuild)
+		}
+	}
+
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("food fts init error: %w", err)
+		}
+	}
+
+	r.foodFTS = enabled
+	return nil
+}
+
+// foodSearchWords splits search pattern to words, words without letters and
+// digits are skipped.
+func foodSearchWords(pattern string) []string {
+	var words []string
+	for _, w := range strings.Fields(pattern) {
+		if strings.IndexFunc(w, func(c rune) bool { return unicode.IsLetter(c) || unicode.IsDigit(c) }) >= 0 {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// foodFTSQuery returns FTS5 query where every word is prefix phrase,
+// all words are required.
+func foodFTSQuery(words []string) string {
+	phrases := make([]string, 0, len(words))
+	for _, w := range words {
+		phrases = append(phrases, `"`+strings.ReplaceAll(w, `"`, `""`)+`"*`)
+	}
+	return strings.Join(phrases, " ")
+}
+
+const (
+	_foodFTSTable = "foods_fts"
+	_foodFTSAlias = "fts"
+)
+
+// foodFTSMatch is predicate of foods matched by FTS query, FTS table is joined
+// to order foods by relevance with foodFTSOrder.
+func foodFTSMatch(query string) func(s *entsql.Selector) {
+	return func(s *entsql.Selector) {
+		t := entsql.Table(_foodFTSTable).As(_foodFTSAlias)
+		s.Join(t).On(s.C(food.FieldID), t.C("rowid"))
+		s.Where(entsql.ExprP(t.C(_foodFTSTable)+" MATCH ?", query))
+	}
+}
+
+// foodFTSOrder orders foods matched by foodFTSMatch by relevance.
+func foodFTSOrder(s *entsql.Selector) {
+	s.OrderBy(entsql.Table(_foodFTSAlias).C("rank"))
+}
+
+// foodNotShadowed is predicate of foods not shadowed by user private food with same key.
+func foodNotShadowed(userID int64) func(s *entsql.Selector) {
+	return func(s *entsql.Selector) {
+		if userID == 0 {
+			return
+		}
+		t := entsql.Table(food.Table).As("shadow")
+		s.Where(entsql.Or(
+			entsql.NEQ(s.C(food.FieldUserid), 0),
+			entsql.NotIn(
+				s.C(food.FieldKey),
+				entsql.Select(t.C(food.FieldKey)).From(t).Where(entsql.EQ(t.C(food.FieldUserid), userID)),
+			),
+		))
+	}
+}
+
+// foodLikeMatch is predicate of foods where every word is substring of key,
+// name, brand or comment, case insensitive.
+func foodLikeMatch(words []string) func(s *entsql.Selector) {
+	return func(s *entsql.Selector) {
+		for _, w := range words {
+			upWord := strings.ToUpper(w)
+			preds := make([]*entsql.Predicate, 0, 4)
+			for _, col := range []string{food.FieldKey, food.FieldName, food.FieldBrand, food.FieldComment} {
+				preds = append(preds, entsql.ExprP(
+					fmt.Sprintf("go_upper(%s) LIKE '%%' || ? || '%%'", s.C(col)),
+					upWord,
+				))
+			}
+			s.Where(entsql.Or(preds...))
+		}
+	}
+}
+
+// pageFoods sets limit and offset of food query, zero limit means no limit.
+func pageFoods(q *ent.FoodQuery, limit, offset int) *ent.FoodQuery {
+	if limit > 0 {
+		q.Limit(limit)
+	}
+	return q.Offset(offset)
+}
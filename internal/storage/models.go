@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -13,7 +15,18 @@ type Food struct {
 	Prot100 float64
 	Fat100  float64
 	Carb100 float64
-	Comment string
+	// Extended nutrients per 100 g, nil if unknown.
+	Fiber100  *float64
+	Sugar100  *float64
+	SatFat100 *float64
+	Salt100   *float64
+	Comment   string
+	// Map of portion name -> portion weight in grams,
+	// nil keeps existing portions on update.
+	Portions map[string]float64
+	// Private food is visible only to owner user
+	// and shadows global food with same key.
+	Private bool
 }
 
 func (r *Food) Validate() bool {
@@ -22,60 +35,148 @@ func (r *Food) Validate() bool {
 		r.Cal100 >= 0 &&
 		r.Prot100 >= 0 &&
 		r.Fat100 >= 0 &&
-		r.Carb100 >= 0
+		r.Carb100 >= 0 &&
+		validOptNutrient(r.Fiber100) &&
+		validOptNutrient(r.Sugar100) &&
+		validOptNutrient(r.SatFat100) &&
+		validOptNutrient(r.Salt100) &&
+		validPortions(r.Portions)
+}
+
+func validPortions(portions map[string]float64) bool {
+	for k, v := range portions {
+		if k == "" || v <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func validOptNutrient(v *float64) bool {
+	return v == nil || *v >= 0
 }
 
 type Meal int64
 
-func NewMealFromString(m string) Meal {
-	switch strings.ToUpper(m) {
-	case "ЗАВТРАК":
-		return Meal(0)
-	case "ДО ОБЕДА":
-		return Meal(1)
-	case "ОБЕД":
-		return Meal(2)
-	case "ПОЛДНИК":
-		return Meal(3)
-	case "ДО УЖИНА":
-		return Meal(4)
-	case "УЖИН":
-		return Meal(5)
-	}
-	return Meal(6)
-}
-
-func (r Meal) ToString() string {
-	switch r {
-	case 0:
-		return "Завтрак"
-	case 1:
-		return "До обеда"
-	case 2:
-		return "Обед"
-	case 3:
-		return "Полдник"
-	case 4:
-		return "До ужина"
-	case 5:
-		return "Ужин"
-	}
-	return "Перекус"
+type UserMeal struct {
+	Meal    Meal
+	Name    string
+	Aliases []string
+}
+
+func (r *UserMeal) Validate() bool {
+	if r.Meal < 0 || r.Name == "" {
+		return false
+	}
+
+	for _, a := range r.Aliases {
+		if a == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// names returns upper case meal name and aliases.
+func (r *UserMeal) names() []string {
+	names := make([]string, 0, len(r.Aliases)+1)
+	names = append(names, strings.ToUpper(r.Name))
+	for _, a := range r.Aliases {
+		names = append(names, strings.ToUpper(a))
+	}
+	return names
+}
+
+// UserMeals is user meals list, ordered by meal.
+type UserMeals []UserMeal
+
+// DefaultUserMeals returns meals for user without own meals.
+func DefaultUserMeals() UserMeals {
+	return UserMeals{
+		{Meal: 0, Name: "Завтрак"},
+		{Meal: 1, Name: "До обеда"},
+		{Meal: 2, Name: "Обед"},
+		{Meal: 3, Name: "Полдник"},
+		{Meal: 4, Name: "До ужина"},
+		{Meal: 5, Name: "Ужин"},
+		{Meal: 6, Name: "Перекус"},
+	}
 }
 
+// Resolve returns meal by name or alias, case insensitive.
+func (r UserMeals) Resolve(m string) (Meal, bool) {
+	m = strings.ToUpper(m)
+	for _, um := range r {
+		for _, name := range um.names() {
+			if name == m {
+				return um.Meal, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// Has reports whether meal is one of user meals.
+func (r UserMeals) Has(m Meal) bool {
+	for _, um := range r {
+		if um.Meal == m {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Name returns meal name or meal number for unknown meal.
+func (r UserMeals) Name(m Meal) string {
+	for _, um := range r {
+		if um.Meal == m {
+			return um.Name
+		}
+	}
+
+	return strconv.FormatInt(int64(m), 10)
+}
+
+// MaxDayTime is exclusive upper bound of journal entry time of day.
+const MaxDayTime = 24 * time.Hour
+
+// Journal is food journal entry. DayTime is entry time since start of day,
+// entries with different time are stored separately.
+// If Portion is set, FoodWeight is calculated from PortionCount and food portion weight.
 type Journal struct {
-	Timestamp  time.Time
-	Meal       Meal
-	FoodKey    string
-	FoodWeight float64
+	Timestamp    time.Time
+	DayTime      time.Duration
+	Meal         Meal
+	FoodKey      string
+	FoodWeight   float64
+	Portion      string
+	PortionCount float64
 }
 
 func (r *Journal) Validate() bool {
 	return r.Meal >= 0 &&
+		validDayTime(r.DayTime) &&
 		r.FoodKey != "" &&
-		r.FoodWeight > 0
+		((r.Portion == "" && r.FoodWeight > 0) || (r.Portion != "" && r.PortionCount > 0))
 }
 
+func validDayTime(d time.Duration) bool {
+	return d >= 0 && d < MaxDayTime && d%time.Minute == 0
+}
+
+type JournalSetMode int64
+
+const (
+	// Replace weight of existing entry.
+	JournalSetModeReplace JournalSetMode = iota
+	// Add weight to latest entry of food in meal regardless of time,
+	// time is used only for new entry.
+	JournalSetModeAdd
+)
+
 type JournalMealReport struct {
 	ConsumedDayCal  float64
 	ConsumedMealCal float64
@@ -83,25 +184,40 @@ type JournalMealReport struct {
 }
 
 type JournalMealItem struct {
-	Timestamp  time.Time
+	Timestamp time.Time
+	// Nil for entries logged before time of day was stored.
+	DayTime    *time.Duration
 	FoodKey    string
 	FoodName   string
 	FoodBrand  string
 	FoodWeight float64
-	Cal        float64
+	// Original quantity, empty portion if set in grams.
+	Portion      string
+	PortionCount float64
+	Cal          float64
 }
 
 type JournalReport struct {
-	Timestamp  time.Time
+	Timestamp time.Time
+	// Nil for entries logged before time of day was stored.
+	DayTime    *time.Duration
 	Meal       Meal
 	FoodKey    string
 	FoodName   string
 	FoodBrand  string
 	FoodWeight float64
-	Cal        float64
-	Prot       float64
-	Fat        float64
-	Carb       float64
+	// Original quantity, empty portion if set in grams.
+	Portion      string
+	PortionCount float64
+	Cal          float64
+	Prot         float64
+	Fat          float64
+	Carb         float64
+	// Extended nutrients, nil if unknown for food.
+	Fiber  *float64
+	Sugar  *float64
+	SatFat *float64
+	Salt   *float64
 }
 
 type JournalStats struct {
@@ -110,6 +226,17 @@ type JournalStats struct {
 	TotalProt float64
 	TotalFat  float64
 	TotalCarb float64
+	// Extended nutrients total of foods where known, nil if unknown for all.
+	TotalFiber  *float64
+	TotalSugar  *float64
+	TotalSatFat *float64
+	TotalSalt   *float64
+	// Count of entries with unknown extended nutrient, total is partial
+	// if not zero.
+	FiberUnknown  int
+	SugarUnknown  int
+	SatFatUnknown int
+	SaltUnknown   int
 }
 
 type Weight struct {
@@ -153,6 +280,32 @@ func (r *Bundle) Validate() bool {
 	return true
 }
 
+// Recipe is dish cooked from raw ingredients. Recipe produces private food
+// with same key, which nutrition is calculated per 100 g of cooked dish.
+type Recipe struct {
+	Key  string
+	Name string
+	// Map of ingredient food key -> raw weight.
+	Ingredients map[string]float64
+	// Measured weight of cooked dish, including pot tare.
+	CookedWeight float64
+	Tare         float64
+}
+
+func (r *Recipe) Validate() bool {
+	if r.Key == "" || r.Name == "" || len(r.Ingredients) == 0 {
+		return false
+	}
+
+	for k, v := range r.Ingredients {
+		if k == "" || v <= 0 {
+			return false
+		}
+	}
+
+	return r.Tare >= 0 && r.CookedWeight-r.Tare > 0
+}
+
 type Activity struct {
 	Timestamp time.Time
 	ActiveCal float64
@@ -162,22 +315,127 @@ func (r *Activity) Validate() bool {
 	return r.ActiveCal > 0
 }
 
+// BundleNode is node of resolved bundle hierarchy. For bundle node
+// weight and nutrition are totals of children.
+type BundleNode struct {
+	Key      string
+	IsBundle bool
+	// Food name and brand, empty for bundle.
+	Name     string
+	Brand    string
+	Weight   float64
+	Cal      float64
+	Prot     float64
+	Fat      float64
+	Carb     float64
+	Children []BundleNode
+}
+
+type RestoreMode int64
+
+const (
+	// Delete existing data of backup users before restore, global food
+	// is shared by all users and is merged.
+	RestoreModeReplace RestoreMode = iota
+	// Add backup data to existing, updating same entries.
+	RestoreModeMerge
+)
+
+const (
+	BackupVersion    = 7
+	BackupDateFormat = "2006-01-02"
+
+	// Version 1 stored timestamps as start of day in Europe/Moscow TZ.
+	_backupV1TZOffset = 3 * time.Hour
+)
+
 type Backup struct {
+	Version      int64                `json:"version"`
 	Timestamp    int64                `json:"timestamp"`
 	Weight       []WeightBackup       `json:"weight"`
 	Food         []FoodBackup         `json:"food"`
 	Journal      []JournalBackup      `json:"journal"`
 	Bundle       []BundleBackup       `json:"bundle"`
 	UserSettings []UserSettingsBackup `json:"user_settings"`
+	Activity     []ActivityBackup     `json:"activity"`
+	UserMeals    []UserMealBackup     `json:"user_meals"`
+	Recipes      []RecipeBackup       `json:"recipes"`
+}
+
+// Upgrade converts backup of previous versions to current version.
+func (r *Backup) Upgrade() error {
+	if r.Version > BackupVersion {
+		return ErrBackupInvalid
+	}
+
+	if r.Version < 2 {
+		v1Date := func(ts int64) string {
+			return time.UnixMilli(ts).Add(_backupV1TZOffset).UTC().Format(BackupDateFormat)
+		}
+
+		for i := range r.Weight {
+			r.Weight[i].Date = v1Date(r.Weight[i].Timestamp)
+			r.Weight[i].Timestamp = 0
+		}
+		for i := range r.Journal {
+			r.Journal[i].Date = v1Date(r.Journal[i].Timestamp)
+			r.Journal[i].Timestamp = 0
+		}
+	}
+
+	r.Version = BackupVersion
+	return nil
+}
+
+// UserIDs returns users with data in backup, global food owner is not included.
+func (r *Backup) UserIDs() []int64 {
+	set := make(map[int64]struct{})
+	for _, v := range r.Weight {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.Food {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.Journal {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.Bundle {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.UserSettings {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.Activity {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.UserMeals {
+		set[v.UserID] = struct{}{}
+	}
+	for _, v := range r.Recipes {
+		set[v.UserID] = struct{}{}
+	}
+	delete(set, 0)
+
+	res := make([]int64, 0, len(set))
+	for id := range set {
+		res = append(res, id)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res
 }
 
 type WeightBackup struct {
-	UserID    int64   `json:"user_id"`
-	Timestamp int64   `json:"timestamp"`
+	UserID int64 `json:"user_id"`
+	// Deprecated: version 1 only, use Date.
+	Timestamp int64   `json:"timestamp,omitempty"`
+	Date      string  `json:"date"`
 	Value     float64 `json:"value"`
 }
 
 type FoodBackup struct {
+	// Owner user ID, 0 for global food.
+	UserID  int64   `json:"user_id"`
 	Key     string  `json:"key"`
 	Name    string  `json:"name"`
 	Brand   string  `json:"brand"`
@@ -186,14 +444,38 @@ type FoodBackup struct {
 	Fat100  float64 `json:"fat100"`
 	Carb100 float64 `json:"carb100"`
 	Comment string  `json:"comment"`
+	// Extended nutrients, since version 6.
+	Fiber100  *float64 `json:"fiber100,omitempty"`
+	Sugar100  *float64 `json:"sugar100,omitempty"`
+	SatFat100 *float64 `json:"satfat100,omitempty"`
+	Salt100   *float64 `json:"salt100,omitempty"`
+	// Portions, since version 7.
+	Portions map[string]float64 `json:"portions,omitempty"`
 }
 
 type JournalBackup struct {
-	UserID     int64   `json:"user_id"`
-	Timestamp  int64   `json:"timestamp"`
+	UserID int64 `json:"user_id"`
+	// Deprecated: version 1 only, use Date.
+	Timestamp  int64   `json:"timestamp,omitempty"`
+	Date       string  `json:"date"`
+	DayTime    *int64  `json:"day_time,omitempty"` // seconds since start of day, nil for legacy entries
 	Meal       int64   `json:"meal"`
 	FoodKey    string  `json:"food_key"`
+	FoodUserID int64   `json:"food_user_id"`
 	FoodWeight float64 `json:"food_weight"`
+	// Nutrition snapshot, nil for versions before 4.
+	Cal100  *float64 `json:"cal100,omitempty"`
+	Prot100 *float64 `json:"prot100,omitempty"`
+	Fat100  *float64 `json:"fat100,omitempty"`
+	Carb100 *float64 `json:"carb100,omitempty"`
+	// Extended nutrients snapshot, since version 6.
+	Fiber100  *float64 `json:"fiber100,omitempty"`
+	Sugar100  *float64 `json:"sugar100,omitempty"`
+	SatFat100 *float64 `json:"satfat100,omitempty"`
+	Salt100   *float64 `json:"salt100,omitempty"`
+	// Original quantity, since version 7.
+	Portion      string  `json:"portion,omitempty"`
+	PortionCount float64 `json:"portion_count,omitempty"`
 }
 
 type BundleBackup struct {
@@ -203,6 +485,29 @@ type BundleBackup struct {
 }
 
 type UserSettingsBackup struct {
-	UserID   int64   `json:"user_id"`
-	CalLimit float64 `json:"cal_limit"`
+	UserID           int64   `json:"user_id"`
+	CalLimit         float64 `json:"cal_limit"`
+	DefaultActiveCal float64 `json:"default_active_cal"`
+}
+
+type ActivityBackup struct {
+	UserID    int64   `json:"user_id"`
+	Date      string  `json:"date"`
+	ActiveCal float64 `json:"active_cal"`
+}
+
+type UserMealBackup struct {
+	UserID  int64    `json:"user_id"`
+	Meal    int64    `json:"meal"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type RecipeBackup struct {
+	UserID       int64              `json:"user_id"`
+	Key          string             `json:"key"`
+	Name         string             `json:"name"`
+	Ingredients  map[string]float64 `json:"ingredients"`
+	CookedWeight float64            `json:"cooked_weight"`
+	Tare         float64            `json:"tare"`
 }
@@ -7,18 +7,31 @@ import (
 
 type Storage interface {
 	// Food
-	GetFood(ctx context.Context, key string) (*Food, error)
-	SetFood(ctx context.Context, food *Food) error
-	SetFoodComment(ctx context.Context, key, comment string) error
-	GetFoodList(ctx context.Context) ([]Food, error)
-	FindFood(ctx context.Context, pattern string) ([]Food, error)
-	DeleteFood(ctx context.Context, key string) error
+	GetFood(ctx context.Context, userID int64, key string) (*Food, error)
+	SetFood(ctx context.Context, userID int64, food *Food) error
+	SetFoodComment(ctx context.Context, userID int64, key, comment string) error
+	SetFoodPortions(ctx context.Context, userID int64, key string, portions map[string]float64) error
+	GetFoodList(ctx context.Context, userID int64) ([]Food, error)
+	FindFood(ctx context.Context, userID int64, pattern string, limit, offset int) ([]Food, error)
+	SuggestFood(ctx context.Context, userID int64, pattern string, limit int) ([]Food, error)
+	DeleteFood(ctx context.Context, userID int64, key string) error
+	RenameFood(ctx context.Context, userID int64, oldKey, newKey string) error
+	MergeFoods(ctx context.Context, userID int64, srcKey, dstKey string) error
 
 	// Bundle
 	SetBundle(ctx context.Context, userID int64, bndl *Bundle) error
 	GetBundle(ctx context.Context, userID int64, key string) (*Bundle, error)
 	GetBundleList(ctx context.Context, userID int64) ([]Bundle, error)
+	SuggestBundle(ctx context.Context, userID int64, pattern string, limit int) ([]Bundle, error)
 	DeleteBundle(ctx context.Context, userID int64, key string) error
+	RenameBundle(ctx context.Context, userID int64, oldKey, newKey string) error
+	GetBundleTree(ctx context.Context, userID int64, key string) (*BundleNode, error)
+
+	// Recipe
+	SetRecipe(ctx context.Context, userID int64, rcp *Recipe) error
+	GetRecipe(ctx context.Context, userID int64, key string) (*Recipe, error)
+	GetRecipeList(ctx context.Context, userID int64) ([]Recipe, error)
+	DeleteRecipe(ctx context.Context, userID int64, key string) error
 
 	// Weight
 	GetWeightList(ctx context.Context, userID int64, from, to time.Time) ([]Weight, error)
@@ -26,14 +39,16 @@ type Storage interface {
 	DeleteWeight(ctx context.Context, userID int64, timestamp time.Time) error
 
 	// Journal
-	SetJournal(ctx context.Context, userID int64, journal *Journal) error
-	SetJournalBundle(ctx context.Context, userID int64, timestamp time.Time, meal Meal, bndlKey string) error
+	SetJournal(ctx context.Context, userID int64, journal *Journal, mode JournalSetMode) error
+	SetJournalBundle(ctx context.Context, userID int64, timestamp time.Time, dayTime time.Duration, meal Meal, bndlKey string, multiplier float64, mode JournalSetMode) error
 	DeleteJournal(ctx context.Context, userID int64, timestamp time.Time, meal Meal, foodkey string) error
+	DeleteJournalEntry(ctx context.Context, userID int64, timestamp time.Time, dayTime *time.Duration, meal Meal, foodkey string) error
 	DeleteJournalMeal(ctx context.Context, userID int64, timestamp time.Time, meal Meal) error
 	GetJournalMealReport(ctx context.Context, userID int64, timestamp time.Time, meal Meal) (*JournalMealReport, error)
 	GetJournalReport(ctx context.Context, userID int64, from, to time.Time) ([]JournalReport, error)
 	GetJournalStats(ctx context.Context, userID int64, from, to time.Time) ([]JournalStats, error)
 	CopyJournal(ctx context.Context, userID int64, from time.Time, mealFrom Meal, to time.Time, mealTo Meal) (int, error)
+	RecalcJournal(ctx context.Context, userID int64, from, to time.Time) (int, error)
 	GetJournalFoodAvgWeight(ctx context.Context, userID int64, from, to time.Time, foodkey string) (float64, error)
 
 	// Activity
@@ -46,8 +61,18 @@ type Storage interface {
 	GetUserSettings(ctx context.Context, userID int64) (*UserSettings, error)
 	SetUserSettings(ctx context.Context, userID int64, settings *UserSettings) error
 
+	// UserMeal
+	GetUserMeals(ctx context.Context, userID int64) (UserMeals, error)
+	SetUserMeal(ctx context.Context, userID int64, meal *UserMeal) error
+	DeleteUserMeal(ctx context.Context, userID int64, meal Meal) error
+
 	// Backup
 	Backup(ctx context.Context) (*Backup, error)
+	Restore(ctx context.Context, backup *Backup, mode RestoreMode) error
+
+	// Batch runs fn with storage, which does all operations in single
+	// transaction. Transaction is rolled back if fn returns error.
+	Batch(ctx context.Context, fn func(stg Storage) error) error
 
 	Close() error
 }
@@ -3,7 +3,9 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +16,9 @@ import (
 	"github.com/devldavydov/myfood/internal/storage/ent/bundle"
 	"github.com/devldavydov/myfood/internal/storage/ent/food"
 	"github.com/devldavydov/myfood/internal/storage/ent/journal"
+	"github.com/devldavydov/myfood/internal/storage/ent/predicate"
+	"github.com/devldavydov/myfood/internal/storage/ent/recipe"
+	"github.com/devldavydov/myfood/internal/storage/ent/usermeal"
 	"github.com/devldavydov/myfood/internal/storage/ent/usersettings"
 	"github.com/devldavydov/myfood/internal/storage/ent/weight"
 	gsql "github.com/mattn/go-sqlite3"
@@ -31,6 +36,10 @@ type TxFn func(ctx context.Context, tx *ent.Tx) (any, error)
 type StorageSQLite struct {
 	db    *ent.Client
 	debug bool
+	// foodFTS is true if food full-text index is available.
+	foodFTS bool
+	// tx is batch transaction, all operations are done in it.
+	tx *ent.Tx
 }
 
 var _ Storage = (*StorageSQLite)(nil)
@@ -87,6 +96,20 @@ func NewStorageSQLite(dbFilePath string, opts ...func(*StorageSQLite)) (*Storage
 		opt(stg)
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), _databaseInitTimeout)
+	defer cancel()
+
+	if err := stg.initFoodFTS(ctx, dbSQL); err != nil {
+		return nil, err
+	}
+
+	// Fill nutrition snapshot for journal entries created before it was added.
+	if _, err := stg.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return stg.updateJournalNutrition(ctx, tx, journal.Cal100IsNil())
+	}); err != nil {
+		return nil, err
+	}
+
 	return stg, nil
 }
 
@@ -97,14 +120,32 @@ func WithDebug() func(*StorageSQLite) {
 }
 
 func (r *StorageSQLite) Close() error {
-	if r.db == nil {
+	if r.db == nil || r.tx != nil {
 		return nil
 	}
 
 	return r.db.Close()
 }
 
+func (r *StorageSQLite) Batch(ctx context.Context, fn func(stg Storage) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return nil, fn(&StorageSQLite{db: r.db, debug: r.debug, foodFTS: r.foodFTS, tx: tx})
+	})
+
+	return err
+}
+
 func (r *StorageSQLite) doTx(ctx context.Context, fn TxFn) (any, error) {
+	// In batch operation is done in batch transaction, which is
+	// committed or rolled back by Batch.
+	if r.tx != nil {
+		return fn(ctx, r.tx)
+	}
+
 	// Begin database transaction.
 
 	var clt *ent.Client
@@ -146,18 +187,12 @@ func (r *StorageSQLite) doTx(ctx context.Context, fn TxFn) (any, error) {
 // Food
 //
 
-func (r *StorageSQLite) GetFood(ctx context.Context, key string) (*Food, error) {
+func (r *StorageSQLite) GetFood(ctx context.Context, userID int64, key string) (*Food, error) {
 	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		return tx.Food.
-			Query().
-			Where(food.Key(key)).
-			First(ctx)
+		return r.getFood(ctx, tx, userID, key)
 	})
 
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, ErrFoodNotFound
-		}
 		return nil, err
 	}
 
@@ -165,14 +200,58 @@ func (r *StorageSQLite) GetFood(ctx context.Context, key string) (*Food, error)
 	return foodFromEntFood(ef), nil
 }
 
-func (r *StorageSQLite) SetFood(ctx context.Context, food *Food) error {
+// getFood returns user private food if exists, otherwise global food.
+func (r *StorageSQLite) getFood(ctx context.Context, tx *ent.Tx, userID int64, key string) (*ent.Food, error) {
+	efList, err := tx.Food.
+		Query().
+		Where(
+			food.Key(key),
+			food.UseridIn(0, userID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *ent.Food
+	for _, ef := range efList {
+		if res == nil || ef.Userid == userID {
+			res = ef
+		}
+	}
+
+	if res == nil {
+		return nil, ErrFoodNotFound
+	}
+
+	return res, nil
+}
+
+func (r *StorageSQLite) SetFood(ctx context.Context, userID int64, food *Food) error {
 	if !food.Validate() {
 		return ErrFoodInvalid
 	}
 
+	var ownerID int64
+	if food.Private {
+		ownerID = userID
+	}
+
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		return tx.Food.
+		// Recipe food is calculated from ingredients.
+		if ownerID != 0 {
+			isRecipe, err := r.isRecipeFood(ctx, tx, ownerID, food.Key)
+			if err != nil {
+				return nil, err
+			}
+			if isRecipe {
+				return nil, ErrFoodIsRecipe
+			}
+		}
+
+		create := tx.Food.
 			Create().
+			SetUserid(ownerID).
 			SetKey(food.Key).
 			SetName(food.Name).
 			SetBrand(food.Brand).
@@ -180,22 +259,33 @@ func (r *StorageSQLite) SetFood(ctx context.Context, food *Food) error {
 			SetProt100(food.Prot100).
 			SetFat100(food.Fat100).
 			SetCarb100(food.Carb100).
-			SetComment(food.Comment).
+			SetNillableFiber100(food.Fiber100).
+			SetNillableSugar100(food.Sugar100).
+			SetNillableSatfat100(food.SatFat100).
+			SetNillableSalt100(food.Salt100).
+			SetComment(food.Comment)
+		if food.Portions != nil {
+			create.SetPortions(food.Portions)
+		}
+
+		err := create.
 			OnConflict().
 			UpdateNewValues().
-			ID(ctx)
+			Update(updateFoodExtNutrients).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, r.updateFoodRecipes(ctx, tx, ownerID, food.Key, make(map[string]struct{}))
 	})
 
 	return err
 }
 
-func (r *StorageSQLite) SetFoodComment(ctx context.Context, key, comment string) error {
+func (r *StorageSQLite) SetFoodComment(ctx context.Context, userID int64, key, comment string) error {
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		f, err := tx.Food.
-			Query().
-			Where(food.Key(key)).
-			First(ctx)
-
+		f, err := r.getFood(ctx, tx, userID, key)
 		if err != nil {
 			return nil, err
 		}
@@ -206,17 +296,34 @@ func (r *StorageSQLite) SetFoodComment(ctx context.Context, key, comment string)
 			Save(ctx)
 	})
 
-	if ent.IsNotFound(err) {
-		return ErrFoodNotFound
+	return err
+}
+
+func (r *StorageSQLite) SetFoodPortions(ctx context.Context, userID int64, key string, portions map[string]float64) error {
+	if !validPortions(portions) {
+		return ErrFoodInvalid
 	}
 
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		f, err := r.getFood(ctx, tx, userID, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return f.
+			Update().
+			SetPortions(portions).
+			Save(ctx)
+	})
+
 	return err
 }
 
-func (r *StorageSQLite) GetFoodList(ctx context.Context) ([]Food, error) {
+func (r *StorageSQLite) GetFoodList(ctx context.Context, userID int64) ([]Food, error) {
 	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
 		return tx.Food.
 			Query().
+			Where(food.UseridIn(0, userID)).
 			Order(food.ByName()).
 			All(ctx)
 	})
@@ -225,6 +332,7 @@ func (r *StorageSQLite) GetFoodList(ctx context.Context) ([]Food, error) {
 	}
 
 	efList, _ := res.([]*ent.Food)
+	efList = foodResolveShadowed(efList, userID)
 
 	if len(efList) == 0 {
 		return nil, ErrFoodEmptyList
@@ -238,37 +346,63 @@ func (r *StorageSQLite) GetFoodList(ctx context.Context) ([]Food, error) {
 	return fList, nil
 }
 
-func (r *StorageSQLite) FindFood(ctx context.Context, pattern string) ([]Food, error) {
-	upPattern := strings.ToUpper(pattern)
+// SuggestFood returns foods with keys or names closest to pattern by edit
+// distance, russian letters are transliterated before comparison.
+func (r *StorageSQLite) SuggestFood(ctx context.Context, userID int64, pattern string, limit int) ([]Food, error) {
+	fList, err := r.GetFoodList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	idxs := suggestRank(pattern, limit, len(fList), func(i int) (string, string) {
+		return fList[i].Key, fList[i].Name
+	})
+	if len(idxs) == 0 {
+		return nil, ErrFoodEmptyList
+	}
+
+	res := make([]Food, 0, len(idxs))
+	for _, i := range idxs {
+		res = append(res, fList[i])
+	}
+
+	return res, nil
+}
+
+// FindFood returns foods where key, name, brand or comment match all words of
+// pattern. With full-text index words are matched by prefix and result is
+// ordered by relevance, if nothing found or no index, words are matched as
+// substrings and result is ordered by name. Empty pattern matches all foods,
+// zero limit means no limit.
+func (r *StorageSQLite) FindFood(ctx context.Context, userID int64, pattern string, limit, offset int) ([]Food, error) {
+	words := foodSearchWords(pattern)
 
 	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		return tx.Food.
-			Query().
-			Where(func(s *entsql.Selector) {
-				s.Where(
-					entsql.ExprP(
-						fmt.Sprintf("go_upper(%s) LIKE '%%' || ? || '%%'", s.C(food.FieldKey)),
-						upPattern,
-					)).
-					Or().
-					Where(
-						entsql.ExprP(
-							fmt.Sprintf("go_upper(%s) LIKE '%%' || ? || '%%'", s.C(food.FieldName)),
-							upPattern,
-						)).
-					Or().
-					Where(
-						entsql.ExprP(
-							fmt.Sprintf("go_upper(%s) LIKE '%%' || ? || '%%'", s.C(food.FieldBrand)),
-							upPattern,
-						)).
-					Or().
-					Where(
-						entsql.ExprP(
-							fmt.Sprintf("go_upper(%s) LIKE '%%' || ? || '%%'", s.C(food.FieldComment)),
-							upPattern,
-						))
-			}).
+		if r.foodFTS && len(words) > 0 {
+			ftsQuery := tx.Food.
+				Query().
+				Where(food.UseridIn(0, userID), foodNotShadowed(userID)).
+				Where(foodFTSMatch(foodFTSQuery(words)))
+
+			efList, err := pageFoods(ftsQuery.Clone(), limit, offset).
+				Order(foodFTSOrder, food.ByName()).
+				All(ctx)
+			if err != nil || len(efList) > 0 {
+				return efList, err
+			}
+
+			// Page is empty, fallback only if nothing found at all.
+			if offset > 0 {
+				found, err := ftsQuery.Exist(ctx)
+				if err != nil || found {
+					return nil, err
+				}
+			}
+		}
+
+		return pageFoods(tx.Food.Query(), limit, offset).
+			Where(food.UseridIn(0, userID), foodNotShadowed(userID)).
+			Where(foodLikeMatch(words)).
 			Order(food.ByName()).
 			All(ctx)
 	})
@@ -290,10 +424,33 @@ func (r *StorageSQLite) FindFood(ctx context.Context, pattern string) ([]Food, e
 	return fList, nil
 }
 
-func (r *StorageSQLite) DeleteFood(ctx context.Context, key string) error {
+func (r *StorageSQLite) DeleteFood(ctx context.Context, userID int64, key string) error {
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		// Check food in bundles.
-		bndls, err := tx.Bundle.Query().All(ctx)
+		f, err := r.getFood(ctx, tx, userID, key)
+		if err != nil {
+			if errors.Is(err, ErrFoodNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		if f.Userid != 0 {
+			isRecipe, err := r.isRecipeFood(ctx, tx, f.Userid, key)
+			if err != nil {
+				return nil, err
+			}
+			if isRecipe {
+				return nil, ErrFoodIsRecipe
+			}
+		}
+
+		// Check food in bundles, private food used only in owner bundles.
+		bndlQuery := tx.Bundle.Query()
+		if f.Userid != 0 {
+			bndlQuery.Where(bundle.Userid(f.Userid))
+		}
+
+		bndls, err := bndlQuery.All(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -306,10 +463,21 @@ func (r *StorageSQLite) DeleteFood(ctx context.Context, key string) error {
 			}
 		}
 
+		// Check food in recipes.
+		rcps, err := r.getFoodRecipes(ctx, tx, f.Userid, key)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rcp := range rcps {
+			if _, ok := rcp.Ingredients[key]; ok {
+				return nil, ErrFoodIsUsed
+			}
+		}
+
 		// Delete food.
-		return tx.Food.
-			Delete().
-			Where(food.Key(key)).
+		return nil, tx.Food.
+			DeleteOne(f).
 			Exec(ctx)
 	})
 
@@ -320,154 +488,941 @@ func (r *StorageSQLite) DeleteFood(ctx context.Context, key string) error {
 	return err
 }
 
-func foodFromEntFood(ef *ent.Food) *Food {
-	return &Food{
-		Key:     ef.Key,
-		Name:    ef.Name,
-		Brand:   ef.Brand,
-		Cal100:  ef.Cal100,
-		Prot100: ef.Prot100,
-		Fat100:  ef.Fat100,
-		Carb100: ef.Carb100,
-		Comment: ef.Comment,
+func (r *StorageSQLite) RenameFood(ctx context.Context, userID int64, oldKey, newKey string) error {
+	if newKey == "" {
+		return ErrFoodInvalid
 	}
-}
 
-//
-// Bundle
-//
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		f, err := r.getFood(ctx, tx, userID, oldKey)
+		if err != nil {
+			return nil, err
+		}
 
-func (r *StorageSQLite) SetBundle(ctx context.Context, userID int64, bndl *Bundle) error {
-	if !bndl.Validate() {
-		return ErrBundleInvalid
-	}
+		if oldKey == newKey {
+			return nil, nil
+		}
 
-	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		// Check bundle data
-		for k, v := range bndl.Data {
-			if v == 0 {
-				// Dependent bundle.
+		// New key must not be visible to any user who sees renamed food,
+		// otherwise bundles will reference another food.
+		existsQuery := tx.Food.Query().Where(food.Key(newKey))
+		if f.Userid != 0 {
+			existsQuery.Where(food.UseridIn(0, f.Userid))
+		}
 
-				// Check in DB.
-				_, err := tx.Bundle.
-					Query().
-					Where(
-						bundle.Key(k),
-						bundle.Userid(userID),
-					).
-					First(ctx)
-				if err != nil {
-					if ent.IsNotFound(err) {
-						return nil, ErrBundleDepBundleNotFound
-					}
-					return nil, err
-				}
+		exists, err := existsQuery.Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrFoodExists
+		}
 
-				// If same key - recursive not allowed.
-				if k == bndl.Key {
-					return nil, ErrBundleDepRecursive
-				}
-			} else {
-				// Dependent food, check in DB
-				_, err := tx.Food.
-					Query().
-					Where(food.Key(k)).
-					First(ctx)
-				if err != nil {
-					if ent.IsNotFound(err) {
-						return nil, ErrBundleDepFoodNotFound
-					}
-					return nil, err
+		if err = f.Update().SetKey(newKey).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		bndls, err := r.getFoodBundles(ctx, tx, f.Userid, oldKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bndl := range bndls {
+			if v, ok := bndl.Data[oldKey]; !ok || v == 0 {
+				continue
+			}
+
+			if err := r.renameBundleDataKey(ctx, bndl, oldKey, newKey, ErrFoodExists); err != nil {
+				return nil, err
+			}
+		}
+
+		// Recipes.
+		rcps, err := r.getFoodRecipes(ctx, tx, f.Userid, oldKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rcp := range rcps {
+			v, ok := rcp.Ingredients[oldKey]
+			if !ok {
+				continue
+			}
+			if _, ok := rcp.Ingredients[newKey]; ok {
+				return nil, ErrFoodExists
+			}
+
+			ingredients := make(map[string]float64, len(rcp.Ingredients))
+			for k, w := range rcp.Ingredients {
+				if k != oldKey {
+					ingredients[k] = w
 				}
 			}
+			ingredients[newKey] = v
+
+			if err := rcp.Update().SetIngredients(ingredients).Exec(ctx); err != nil {
+				return nil, err
+			}
 		}
 
-		// Set bundle in DB
-		return tx.Bundle.
-			Create().
-			SetUserid(userID).
-			SetKey(bndl.Key).
-			SetData(bndl.Data).
-			OnConflict().
-			UpdateNewValues().
-			ID(ctx)
+		// Recipe food is renamed with recipe.
+		if f.Userid != 0 {
+			_, err = tx.Recipe.
+				Update().
+				Where(
+					recipe.Userid(f.Userid),
+					recipe.Key(oldKey),
+				).
+				SetKey(newKey).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
 	})
 
 	return err
 }
 
-func (r *StorageSQLite) GetBundle(ctx context.Context, userID int64, key string) (*Bundle, error) {
-	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		return r.getBundle(ctx, tx, userID, key)
-	})
+// getFoodBundles returns bundles that can reference food with key: private food
+// used only in owner bundles, global food used in bundles of users without
+// private food with same key.
+func (r *StorageSQLite) getFoodBundles(ctx context.Context, tx *ent.Tx, ownerID int64, key string) ([]*ent.Bundle, error) {
+	bndlQuery := tx.Bundle.Query()
+	if ownerID != 0 {
+		return bndlQuery.Where(bundle.Userid(ownerID)).All(ctx)
+	}
 
+	shadowUsers, err := r.getFoodShadowUsers(ctx, tx, key)
 	if err != nil {
 		return nil, err
 	}
 
-	bndl, _ := res.(*ent.Bundle)
-
-	return &Bundle{Key: bndl.Key, Data: bndl.Data}, nil
+	return bndlQuery.Where(bundle.UseridNotIn(shadowUsers...)).All(ctx)
 }
 
-func (r *StorageSQLite) getBundle(ctx context.Context, tx *ent.Tx, userID int64, key string) (*ent.Bundle, error) {
-	res, err := tx.Bundle.
-		Query().
-		Where(
-			bundle.Userid(userID),
-			bundle.Key(key),
-		).
-		First(ctx)
+// getFoodRecipes returns recipes that can reference food with key, same as getFoodBundles.
+func (r *StorageSQLite) getFoodRecipes(ctx context.Context, tx *ent.Tx, ownerID int64, key string) ([]*ent.Recipe, error) {
+	rcpQuery := tx.Recipe.Query()
+	if ownerID != 0 {
+		return rcpQuery.Where(recipe.Userid(ownerID)).All(ctx)
+	}
+
+	shadowUsers, err := r.getFoodShadowUsers(ctx, tx, key)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, ErrBundleNotFound
-		}
 		return nil, err
 	}
 
-	return res, nil
+	return rcpQuery.Where(recipe.UseridNotIn(shadowUsers...)).All(ctx)
 }
 
-func (r *StorageSQLite) GetBundleList(ctx context.Context, userID int64) ([]Bundle, error) {
-	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		return tx.Bundle.
-			Query().
-			Where(bundle.Userid(userID)).
-			Order(bundle.ByKey()).
-			All(ctx)
-	})
+// getFoodShadowUsers returns users with private food, which shadows global food with key.
+func (r *StorageSQLite) getFoodShadowUsers(ctx context.Context, tx *ent.Tx, key string) ([]int64, error) {
+	shadowFood, err := tx.Food.
+		Query().
+		Where(
+			food.Key(key),
+			food.UseridNEQ(0),
+		).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	beLst, _ := res.([]*ent.Bundle)
-	if len(beLst) == 0 {
-		return nil, ErrBundleEmptyList
-	}
-
-	bLst := make([]Bundle, 0, len(beLst))
-	for _, b := range beLst {
-		bLst = append(bLst, Bundle{Key: b.Key, Data: b.Data})
+	shadowUsers := make([]int64, 0, len(shadowFood))
+	for _, sf := range shadowFood {
+		shadowUsers = append(shadowUsers, sf.Userid)
 	}
 
-	return bLst, nil
+	return shadowUsers, nil
 }
 
-func (r *StorageSQLite) getBundleFoodItems(ctx context.Context, tx *ent.Tx, userID int64, bndlData, foodItems map[string]float64) error {
-	for k, v := range bndlData {
-		if v > 0 {
-			// If food - add to result map.
-			foodItems[k] = v
-			continue
-		}
-
+func (r *StorageSQLite) MergeFoods(ctx context.Context, userID int64, srcKey, dstKey string) error {
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		src, err := r.getFood(ctx, tx, userID, srcKey)
+		if err != nil {
+			return nil, err
+		}
+
+		dst, err := r.getFood(ctx, tx, userID, dstKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// Global food can't be merged into private food, because it
+		// can be used by other users.
+		if src.ID == dst.ID || (src.Userid == 0 && dst.Userid != 0) {
+			return nil, ErrFoodMerge
+		}
+
+		// Recipe food can't be merged, it is calculated from ingredients.
+		if src.Userid != 0 {
+			isRecipe, err := r.isRecipeFood(ctx, tx, src.Userid, srcKey)
+			if err != nil {
+				return nil, err
+			}
+			if isRecipe {
+				return nil, ErrFoodMerge
+			}
+		}
+
+		// Journal.
+		jLst, err := tx.Journal.
+			Query().
+			Where(journal.HasFoodWith(food.ID(src.ID))).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, j := range jLst {
+			if err := r.mergeJournalEntry(ctx, tx, j, dst); err != nil {
+				return nil, err
+			}
+		}
+
+		// Bundles.
+		bndls, err := r.getFoodBundles(ctx, tx, src.Userid, srcKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bndl := range bndls {
+			srcWeight, ok := bndl.Data[srcKey]
+			if !ok || srcWeight == 0 {
+				continue
+			}
+
+			dstWeight, ok := bndl.Data[dstKey]
+			if ok && dstWeight == 0 {
+				// Bundle with dst key in data.
+				return nil, ErrFoodMerge
+			}
+
+			data := make(map[string]float64, len(bndl.Data))
+			for k, v := range bndl.Data {
+				if k != srcKey {
+					data[k] = v
+				}
+			}
+			data[dstKey] = srcWeight + dstWeight
+
+			if err := bndl.Update().SetData(data).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		// Recipes.
+		rcps, err := r.getFoodRecipes(ctx, tx, src.Userid, srcKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rcp := range rcps {
+			srcWeight, ok := rcp.Ingredients[srcKey]
+			if !ok {
+				continue
+			}
+
+			ingredients := make(map[string]float64, len(rcp.Ingredients))
+			for k, v := range rcp.Ingredients {
+				if k != srcKey {
+					ingredients[k] = v
+				}
+			}
+			ingredients[dstKey] += srcWeight
+
+			rcp, err = rcp.Update().SetIngredients(ingredients).Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = r.updateRecipeFood(ctx, tx, rcp, make(map[string]struct{})); err != nil {
+				if errors.Is(err, ErrRecipeIngredientRecursive) {
+					return nil, ErrFoodMerge
+				}
+				return nil, err
+			}
+		}
+
+		// Delete src food.
+		return nil, tx.Food.DeleteOne(src).Exec(ctx)
+	})
+
+	return err
+}
+
+// mergeJournalEntry moves journal entry to dst food. If dst food entry already exists,
+// weights are summed and nutrition snapshot is weighted by food weight.
+func (r *StorageSQLite) mergeJournalEntry(ctx context.Context, tx *ent.Tx, j *ent.Journal, dst *ent.Food) error {
+	dstJ, err := tx.Journal.
+		Query().
+		Where(
+			journal.Userid(j.Userid),
+			journal.Timestamp(j.Timestamp),
+			journal.Meal(j.Meal),
+			journalDayTime(j.Daytime),
+			journal.HasFoodWith(food.ID(dst.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return j.Update().SetFoodID(dst.ID).Exec(ctx)
+		}
+		return err
+	}
+
+	if err := tx.Journal.DeleteOne(j).Exec(ctx); err != nil {
+		return err
+	}
+
+	upd, err := addJournalEntry(dstJ, j)
+	if err != nil {
+		return err
+	}
+	return upd.Exec(ctx)
+}
+
+// addJournalEntry returns update of dst journal entry with src weight added,
+// nutrition snapshot is weighted by food weight.
+func addJournalEntry(dst, src *ent.Journal) (*ent.JournalUpdateOne, error) {
+	weight := src.Foodweight + dst.Foodweight
+	avg := func(srcV, dstV *float64) float64 {
+		return (*srcV*src.Foodweight + *dstV*dst.Foodweight) / weight
+	}
+
+	upd := dst.
+		Update().
+		SetFoodweight(weight).
+		SetPortion("").
+		SetPortioncount(0).
+		SetCal100(avg(src.Cal100, dst.Cal100)).
+		SetProt100(avg(src.Prot100, dst.Prot100)).
+		SetFat100(avg(src.Fat100, dst.Fat100)).
+		SetCarb100(avg(src.Carb100, dst.Carb100))
+
+	if src.Portion == dst.Portion {
+		upd.
+			SetPortion(src.Portion).
+			SetPortioncount(src.Portioncount + dst.Portioncount)
+	}
+
+	// Extended nutrient is known only if known for both entries.
+	for field, v := range map[string][2]*float64{
+		journal.FieldFiber100:  {src.Fiber100, dst.Fiber100},
+		journal.FieldSugar100:  {src.Sugar100, dst.Sugar100},
+		journal.FieldSatfat100: {src.Satfat100, dst.Satfat100},
+		journal.FieldSalt100:   {src.Salt100, dst.Salt100},
+	} {
+		var err error
+		if v[0] == nil || v[1] == nil {
+			err = upd.Mutation().ClearField(field)
+		} else {
+			err = upd.Mutation().SetField(field, avg(v[0], v[1]))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return upd, nil
+}
+
+// foodResolveShadowed removes global food shadowed by user private food with same key.
+func foodResolveShadowed(efList []*ent.Food, userID int64) []*ent.Food {
+	private := make(map[string]struct{})
+	for _, ef := range efList {
+		if ef.Userid == userID && userID != 0 {
+			private[ef.Key] = struct{}{}
+		}
+	}
+
+	res := make([]*ent.Food, 0, len(efList))
+	for _, ef := range efList {
+		if _, ok := private[ef.Key]; ok && ef.Userid == 0 {
+			continue
+		}
+		res = append(res, ef)
+	}
+
+	return res
+}
+
+func foodFromEntFood(ef *ent.Food) *Food {
+	return &Food{
+		Key:       ef.Key,
+		Name:      ef.Name,
+		Brand:     ef.Brand,
+		Cal100:    ef.Cal100,
+		Prot100:   ef.Prot100,
+		Fat100:    ef.Fat100,
+		Carb100:   ef.Carb100,
+		Fiber100:  ef.Fiber100,
+		Sugar100:  ef.Sugar100,
+		SatFat100: ef.Satfat100,
+		Salt100:   ef.Salt100,
+		Comment:   ef.Comment,
+		Portions:  ef.Portions,
+		Private:   ef.Userid != 0,
+	}
+}
+
+// updateFoodExtNutrients sets extended nutrients on upsert, so unknown
+// nutrients of new values are cleared.
+func updateFoodExtNutrients(u *ent.FoodUpsert) {
+	u.UpdateFiber100()
+	u.UpdateSugar100()
+	u.UpdateSatfat100()
+	u.UpdateSalt100()
+}
+
+//
+// Bundle
+//
+
+func (r *StorageSQLite) SetBundle(ctx context.Context, userID int64, bndl *Bundle) error {
+	if !bndl.Validate() {
+		return ErrBundleInvalid
+	}
+
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		// Check bundle data
+		for k, v := range bndl.Data {
+			if v == 0 {
+				// Dependent bundle.
+
+				// Check in DB.
+				_, err := tx.Bundle.
+					Query().
+					Where(
+						bundle.Key(k),
+						bundle.Userid(userID),
+					).
+					First(ctx)
+				if err != nil {
+					if ent.IsNotFound(err) {
+						return nil, ErrBundleDepBundleNotFound
+					}
+					return nil, err
+				}
+			} else {
+				// Dependent food, check in DB
+				_, err := r.getFood(ctx, tx, userID, k)
+				if err != nil {
+					if errors.Is(err, ErrFoodNotFound) {
+						return nil, ErrBundleDepFoodNotFound
+					}
+					return nil, err
+				}
+			}
+		}
+
+		// Check that new dependencies don't create cycle.
+		bndls, err := tx.Bundle.
+			Query().
+			Where(bundle.Userid(userID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		graph := make(map[string]map[string]float64, len(bndls)+1)
+		for _, b := range bndls {
+			graph[b.Key] = b.Data
+		}
+		graph[bndl.Key] = bndl.Data
+
+		if bundleHasCycle(graph, bndl.Key, make(map[string]struct{})) {
+			return nil, ErrBundleDepRecursive
+		}
+
+		// Set bundle in DB
+		return tx.Bundle.
+			Create().
+			SetUserid(userID).
+			SetKey(bndl.Key).
+			SetData(bndl.Data).
+			OnConflict().
+			UpdateNewValues().
+			ID(ctx)
+	})
+
+	return err
+}
+
+// bundleHasCycle checks if bundle dependencies graph has cycle reachable from key.
+func bundleHasCycle(graph map[string]map[string]float64, key string, path map[string]struct{}) bool {
+	if _, ok := path[key]; ok {
+		return true
+	}
+
+	path[key] = struct{}{}
+	defer delete(path, key)
+
+	for k, v := range graph[key] {
+		if v == 0 && bundleHasCycle(graph, k, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *StorageSQLite) GetBundle(ctx context.Context, userID int64, key string) (*Bundle, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return r.getBundle(ctx, tx, userID, key)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	bndl, _ := res.(*ent.Bundle)
+
+	return &Bundle{Key: bndl.Key, Data: bndl.Data}, nil
+}
+
+func (r *StorageSQLite) getBundle(ctx context.Context, tx *ent.Tx, userID int64, key string) (*ent.Bundle, error) {
+	res, err := tx.Bundle.
+		Query().
+		Where(
+			bundle.Userid(userID),
+			bundle.Key(key),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBundleNotFound
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *StorageSQLite) GetBundleList(ctx context.Context, userID int64) ([]Bundle, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return tx.Bundle.
+			Query().
+			Where(bundle.Userid(userID)).
+			Order(bundle.ByKey()).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	beLst, _ := res.([]*ent.Bundle)
+	if len(beLst) == 0 {
+		return nil, ErrBundleEmptyList
+	}
+
+	bLst := make([]Bundle, 0, len(beLst))
+	for _, b := range beLst {
+		bLst = append(bLst, Bundle{Key: b.Key, Data: b.Data})
+	}
+
+	return bLst, nil
+}
+
+// SuggestBundle returns bundles with keys closest to pattern, see SuggestFood.
+func (r *StorageSQLite) SuggestBundle(ctx context.Context, userID int64, pattern string, limit int) ([]Bundle, error) {
+	bList, err := r.GetBundleList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	idxs := suggestRank(pattern, limit, len(bList), func(i int) (string, string) {
+		return bList[i].Key, ""
+	})
+	if len(idxs) == 0 {
+		return nil, ErrBundleEmptyList
+	}
+
+	res := make([]Bundle, 0, len(idxs))
+	for _, i := range idxs {
+		res = append(res, bList[i])
+	}
+
+	return res, nil
+}
+
+func (r *StorageSQLite) GetBundleTree(ctx context.Context, userID int64, key string) (*BundleNode, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		bndl, err := r.getBundle(ctx, tx, userID, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.getBundleNode(ctx, tx, userID, bndl, make(map[string]struct{}))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*BundleNode), nil
+}
+
+func (r *StorageSQLite) getBundleNode(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int64,
+	bndl *ent.Bundle,
+	path map[string]struct{},
+) (*BundleNode, error) {
+	if _, ok := path[bndl.Key]; ok {
+		return nil, ErrBundleDepRecursive
+	}
+	path[bndl.Key] = struct{}{}
+	defer delete(path, bndl.Key)
+
+	node := &BundleNode{Key: bndl.Key, IsBundle: true}
+
+	keys := make([]string, 0, len(bndl.Data))
+	for k := range bndl.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		var child *BundleNode
+
+		if v := bndl.Data[k]; v > 0 {
+			f, err := r.getFood(ctx, tx, userID, k)
+			if err != nil {
+				if errors.Is(err, ErrFoodNotFound) {
+					return nil, ErrBundleDepFoodNotFound
+				}
+				return nil, err
+			}
+
+			child = &BundleNode{
+				Key:    k,
+				Name:   f.Name,
+				Brand:  f.Brand,
+				Weight: v,
+				Cal:    v / 100 * f.Cal100,
+				Prot:   v / 100 * f.Prot100,
+				Fat:    v / 100 * f.Fat100,
+				Carb:   v / 100 * f.Carb100,
+			}
+		} else {
+			depBndl, err := r.getBundle(ctx, tx, userID, k)
+			if err != nil {
+				if errors.Is(err, ErrBundleNotFound) {
+					return nil, ErrBundleDepBundleNotFound
+				}
+				return nil, err
+			}
+
+			child, err = r.getBundleNode(ctx, tx, userID, depBndl, path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		node.Weight += child.Weight
+		node.Cal += child.Cal
+		node.Prot += child.Prot
+		node.Fat += child.Fat
+		node.Carb += child.Carb
+		node.Children = append(node.Children, *child)
+	}
+
+	return node, nil
+}
+
+func (r *StorageSQLite) getBundleFoodItems(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int64,
+	bndl *ent.Bundle,
+	foodItems map[string]float64,
+	path map[string]struct{},
+) error {
+	// Guard against cycles saved before full graph validation.
+	if _, ok := path[bndl.Key]; ok {
+		return ErrBundleDepRecursive
+	}
+	path[bndl.Key] = struct{}{}
+	defer delete(path, bndl.Key)
+
+	for k, v := range bndl.Data {
+		if v > 0 {
+			// If food - add to result map, same food from different
+			// bundles is summed.
+			foodItems[k] += v
+			continue
+		}
+
 		// If bundle - get from DB and call recursive.
-		bndl, err := r.getBundle(ctx, tx, userID, k)
+		depBndl, err := r.getBundle(ctx, tx, userID, k)
+		if err != nil {
+			return err
+		}
+
+		if err := r.getBundleFoodItems(ctx, tx, userID, depBndl, foodItems, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *StorageSQLite) DeleteBundle(ctx context.Context, userID int64, key string) error {
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		// Check that bundle not used in other bundles.
+		bndls, err := tx.Bundle.
+			Query().
+			Where(bundle.Userid(userID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bndl := range bndls {
+			for k, v := range bndl.Data {
+				if v == 0 && k == key {
+					return nil, ErrBundleIsUsed
+				}
+			}
+		}
+
+		return tx.Bundle.
+			Delete().
+			Where(
+				bundle.Userid(userID),
+				bundle.Key(key),
+			).Exec(ctx)
+	})
+
+	return err
+}
+
+func (r *StorageSQLite) RenameBundle(ctx context.Context, userID int64, oldKey, newKey string) error {
+	if newKey == "" {
+		return ErrBundleInvalid
+	}
+
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		bndl, err := r.getBundle(ctx, tx, userID, oldKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if oldKey == newKey {
+			return nil, nil
+		}
+
+		exists, err := tx.Bundle.
+			Query().
+			Where(
+				bundle.Userid(userID),
+				bundle.Key(newKey),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrBundleExists
+		}
+
+		if err = bndl.Update().SetKey(newKey).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		// Update dependent bundles.
+		bndls, err := tx.Bundle.
+			Query().
+			Where(bundle.Userid(userID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range bndls {
+			if v, ok := b.Data[oldKey]; !ok || v != 0 {
+				continue
+			}
+
+			if err := r.renameBundleDataKey(ctx, b, oldKey, newKey, ErrBundleExists); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+
+	return err
+}
+
+// renameBundleDataKey renames bundle data key, errExists returned if new key already in data.
+func (r *StorageSQLite) renameBundleDataKey(ctx context.Context, bndl *ent.Bundle, oldKey, newKey string, errExists error) error {
+	if _, ok := bndl.Data[newKey]; ok {
+		return errExists
+	}
+
+	data := make(map[string]float64, len(bndl.Data))
+	for k, v := range bndl.Data {
+		if k == oldKey {
+			k = newKey
+		}
+		data[k] = v
+	}
+
+	return bndl.Update().SetData(data).Exec(ctx)
+}
+
+//
+// Recipe
+//
+
+func (r *StorageSQLite) SetRecipe(ctx context.Context, userID int64, rcp *Recipe) error {
+	if !rcp.Validate() {
+		return ErrRecipeInvalid
+	}
+
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		// New recipe key must not clash with food visible to user.
+		isRecipe, err := r.isRecipeFood(ctx, tx, userID, rcp.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !isRecipe {
+			_, err = r.getFood(ctx, tx, userID, rcp.Key)
+			if err == nil {
+				return nil, ErrFoodExists
+			}
+			if !errors.Is(err, ErrFoodNotFound) {
+				return nil, err
+			}
+		}
+
+		id, err := tx.Recipe.
+			Create().
+			SetUserid(userID).
+			SetKey(rcp.Key).
+			SetName(rcp.Name).
+			SetIngredients(rcp.Ingredients).
+			SetCookedweight(rcp.CookedWeight).
+			SetTare(rcp.Tare).
+			OnConflict().
+			UpdateNewValues().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		erc, err := tx.Recipe.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, r.updateRecipeFood(ctx, tx, erc, make(map[string]struct{}))
+	})
+
+	return err
+}
+
+// updateRecipeFood calculates recipe food nutrition per 100 g of cooked dish
+// and updates recipes, which use recipe food as ingredient.
+func (r *StorageSQLite) updateRecipeFood(ctx context.Context, tx *ent.Tx, rcp *ent.Recipe, path map[string]struct{}) error {
+	if _, ok := path[rcp.Key]; ok {
+		return ErrRecipeIngredientRecursive
+	}
+	path[rcp.Key] = struct{}{}
+	defer delete(path, rcp.Key)
+
+	var cal, prot, fat, carb float64
+	// Extended nutrient is known only if known for all ingredients.
+	fiber, sugar, satFat, salt := new(float64), new(float64), new(float64), new(float64)
+	addOpt := func(sum **float64, w float64, v *float64) {
+		if *sum == nil {
+			return
+		}
+		if v == nil {
+			*sum = nil
+			return
+		}
+		**sum += w / 100 * *v
+	}
+
+	for k, v := range rcp.Ingredients {
+		f, err := r.getFood(ctx, tx, rcp.Userid, k)
 		if err != nil {
+			if errors.Is(err, ErrFoodNotFound) {
+				return ErrRecipeIngredientNotFound
+			}
 			return err
 		}
 
-		if err := r.getBundleFoodItems(ctx, tx, userID, bndl.Data, foodItems); err != nil {
+		cal += v / 100 * f.Cal100
+		prot += v / 100 * f.Prot100
+		fat += v / 100 * f.Fat100
+		carb += v / 100 * f.Carb100
+		addOpt(&fiber, v, f.Fiber100)
+		addOpt(&sugar, v, f.Sugar100)
+		addOpt(&satFat, v, f.Satfat100)
+		addOpt(&salt, v, f.Salt100)
+	}
+
+	k := 100 / (rcp.Cookedweight - rcp.Tare)
+	per100 := func(v *float64) *float64 {
+		if v == nil {
+			return nil
+		}
+		res := *v * k
+		return &res
+	}
+
+	// Keep recipe food brand and comment.
+	err := tx.Food.
+		Create().
+		SetUserid(rcp.Userid).
+		SetKey(rcp.Key).
+		SetName(rcp.Name).
+		SetCal100(cal * k).
+		SetProt100(prot * k).
+		SetFat100(fat * k).
+		SetCarb100(carb * k).
+		SetNillableFiber100(per100(fiber)).
+		SetNillableSugar100(per100(sugar)).
+		SetNillableSatfat100(per100(satFat)).
+		SetNillableSalt100(per100(salt)).
+		OnConflict().
+		Update(func(u *ent.FoodUpsert) {
+			u.UpdateName()
+			u.UpdateCal100()
+			u.UpdateProt100()
+			u.UpdateFat100()
+			u.UpdateCarb100()
+			updateFoodExtNutrients(u)
+		}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.updateFoodRecipes(ctx, tx, rcp.Userid, rcp.Key, path)
+}
+
+// updateFoodRecipes updates food of recipes, which use food with key as ingredient.
+func (r *StorageSQLite) updateFoodRecipes(ctx context.Context, tx *ent.Tx, ownerID int64, key string, path map[string]struct{}) error {
+	rcps, err := r.getFoodRecipes(ctx, tx, ownerID, key)
+	if err != nil {
+		return err
+	}
+
+	for _, rcp := range rcps {
+		if _, ok := rcp.Ingredients[key]; !ok {
+			continue
+		}
+
+		if err := r.updateRecipeFood(ctx, tx, rcp, path); err != nil {
 			return err
 		}
 	}
@@ -475,36 +1430,86 @@ func (r *StorageSQLite) getBundleFoodItems(ctx context.Context, tx *ent.Tx, user
 	return nil
 }
 
-func (r *StorageSQLite) DeleteBundle(ctx context.Context, userID int64, key string) error {
-	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		// Check that bundle not used in other bundles.
-		bndls, err := tx.Bundle.
+func (r *StorageSQLite) isRecipeFood(ctx context.Context, tx *ent.Tx, userID int64, key string) (bool, error) {
+	return tx.Recipe.
+		Query().
+		Where(
+			recipe.Userid(userID),
+			recipe.Key(key),
+		).
+		Exist(ctx)
+}
+
+func (r *StorageSQLite) GetRecipe(ctx context.Context, userID int64, key string) (*Recipe, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return tx.Recipe.
 			Query().
-			Where(bundle.Userid(userID)).
-			All(ctx)
-		if err != nil {
-			return nil, err
+			Where(
+				recipe.Userid(userID),
+				recipe.Key(key),
+			).
+			Only(ctx)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRecipeNotFound
 		}
+		return nil, err
+	}
 
-		for _, bndl := range bndls {
-			for k, v := range bndl.Data {
-				if v == 0 && k == key {
-					return nil, ErrBundleIsUsed
-				}
-			}
-		}
+	return recipeFromEntRecipe(res.(*ent.Recipe)), nil
+}
 
-		return tx.Bundle.
+func (r *StorageSQLite) GetRecipeList(ctx context.Context, userID int64) ([]Recipe, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return tx.Recipe.
+			Query().
+			Where(recipe.Userid(userID)).
+			Order(recipe.ByKey()).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	erLst, _ := res.([]*ent.Recipe)
+	if len(erLst) == 0 {
+		return nil, ErrRecipeEmptyList
+	}
+
+	rLst := make([]Recipe, 0, len(erLst))
+	for _, er := range erLst {
+		rLst = append(rLst, *recipeFromEntRecipe(er))
+	}
+
+	return rLst, nil
+}
+
+// DeleteRecipe deletes recipe, recipe food is kept as ordinary private food.
+func (r *StorageSQLite) DeleteRecipe(ctx context.Context, userID int64, key string) error {
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return tx.Recipe.
 			Delete().
 			Where(
-				bundle.Userid(userID),
-				bundle.Key(key),
-			).Exec(ctx)
+				recipe.Userid(userID),
+				recipe.Key(key),
+			).
+			Exec(ctx)
 	})
 
 	return err
 }
 
+func recipeFromEntRecipe(er *ent.Recipe) *Recipe {
+	return &Recipe{
+		Key:          er.Key,
+		Name:         er.Name,
+		Ingredients:  er.Ingredients,
+		CookedWeight: er.Cookedweight,
+		Tare:         er.Tare,
+	}
+}
+
 //
 // Weight
 //
@@ -576,34 +1581,138 @@ func (r *StorageSQLite) DeleteWeight(ctx context.Context, userID int64, timestam
 // Journal
 //
 
-func (r *StorageSQLite) SetJournal(ctx context.Context, userID int64, journal *Journal) error {
+func (r *StorageSQLite) SetJournal(ctx context.Context, userID int64, journal *Journal, mode JournalSetMode) error {
 	if !journal.Validate() {
 		return ErrJournalInvalid
 	}
 
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		food, err := r.getFoodForJournal(ctx, tx, journal.FoodKey)
+		if err := r.checkUserMeal(ctx, tx, userID, journal.Meal); err != nil {
+			return nil, err
+		}
+
+		food, err := r.getFoodForJournal(ctx, tx, userID, journal.FoodKey)
 		if err != nil {
 			return nil, err
 		}
 
-		return tx.Journal.
-			Create().
-			SetUserid(userID).
-			SetTimestamp(journal.Timestamp).
-			SetMeal(int64(journal.Meal)).
-			SetFoodweight(journal.FoodWeight).
-			SetFood(food).
-			OnConflict().
-			UpdateNewValues().
-			ID(ctx)
+		// Convert portions to grams.
+		foodWeight := journal.FoodWeight
+		if journal.Portion != "" {
+			portionWeight, ok := food.Portions[journal.Portion]
+			if !ok {
+				return nil, ErrFoodPortionNotFound
+			}
+			foodWeight = journal.PortionCount * portionWeight
+		}
+
+		return nil, r.setJournal(
+			ctx, tx, userID,
+			journal.Timestamp, journal.DayTime, journal.Meal,
+			food, foodWeight, journal.Portion, journal.PortionCount,
+			mode,
+		)
 	})
 
 	return err
 }
 
-func (r *StorageSQLite) SetJournalBundle(ctx context.Context, userID int64, timestamp time.Time, meal Meal, bndlKey string) error {
+func (r *StorageSQLite) setJournal(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int64,
+	timestamp time.Time,
+	dayTime time.Duration,
+	meal Meal,
+	f *ent.Food,
+	foodWeight float64,
+	portion string,
+	portionCount float64,
+	mode JournalSetMode,
+) error {
+	upsert := tx.Journal.
+		Create().
+		SetUserid(userID).
+		SetTimestamp(timestamp).
+		SetDaytime(int64(dayTime.Seconds())).
+		SetMeal(int64(meal)).
+		SetFoodweight(foodWeight).
+		SetPortion(portion).
+		SetPortioncount(portionCount).
+		SetCal100(f.Cal100).
+		SetProt100(f.Prot100).
+		SetFat100(f.Fat100).
+		SetCarb100(f.Carb100).
+		SetNillableFiber100(f.Fiber100).
+		SetNillableSugar100(f.Sugar100).
+		SetNillableSatfat100(f.Satfat100).
+		SetNillableSalt100(f.Salt100).
+		SetFood(f).
+		OnConflict()
+
+	// Weight is added to the latest entry of food in meal regardless of
+	// its time, time is used only for new entry. Nutrition snapshot is
+	// weighted by food weight, so grams already logged keep their nutrition.
+	if mode == JournalSetModeAdd {
+		j, err := tx.Journal.
+			Query().
+			Where(
+				journal.Userid(userID),
+				journal.Timestamp(timestamp),
+				journal.Meal(int64(meal)),
+				journal.HasFoodWith(food.ID(f.ID)),
+			).
+			Order(journal.ByDaytime(entsql.OrderDesc())).
+			First(ctx)
+		if err == nil {
+			upd, err := addJournalEntry(j, &ent.Journal{
+				Foodweight:   foodWeight,
+				Portion:      portion,
+				Portioncount: portionCount,
+				Cal100:       &f.Cal100,
+				Prot100:      &f.Prot100,
+				Fat100:       &f.Fat100,
+				Carb100:      &f.Carb100,
+				Fiber100:     f.Fiber100,
+				Sugar100:     f.Sugar100,
+				Satfat100:    f.Satfat100,
+				Salt100:      f.Salt100,
+			})
+			if err != nil {
+				return err
+			}
+			return upd.Exec(ctx)
+		}
+		if !ent.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return upsert.
+		UpdateNewValues().
+		Update(updateJournalExtNutrients).
+		Exec(ctx)
+}
+
+// updateJournalExtNutrients sets extended nutrients snapshot on upsert, so
+// unknown nutrients of new values are cleared.
+func updateJournalExtNutrients(u *ent.JournalUpsert) {
+	u.UpdateFiber100()
+	u.UpdateSugar100()
+	u.UpdateSatfat100()
+	u.UpdateSalt100()
+}
+
+func (r *StorageSQLite) SetJournalBundle(ctx context.Context, userID int64, timestamp time.Time, dayTime time.Duration, meal Meal, bndlKey string, multiplier float64, mode JournalSetMode) error {
+	if !validDayTime(dayTime) || multiplier <= 0 {
+		return ErrJournalInvalid
+	}
+
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		if err := r.checkUserMeal(ctx, tx, userID, meal); err != nil {
+			return nil, err
+		}
+
 		// Get bundle
 		bndl, err := r.getBundle(ctx, tx, userID, bndlKey)
 		if err != nil {
@@ -612,28 +1721,19 @@ func (r *StorageSQLite) SetJournalBundle(ctx context.Context, userID int64, time
 
 		// Get food items from bundle.
 		resFood := make(map[string]float64)
-		err = r.getBundleFoodItems(ctx, tx, userID, bndl.Data, resFood)
+		err = r.getBundleFoodItems(ctx, tx, userID, bndl, resFood, make(map[string]struct{}))
 		if err != nil {
 			return nil, err
 		}
 
 		// Add food to journal.
 		for foodKey, foodWeight := range resFood {
-			food, err := r.getFoodForJournal(ctx, tx, foodKey)
+			food, err := r.getFoodForJournal(ctx, tx, userID, foodKey)
 			if err != nil {
 				return nil, err
 			}
 
-			_, err = tx.Journal.
-				Create().
-				SetUserid(userID).
-				SetTimestamp(timestamp).
-				SetMeal(int64(meal)).
-				SetFoodweight(foodWeight).
-				SetFood(food).
-				OnConflict().
-				UpdateNewValues().
-				ID(ctx)
+			err = r.setJournal(ctx, tx, userID, timestamp, dayTime, meal, food, foodWeight*multiplier, "", 0, mode)
 			if err != nil {
 				return nil, err
 			}
@@ -645,14 +1745,54 @@ func (r *StorageSQLite) SetJournalBundle(ctx context.Context, userID int64, time
 	return err
 }
 
-func (r *StorageSQLite) getFoodForJournal(ctx context.Context, tx *ent.Tx, key string) (*ent.Food, error) {
-	food, err := tx.Food.
-		Query().
-		Where(food.Key(key)).
-		First(ctx)
+// updateJournalNutrition sets journal entries nutrition snapshot from current food.
+func (r *StorageSQLite) updateJournalNutrition(ctx context.Context, tx *ent.Tx, ps ...predicate.Journal) (int, error) {
+	return tx.Journal.
+		Update().
+		Where(ps...).
+		Modify(func(u *entsql.UpdateBuilder) {
+			for jField, fField := range map[string]string{
+				journal.FieldCal100:    food.FieldCal100,
+				journal.FieldProt100:   food.FieldProt100,
+				journal.FieldFat100:    food.FieldFat100,
+				journal.FieldCarb100:   food.FieldCarb100,
+				journal.FieldFiber100:  food.FieldFiber100,
+				journal.FieldSugar100:  food.FieldSugar100,
+				journal.FieldSatfat100: food.FieldSatfat100,
+				journal.FieldSalt100:   food.FieldSalt100,
+			} {
+				u.Set(jField, entsql.Expr(fmt.Sprintf(
+					"(SELECT `%s`.`%s` FROM `%s` WHERE `%s`.`%s` = `%s`.`%s`)",
+					food.Table, fField,
+					food.Table,
+					food.Table, food.FieldID,
+					journal.Table, journal.FoodColumn,
+				)))
+			}
+		}).
+		Save(ctx)
+}
+
+func (r *StorageSQLite) RecalcJournal(ctx context.Context, userID int64, from, to time.Time) (int, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return r.updateJournalNutrition(ctx, tx,
+			journal.Userid(userID),
+			journal.TimestampGTE(from),
+			journal.TimestampLTE(to),
+		)
+	})
+
+	cnt := 0
+	if err == nil {
+		cnt, _ = res.(int)
+	}
+	return cnt, err
+}
 
+func (r *StorageSQLite) getFoodForJournal(ctx context.Context, tx *ent.Tx, userID int64, key string) (*ent.Food, error) {
+	food, err := r.getFood(ctx, tx, userID, key)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, ErrFoodNotFound) {
 			return nil, ErrJournalInvalidFood
 		}
 		return nil, err
@@ -661,6 +1801,33 @@ func (r *StorageSQLite) getFoodForJournal(ctx context.Context, tx *ent.Tx, key s
 	return food, nil
 }
 
+// dayTimeToDB converts journal time of day to seconds since start of day,
+// nil for legacy entry without time.
+func dayTimeToDB(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	v := int64(d.Seconds())
+	return &v
+}
+
+func dayTimeFromDB(v *int64) *time.Duration {
+	if v == nil {
+		return nil
+	}
+	d := time.Duration(*v) * time.Second
+	return &d
+}
+
+// journalDayTime is predicate of journal entries with time of day, nil
+// matches legacy entries without time.
+func journalDayTime(v *int64) predicate.Journal {
+	if v == nil {
+		return journal.DaytimeIsNil()
+	}
+	return journal.Daytime(*v)
+}
+
 func (r *StorageSQLite) DeleteJournal(ctx context.Context, userID int64, timestamp time.Time, meal Meal, foodkey string) error {
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
 		return tx.Journal.
@@ -677,6 +1844,23 @@ func (r *StorageSQLite) DeleteJournal(ctx context.Context, userID int64, timesta
 	return err
 }
 
+func (r *StorageSQLite) DeleteJournalEntry(ctx context.Context, userID int64, timestamp time.Time, dayTime *time.Duration, meal Meal, foodkey string) error {
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return tx.Journal.
+			Delete().
+			Where(
+				journal.Userid(userID),
+				journal.Timestamp(timestamp),
+				journalDayTime(dayTimeToDB(dayTime)),
+				journal.Meal(int64(meal)),
+				journal.HasFoodWith(food.Key(foodkey)),
+			).
+			Exec(ctx)
+	})
+
+	return err
+}
+
 func (r *StorageSQLite) DeleteJournalMeal(ctx context.Context, userID int64, timestamp time.Time, meal Meal) error {
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
 		return tx.Journal.
@@ -703,6 +1887,7 @@ func (r *StorageSQLite) GetJournalMealReport(ctx context.Context, userID int64,
 				journal.Meal(int64(meal)),
 			).
 			Order(
+				journal.ByDaytime(),
 				journal.ByFoodField(food.FieldName),
 			).
 			WithFood().
@@ -721,15 +1906,18 @@ func (r *StorageSQLite) GetJournalMealReport(ctx context.Context, userID int64,
 	lst := make([]JournalMealItem, 0, len(jLst))
 	var mealCal float64
 	for _, item := range jLst {
-		cal := item.Foodweight / 100 * item.Edges.Food.Cal100
+		cal := item.Foodweight / 100 * *item.Cal100
 		mealCal += cal
 		lst = append(lst, JournalMealItem{
-			Timestamp:  item.Timestamp,
-			FoodKey:    item.Edges.Food.Key,
-			FoodName:   item.Edges.Food.Name,
-			FoodBrand:  item.Edges.Food.Brand,
-			FoodWeight: item.Foodweight,
-			Cal:        cal,
+			Timestamp:    item.Timestamp,
+			DayTime:      dayTimeFromDB(item.Daytime),
+			FoodKey:      item.Edges.Food.Key,
+			FoodName:     item.Edges.Food.Name,
+			FoodBrand:    item.Edges.Food.Brand,
+			FoodWeight:   item.Foodweight,
+			Portion:      item.Portion,
+			PortionCount: item.Portioncount,
+			Cal:          cal,
 		})
 	}
 
@@ -742,16 +1930,10 @@ func (r *StorageSQLite) GetJournalMealReport(ctx context.Context, userID int64,
 				journal.Timestamp(timestamp),
 			).
 			Modify(func(s *entsql.Selector) {
-				f := entsql.Table(food.Table)
 				s.
-					Join(f).
-					On(
-						s.C(journal.FoodColumn),
-						f.C(food.FieldID),
-					).
 					Select(
 						entsql.Sum(
-							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldCal100)),
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldCal100)),
 						),
 					)
 			}).
@@ -771,13 +1953,17 @@ func (r *StorageSQLite) GetJournalMealReport(ctx context.Context, userID int64,
 func (r *StorageSQLite) GetJournalReport(ctx context.Context, userID int64, from, to time.Time) ([]JournalReport, error) {
 	var res []struct {
 		ent.Journal
-		FoodKey   string  `sql:"foodkey"`
-		FoodName  string  `sql:"foodname"`
-		FoodBrand string  `sql:"foodbrand"`
-		Cal       float64 `sql:"cal"`
-		Prot      float64 `sql:"prot"`
-		Fat       float64 `sql:"fat"`
-		Carb      float64 `sql:"carb"`
+		FoodKey   string   `sql:"foodkey"`
+		FoodName  string   `sql:"foodname"`
+		FoodBrand string   `sql:"foodbrand"`
+		Cal       float64  `sql:"cal"`
+		Prot      float64  `sql:"prot"`
+		Fat       float64  `sql:"fat"`
+		Carb      float64  `sql:"carb"`
+		Fiber     *float64 `sql:"fiber"`
+		Sugar     *float64 `sql:"sugar"`
+		SatFat    *float64 `sql:"satfat"`
+		Salt      *float64 `sql:"salt"`
 	}
 
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
@@ -801,25 +1987,42 @@ func (r *StorageSQLite) GetJournalReport(ctx context.Context, userID int64, from
 						entsql.As(f.C(food.FieldName), "foodname"),
 						entsql.As(f.C(food.FieldBrand), "foodbrand"),
 						entsql.As(
-							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldCal100)),
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldCal100)),
 							"cal",
 						),
 						entsql.As(
-							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldProt100)),
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldProt100)),
 							"prot",
 						),
 						entsql.As(
-							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldFat100)),
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldFat100)),
 							"fat",
 						),
 						entsql.As(
-							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldCarb100)),
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldCarb100)),
 							"carb",
 						),
+						entsql.As(
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldFiber100)),
+							"fiber",
+						),
+						entsql.As(
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldSugar100)),
+							"sugar",
+						),
+						entsql.As(
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldSatfat100)),
+							"satfat",
+						),
+						entsql.As(
+							fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldSalt100)),
+							"salt",
+						),
 					).
 					OrderBy(
 						s.C(journal.FieldTimestamp),
 						s.C(journal.FieldMeal),
+						s.C(journal.FieldDaytime),
 						f.C(food.FieldName),
 					)
 			}).
@@ -839,16 +2042,23 @@ func (r *StorageSQLite) GetJournalReport(ctx context.Context, userID int64, from
 	lst := make([]JournalReport, 0, len(res))
 	for _, item := range res {
 		lst = append(lst, JournalReport{
-			Timestamp:  item.Timestamp,
-			Meal:       Meal(item.Meal),
-			FoodKey:    item.FoodKey,
-			FoodName:   item.FoodName,
-			FoodBrand:  item.FoodBrand,
-			FoodWeight: item.Foodweight,
-			Cal:        item.Cal,
-			Prot:       item.Prot,
-			Fat:        item.Fat,
-			Carb:       item.Carb,
+			Timestamp:    item.Timestamp,
+			DayTime:      dayTimeFromDB(item.Daytime),
+			Meal:         Meal(item.Meal),
+			FoodKey:      item.FoodKey,
+			FoodName:     item.FoodName,
+			FoodBrand:    item.FoodBrand,
+			FoodWeight:   item.Foodweight,
+			Portion:      item.Portion,
+			PortionCount: item.Portioncount,
+			Cal:          item.Cal,
+			Prot:         item.Prot,
+			Fat:          item.Fat,
+			Carb:         item.Carb,
+			Fiber:        item.Fiber,
+			Sugar:        item.Sugar,
+			SatFat:       item.SatFat,
+			Salt:         item.Salt,
 		})
 	}
 
@@ -862,6 +2072,16 @@ func (r *StorageSQLite) GetJournalStats(ctx context.Context, userID int64, from,
 		TotalProt float64   `sql:"totalProt"`
 		TotalFat  float64   `sql:"totalFat"`
 		TotalCarb float64   `sql:"totalCarb"`
+		// SUM of NULL only values is NULL.
+		TotalFiber  *float64 `sql:"totalFiber"`
+		TotalSugar  *float64 `sql:"totalSugar"`
+		TotalSatFat *float64 `sql:"totalSatFat"`
+		TotalSalt   *float64 `sql:"totalSalt"`
+		// Count of entries with unknown extended nutrient.
+		FiberUnknown  int `sql:"fiberUnknown"`
+		SugarUnknown  int `sql:"sugarUnknown"`
+		SatFatUnknown int `sql:"satFatUnknown"`
+		SaltUnknown   int `sql:"saltUnknown"`
 	}
 
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
@@ -873,39 +2093,61 @@ func (r *StorageSQLite) GetJournalStats(ctx context.Context, userID int64, from,
 				journal.TimestampLTE(to),
 			).
 			Modify(func(s *entsql.Selector) {
-				f := entsql.Table(food.Table)
 				s.
-					Join(f).
-					On(
-						s.C(journal.FoodColumn),
-						f.C(food.FieldID),
-					).
 					Select(
 						entsql.As(s.C(journal.FieldTimestamp), "timestamp"),
 						entsql.As(
 							entsql.Sum(
-								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldCal100)),
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldCal100)),
 							),
 							"totalCal",
 						),
 						entsql.As(
 							entsql.Sum(
-								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldProt100)),
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldProt100)),
 							),
 							"totalProt",
 						),
 						entsql.As(
 							entsql.Sum(
-								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldFat100)),
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldFat100)),
 							),
 							"totalFat",
 						),
 						entsql.As(
 							entsql.Sum(
-								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), f.C(food.FieldCarb100)),
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldCarb100)),
 							),
 							"totalCarb",
 						),
+						entsql.As(
+							entsql.Sum(
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldFiber100)),
+							),
+							"totalFiber",
+						),
+						entsql.As(
+							entsql.Sum(
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldSugar100)),
+							),
+							"totalSugar",
+						),
+						entsql.As(
+							entsql.Sum(
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldSatfat100)),
+							),
+							"totalSatFat",
+						),
+						entsql.As(
+							entsql.Sum(
+								fmt.Sprintf("%s / 100 * %s", s.C(journal.FieldFoodweight), s.C(journal.FieldSalt100)),
+							),
+							"totalSalt",
+						),
+						entsql.As(journalUnknownCount(s, journal.FieldFiber100), "fiberUnknown"),
+						entsql.As(journalUnknownCount(s, journal.FieldSugar100), "sugarUnknown"),
+						entsql.As(journalUnknownCount(s, journal.FieldSatfat100), "satFatUnknown"),
+						entsql.As(journalUnknownCount(s, journal.FieldSalt100), "saltUnknown"),
 					).
 					GroupBy(
 						s.C(journal.FieldTimestamp),
@@ -930,19 +2172,36 @@ func (r *StorageSQLite) GetJournalStats(ctx context.Context, userID int64, from,
 	lst := make([]JournalStats, 0, len(res))
 	for _, item := range res {
 		lst = append(lst, JournalStats{
-			Timestamp: item.Timestamp,
-			TotalCal:  item.TotalCal,
-			TotalProt: item.TotalProt,
-			TotalFat:  item.TotalFat,
-			TotalCarb: item.TotalCarb,
+			Timestamp:     item.Timestamp,
+			TotalCal:      item.TotalCal,
+			TotalProt:     item.TotalProt,
+			TotalFat:      item.TotalFat,
+			TotalCarb:     item.TotalCarb,
+			TotalFiber:    item.TotalFiber,
+			TotalSugar:    item.TotalSugar,
+			TotalSatFat:   item.TotalSatFat,
+			TotalSalt:     item.TotalSalt,
+			FiberUnknown:  item.FiberUnknown,
+			SugarUnknown:  item.SugarUnknown,
+			SatFatUnknown: item.SatFatUnknown,
+			SaltUnknown:   item.SaltUnknown,
 		})
 	}
 
 	return lst, nil
 }
 
+// journalUnknownCount returns expression counting entries with NULL column.
+func journalUnknownCount(s *entsql.Selector, column string) string {
+	return fmt.Sprintf("SUM(CASE WHEN %s IS NULL THEN 1 ELSE 0 END)", s.C(column))
+}
+
 func (r *StorageSQLite) CopyJournal(ctx context.Context, userID int64, from time.Time, mealFrom Meal, to time.Time, mealTo Meal) (int, error) {
 	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		if err := r.checkUserMeal(ctx, tx, userID, mealTo); err != nil {
+			return nil, err
+		}
+
 		// Check that destination is empty
 		cnt, err := tx.Journal.
 			Query().
@@ -983,8 +2242,19 @@ func (r *StorageSQLite) CopyJournal(ctx context.Context, userID int64, from time
 				Create().
 				SetUserid(userID).
 				SetTimestamp(to).
+				SetNillableDaytime(item.Daytime).
 				SetMeal(int64(mealTo)).
 				SetFoodweight(item.Foodweight).
+				SetPortion(item.Portion).
+				SetPortioncount(item.Portioncount).
+				SetNillableCal100(item.Cal100).
+				SetNillableProt100(item.Prot100).
+				SetNillableFat100(item.Fat100).
+				SetNillableCarb100(item.Carb100).
+				SetNillableFiber100(item.Fiber100).
+				SetNillableSugar100(item.Sugar100).
+				SetNillableSatfat100(item.Satfat100).
+				SetNillableSalt100(item.Salt100).
 				SetFoodID(item.Edges.Food.ID),
 			)
 		}
@@ -1006,10 +2276,7 @@ func (r *StorageSQLite) GetJournalFoodAvgWeight(ctx context.Context, userID int6
 	}
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
 		// Get food
-		foodObj, err := tx.Food.
-			Query().
-			Where(food.Key(foodkey)).
-			First(ctx)
+		foodObj, err := r.getFood(ctx, tx, userID, foodkey)
 		if err != nil {
 			return nil, err
 		}
@@ -1030,7 +2297,7 @@ func (r *StorageSQLite) GetJournalFoodAvgWeight(ctx context.Context, userID int6
 	})
 
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, ErrFoodNotFound) {
 			return 0, ErrJournalInvalidFood
 		}
 
@@ -1059,25 +2326,148 @@ func (r *StorageSQLite) GetUserSettings(ctx context.Context, userID int64) (*Use
 		return nil, err
 	}
 
-	us, _ := res.(*ent.UserSettings)
+	us, _ := res.(*ent.UserSettings)
+
+	return &UserSettings{
+		CalLimit:         us.CalLimit,
+		DefaultActiveCal: us.DefaultActiveCal,
+	}, nil
+}
+
+func (r *StorageSQLite) SetUserSettings(ctx context.Context, userID int64, settings *UserSettings) error {
+	if !settings.Validate() {
+		return ErrUserSettingsInvalid
+	}
+
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return tx.UserSettings.
+			Create().
+			SetUserid(userID).
+			SetCalLimit(settings.CalLimit).
+			SetDefaultActiveCal(settings.DefaultActiveCal).
+			OnConflict().
+			UpdateNewValues().
+			ID(ctx)
+	})
+
+	return err
+}
+
+//
+// UserMeal
+//
+
+func (r *StorageSQLite) GetUserMeals(ctx context.Context, userID int64) (UserMeals, error) {
+	res, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		return r.getUserMeals(ctx, tx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	meals, _ := res.(UserMeals)
+	return meals, nil
+}
+
+func (r *StorageSQLite) getUserMeals(ctx context.Context, tx *ent.Tx, userID int64) (UserMeals, error) {
+	umLst, err := tx.UserMeal.
+		Query().
+		Where(usermeal.Userid(userID)).
+		Order(usermeal.ByMeal()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(umLst) == 0 {
+		return DefaultUserMeals(), nil
+	}
+
+	meals := make(UserMeals, 0, len(umLst))
+	for _, um := range umLst {
+		meals = append(meals, UserMeal{
+			Meal:    Meal(um.Meal),
+			Name:    um.Name,
+			Aliases: um.Aliases,
+		})
+	}
+
+	return meals, nil
+}
+
+// checkUserMeal returns ErrUserMealNotFound if meal is not one of user meals.
+func (r *StorageSQLite) checkUserMeal(ctx context.Context, tx *ent.Tx, userID int64, meal Meal) error {
+	meals, err := r.getUserMeals(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !meals.Has(meal) {
+		return ErrUserMealNotFound
+	}
+
+	return nil
+}
+
+// saveDefaultUserMeals stores default meals for user without own meals,
+// so changing one meal keeps others.
+func (r *StorageSQLite) saveDefaultUserMeals(ctx context.Context, tx *ent.Tx, userID int64) error {
+	cnt, err := tx.UserMeal.
+		Query().
+		Where(usermeal.Userid(userID)).
+		Count(ctx)
+	if err != nil || cnt != 0 {
+		return err
+	}
+
+	defMeals := DefaultUserMeals()
+	bulk := make([]*ent.UserMealCreate, 0, len(defMeals))
+	for _, m := range defMeals {
+		bulk = append(bulk, tx.UserMeal.
+			Create().
+			SetUserid(userID).
+			SetMeal(int64(m.Meal)).
+			SetName(m.Name).
+			SetAliases([]string{}),
+		)
+	}
 
-	return &UserSettings{
-		CalLimit:         us.CalLimit,
-		DefaultActiveCal: us.DefaultActiveCal,
-	}, nil
+	return tx.UserMeal.CreateBulk(bulk...).Exec(ctx)
 }
 
-func (r *StorageSQLite) SetUserSettings(ctx context.Context, userID int64, settings *UserSettings) error {
-	if !settings.Validate() {
-		return ErrUserSettingsInvalid
+func (r *StorageSQLite) SetUserMeal(ctx context.Context, userID int64, meal *UserMeal) error {
+	if !meal.Validate() {
+		return ErrUserMealInvalid
 	}
 
 	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
-		return tx.UserSettings.
+		if err := r.saveDefaultUserMeals(ctx, tx, userID); err != nil {
+			return nil, err
+		}
+
+		// Check that name and aliases not used by other meals.
+		meals, err := r.getUserMeals(ctx, tx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range meal.names() {
+			if m, ok := meals.Resolve(name); ok && m != meal.Meal {
+				return nil, ErrUserMealConflict
+			}
+		}
+
+		aliases := meal.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+
+		return tx.UserMeal.
 			Create().
 			SetUserid(userID).
-			SetCalLimit(settings.CalLimit).
-			SetDefaultActiveCal(settings.DefaultActiveCal).
+			SetMeal(int64(meal.Meal)).
+			SetName(meal.Name).
+			SetAliases(aliases).
 			OnConflict().
 			UpdateNewValues().
 			ID(ctx)
@@ -1086,6 +2476,62 @@ func (r *StorageSQLite) SetUserSettings(ctx context.Context, userID int64, setti
 	return err
 }
 
+func (r *StorageSQLite) DeleteUserMeal(ctx context.Context, userID int64, meal Meal) error {
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		if err := r.saveDefaultUserMeals(ctx, tx, userID); err != nil {
+			return nil, err
+		}
+
+		// Check meal not used in journal.
+		cnt, err := tx.Journal.
+			Query().
+			Where(
+				journal.Userid(userID),
+				journal.Meal(int64(meal)),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if cnt != 0 {
+			return nil, ErrUserMealIsUsed
+		}
+
+		// Check meal is not last one.
+		cnt, err = tx.UserMeal.
+			Query().
+			Where(usermeal.Userid(userID)).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if cnt == 1 {
+			return nil, ErrUserMealIsLast
+		}
+
+		deleted, err := tx.UserMeal.
+			Delete().
+			Where(
+				usermeal.Userid(userID),
+				usermeal.Meal(int64(meal)),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if deleted == 0 {
+			return nil, ErrUserMealNotFound
+		}
+
+		return nil, nil
+	})
+
+	return err
+}
+
 //
 // Activity.
 //
@@ -1181,14 +2627,10 @@ func (r *StorageSQLite) DeleteActivity(ctx context.Context, userID int64, timest
 
 func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 	backup := &Backup{
+		Version:   BackupVersion,
 		Timestamp: time.Now().UnixMilli(),
 	}
 
-	// Fix timestamp to start of day in local TZ (Europe/Moscow)
-	ts_fix := func(ts int64) int64 {
-		return ts - 10800000
-	}
-
 	if _, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
 		var err error
 
@@ -1203,9 +2645,9 @@ func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 		backup.Weight = make([]WeightBackup, 0, len(wLst))
 		for _, w := range wLst {
 			backup.Weight = append(backup.Weight, WeightBackup{
-				UserID:    w.Userid,
-				Timestamp: ts_fix(w.Timestamp.UnixMilli()),
-				Value:     w.Value,
+				UserID: w.Userid,
+				Date:   w.Timestamp.Format(BackupDateFormat),
+				Value:  w.Value,
 			})
 		}
 
@@ -1220,14 +2662,20 @@ func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 		backup.Food = make([]FoodBackup, 0, len(fLst))
 		for _, f := range fLst {
 			backup.Food = append(backup.Food, FoodBackup{
-				Key:     f.Key,
-				Name:    f.Name,
-				Brand:   f.Brand,
-				Cal100:  f.Cal100,
-				Prot100: f.Prot100,
-				Fat100:  f.Fat100,
-				Carb100: f.Carb100,
-				Comment: f.Comment,
+				UserID:    f.Userid,
+				Key:       f.Key,
+				Name:      f.Name,
+				Brand:     f.Brand,
+				Cal100:    f.Cal100,
+				Prot100:   f.Prot100,
+				Fat100:    f.Fat100,
+				Carb100:   f.Carb100,
+				Comment:   f.Comment,
+				Fiber100:  f.Fiber100,
+				Sugar100:  f.Sugar100,
+				SatFat100: f.Satfat100,
+				Salt100:   f.Salt100,
+				Portions:  f.Portions,
 			})
 		}
 
@@ -1243,11 +2691,23 @@ func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 		backup.Journal = make([]JournalBackup, 0, len(jLst))
 		for _, j := range jLst {
 			backup.Journal = append(backup.Journal, JournalBackup{
-				UserID:     j.Userid,
-				Timestamp:  ts_fix(j.Timestamp.UnixMilli()),
-				Meal:       int64(j.Meal),
-				FoodKey:    j.Edges.Food.Key,
-				FoodWeight: j.Foodweight,
+				UserID:       j.Userid,
+				Date:         j.Timestamp.Format(BackupDateFormat),
+				DayTime:      j.Daytime,
+				Meal:         int64(j.Meal),
+				FoodKey:      j.Edges.Food.Key,
+				FoodUserID:   j.Edges.Food.Userid,
+				FoodWeight:   j.Foodweight,
+				Cal100:       j.Cal100,
+				Prot100:      j.Prot100,
+				Fat100:       j.Fat100,
+				Carb100:      j.Carb100,
+				Fiber100:     j.Fiber100,
+				Sugar100:     j.Sugar100,
+				SatFat100:    j.Satfat100,
+				Salt100:      j.Salt100,
+				Portion:      j.Portion,
+				PortionCount: j.Portioncount,
 			})
 		}
 
@@ -1268,7 +2728,7 @@ func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 			})
 		}
 
-		// User settomgs.
+		// User settings.
 		usLst, err := tx.UserSettings.
 			Query().
 			All(ctx)
@@ -1279,8 +2739,64 @@ func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 		backup.UserSettings = make([]UserSettingsBackup, 0, len(usLst))
 		for _, us := range usLst {
 			backup.UserSettings = append(backup.UserSettings, UserSettingsBackup{
-				UserID:   us.Userid,
-				CalLimit: us.CalLimit,
+				UserID:           us.Userid,
+				CalLimit:         us.CalLimit,
+				DefaultActiveCal: us.DefaultActiveCal,
+			})
+		}
+
+		// Activity.
+		aLst, err := tx.Activity.
+			Query().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		backup.Activity = make([]ActivityBackup, 0, len(aLst))
+		for _, a := range aLst {
+			backup.Activity = append(backup.Activity, ActivityBackup{
+				UserID:    a.Userid,
+				Date:      a.Timestamp.Format(BackupDateFormat),
+				ActiveCal: a.ActiveCal,
+			})
+		}
+
+		// User meals.
+		umLst, err := tx.UserMeal.
+			Query().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		backup.UserMeals = make([]UserMealBackup, 0, len(umLst))
+		for _, um := range umLst {
+			backup.UserMeals = append(backup.UserMeals, UserMealBackup{
+				UserID:  um.Userid,
+				Meal:    um.Meal,
+				Name:    um.Name,
+				Aliases: um.Aliases,
+			})
+		}
+
+		// Recipes.
+		rLst, err := tx.Recipe.
+			Query().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		backup.Recipes = make([]RecipeBackup, 0, len(rLst))
+		for _, rcp := range rLst {
+			backup.Recipes = append(backup.Recipes, RecipeBackup{
+				UserID:       rcp.Userid,
+				Key:          rcp.Key,
+				Name:         rcp.Name,
+				Ingredients:  rcp.Ingredients,
+				CookedWeight: rcp.Cookedweight,
+				Tare:         rcp.Tare,
 			})
 		}
 
@@ -1291,3 +2807,322 @@ func (r *StorageSQLite) Backup(ctx context.Context) (*Backup, error) {
 
 	return backup, nil
 }
+
+func (r *StorageSQLite) Restore(ctx context.Context, backup *Backup, mode RestoreMode) error {
+	// Default active cal exists in backup since version 2.
+	withDefaultActiveCal := backup.Version >= 2
+	// Activity exists in backup since version 2.
+	withActivity := backup.Version >= 2
+	// User meals exist in backup since version 3.
+	withUserMeals := backup.Version >= 3
+	// Recipes exist in backup since version 5.
+	withRecipes := backup.Version >= 5
+
+	if err := backup.Upgrade(); err != nil {
+		return err
+	}
+
+	parseDate := func(date string) (time.Time, error) {
+		ts, err := time.Parse(BackupDateFormat, date)
+		if err != nil {
+			return time.Time{}, ErrBackupInvalid
+		}
+		return ts, nil
+	}
+
+	_, err := r.doTx(ctx, func(ctx context.Context, tx *ent.Tx) (any, error) {
+		var err error
+
+		// Clear existing data of backup users, data of other users is kept.
+		if mode == RestoreModeReplace {
+			userIDs := backup.UserIDs()
+
+			// Journal first, because it references food.
+			if _, err = tx.Journal.Delete().Where(journal.UseridIn(userIDs...)).Exec(ctx); err != nil {
+				return nil, err
+			}
+			if _, err = tx.Food.Delete().Where(food.UseridIn(userIDs...)).Exec(ctx); err != nil {
+				return nil, err
+			}
+			if _, err = tx.Bundle.Delete().Where(bundle.UseridIn(userIDs...)).Exec(ctx); err != nil {
+				return nil, err
+			}
+			if _, err = tx.Weight.Delete().Where(weight.UseridIn(userIDs...)).Exec(ctx); err != nil {
+				return nil, err
+			}
+			if _, err = tx.UserSettings.Delete().Where(usersettings.UseridIn(userIDs...)).Exec(ctx); err != nil {
+				return nil, err
+			}
+			if withActivity {
+				if _, err = tx.Activity.Delete().Where(activity.UseridIn(userIDs...)).Exec(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if withUserMeals {
+				if _, err = tx.UserMeal.Delete().Where(usermeal.UseridIn(userIDs...)).Exec(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if withRecipes {
+				if _, err = tx.Recipe.Delete().Where(recipe.UseridIn(userIDs...)).Exec(ctx); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// Weight.
+		for _, w := range backup.Weight {
+			ts, err := parseDate(w.Date)
+			if err != nil {
+				return nil, err
+			}
+
+			err = tx.Weight.
+				Create().
+				SetUserid(w.UserID).
+				SetTimestamp(ts).
+				SetValue(w.Value).
+				OnConflict().
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Food.
+		for _, f := range backup.Food {
+			err = tx.Food.
+				Create().
+				SetUserid(f.UserID).
+				SetKey(f.Key).
+				SetName(f.Name).
+				SetBrand(f.Brand).
+				SetCal100(f.Cal100).
+				SetProt100(f.Prot100).
+				SetFat100(f.Fat100).
+				SetCarb100(f.Carb100).
+				SetNillableFiber100(f.Fiber100).
+				SetNillableSugar100(f.Sugar100).
+				SetNillableSatfat100(f.SatFat100).
+				SetNillableSalt100(f.Salt100).
+				SetComment(f.Comment).
+				SetPortions(f.Portions).
+				OnConflict().
+				UpdateNewValues().
+				Update(updateFoodExtNutrients).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Journal.
+		fLst, err := tx.Food.
+			Query().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		type foodKey struct {
+			key    string
+			userID int64
+		}
+
+		foodIDs := make(map[foodKey]int, len(fLst))
+		for _, f := range fLst {
+			foodIDs[foodKey{f.Key, f.Userid}] = f.ID
+		}
+
+		for _, j := range backup.Journal {
+			foodID, ok := foodIDs[foodKey{j.FoodKey, j.FoodUserID}]
+			if !ok {
+				return nil, ErrJournalInvalidFood
+			}
+
+			ts, err := parseDate(j.Date)
+			if err != nil {
+				return nil, err
+			}
+
+			if dt := dayTimeFromDB(j.DayTime); dt != nil && !validDayTime(*dt) {
+				return nil, ErrBackupInvalid
+			}
+
+			// Entries without time are not matched by unique index on
+			// conflict, so existing entry is replaced explicitly.
+			if j.DayTime == nil {
+				_, err = tx.Journal.
+					Delete().
+					Where(
+						journal.Userid(j.UserID),
+						journal.Timestamp(ts),
+						journal.DaytimeIsNil(),
+						journal.Meal(j.Meal),
+						journal.HasFoodWith(food.ID(foodID)),
+					).
+					Exec(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			err = tx.Journal.
+				Create().
+				SetUserid(j.UserID).
+				SetTimestamp(ts).
+				SetNillableDaytime(j.DayTime).
+				SetMeal(j.Meal).
+				SetFoodweight(j.FoodWeight).
+				SetPortion(j.Portion).
+				SetPortioncount(j.PortionCount).
+				SetNillableCal100(j.Cal100).
+				SetNillableProt100(j.Prot100).
+				SetNillableFat100(j.Fat100).
+				SetNillableCarb100(j.Carb100).
+				SetNillableFiber100(j.Fiber100).
+				SetNillableSugar100(j.Sugar100).
+				SetNillableSatfat100(j.SatFat100).
+				SetNillableSalt100(j.Salt100).
+				SetFoodID(foodID).
+				OnConflict().
+				UpdateNewValues().
+				Update(updateJournalExtNutrients).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Backup before version 4 has no nutrition snapshot.
+		if _, err = r.updateJournalNutrition(ctx, tx, journal.Cal100IsNil()); err != nil {
+			return nil, err
+		}
+
+		// Bundle.
+		for _, b := range backup.Bundle {
+			err = tx.Bundle.
+				Create().
+				SetUserid(b.UserID).
+				SetKey(b.Key).
+				SetData(b.Data).
+				OnConflict().
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// User settings.
+		for _, us := range backup.UserSettings {
+			upsert := tx.UserSettings.
+				Create().
+				SetUserid(us.UserID).
+				SetCalLimit(us.CalLimit).
+				SetDefaultActiveCal(us.DefaultActiveCal).
+				OnConflict()
+
+			// Version 1 has no default active cal, keep existing one.
+			if withDefaultActiveCal {
+				err = upsert.UpdateNewValues().Exec(ctx)
+			} else {
+				err = upsert.UpdateCalLimit().Exec(ctx)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Activity.
+		for _, a := range backup.Activity {
+			ts, err := parseDate(a.Date)
+			if err != nil {
+				return nil, err
+			}
+
+			err = tx.Activity.
+				Create().
+				SetUserid(a.UserID).
+				SetTimestamp(ts).
+				SetActiveCal(a.ActiveCal).
+				OnConflict().
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// User meals.
+		for _, um := range backup.UserMeals {
+			aliases := um.Aliases
+			if aliases == nil {
+				aliases = []string{}
+			}
+
+			err = tx.UserMeal.
+				Create().
+				SetUserid(um.UserID).
+				SetMeal(um.Meal).
+				SetName(um.Name).
+				SetAliases(aliases).
+				OnConflict().
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Journal meals must exist in meals of user after restore.
+		userMeals := make(map[int64]UserMeals)
+		for _, j := range backup.Journal {
+			meals, ok := userMeals[j.UserID]
+			if !ok {
+				if meals, err = r.getUserMeals(ctx, tx, j.UserID); err != nil {
+					return nil, err
+				}
+				userMeals[j.UserID] = meals
+			}
+
+			if !meals.Has(Meal(j.Meal)) {
+				return nil, fmt.Errorf("backup journal meal %d of user %d: %w", j.Meal, j.UserID, ErrUserMealNotFound)
+			}
+		}
+
+		// Recipes, recipe food is restored with food.
+		for _, rcp := range backup.Recipes {
+			mr := Recipe{
+				Key:          rcp.Key,
+				Name:         rcp.Name,
+				Ingredients:  rcp.Ingredients,
+				CookedWeight: rcp.CookedWeight,
+				Tare:         rcp.Tare,
+			}
+			if !mr.Validate() {
+				return nil, ErrBackupInvalid
+			}
+
+			err = tx.Recipe.
+				Create().
+				SetUserid(rcp.UserID).
+				SetKey(rcp.Key).
+				SetName(rcp.Name).
+				SetIngredients(rcp.Ingredients).
+				SetCookedweight(rcp.CookedWeight).
+				SetTare(rcp.Tare).
+				OnConflict().
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+
+	return err
+}
@@ -2,10 +2,12 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/devldavydov/myfood/internal/storage/ent/bundle"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -185,40 +187,40 @@ func (r *StorageSQLiteTestSuite) TestUserSettingsCRU() {
 
 func (r *StorageSQLiteTestSuite) TestFoodCRU() {
 	r.Run("get food not exists", func() {
-		food, err := r.stg.GetFood(context.TODO(), "key1")
+		food, err := r.stg.GetFood(context.TODO(), 1, "key1")
 		r.Nil(food)
 		r.ErrorIs(err, ErrFoodNotFound)
 	})
 
 	r.Run("create invalid food", func() {
-		r.ErrorIs(r.stg.SetFood(context.TODO(), &Food{
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "", Name: "Name", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: 1,
 		}), ErrFoodInvalid)
-		r.ErrorIs(r.stg.SetFood(context.TODO(), &Food{
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: 1,
 		}), ErrFoodInvalid)
-		r.ErrorIs(r.stg.SetFood(context.TODO(), &Food{
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "Name", Cal100: -1, Prot100: 1, Fat100: 1, Carb100: 1,
 		}), ErrFoodInvalid)
-		r.ErrorIs(r.stg.SetFood(context.TODO(), &Food{
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "Name", Cal100: 1, Prot100: -1, Fat100: 1, Carb100: 1,
 		}), ErrFoodInvalid)
-		r.ErrorIs(r.stg.SetFood(context.TODO(), &Food{
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "Name", Cal100: 1, Prot100: 1, Fat100: -1, Carb100: 1,
 		}), ErrFoodInvalid)
-		r.ErrorIs(r.stg.SetFood(context.TODO(), &Food{
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "Name", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: -1,
 		}), ErrFoodInvalid)
 	})
 
 	r.Run("create valid food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "Name", Brand: "Brand", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
 		}))
 	})
 
 	r.Run("get food", func() {
-		food, err := r.stg.GetFood(context.TODO(), "Key")
+		food, err := r.stg.GetFood(context.TODO(), 1, "Key")
 		r.NoError(err)
 		r.Equal(&Food{
 			Key: "Key", Name: "Name", Brand: "Brand", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
@@ -226,11 +228,11 @@ func (r *StorageSQLiteTestSuite) TestFoodCRU() {
 	})
 
 	r.Run("update food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key", Name: "Name 2", Brand: "Brand 2", Cal100: 10, Prot100: 20, Fat100: 30, Carb100: 40, Comment: "Comment 2",
 		}))
 
-		food, err := r.stg.GetFood(context.TODO(), "Key")
+		food, err := r.stg.GetFood(context.TODO(), 1, "Key")
 		r.NoError(err)
 		r.Equal(&Food{
 			Key: "Key", Name: "Name 2", Brand: "Brand 2", Cal100: 10, Prot100: 20, Fat100: 30, Carb100: 40, Comment: "Comment 2",
@@ -240,25 +242,25 @@ func (r *StorageSQLiteTestSuite) TestFoodCRU() {
 
 func (r *StorageSQLiteTestSuite) TestFoodList() {
 	r.Run("get empty list", func() {
-		lst, err := r.stg.GetFoodList(context.TODO())
+		lst, err := r.stg.GetFoodList(context.TODO(), 1)
 		r.ErrorIs(err, ErrFoodEmptyList)
 		r.Nil(lst)
 	})
 
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key1", Name: "bbb", Brand: "Brand1", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment1",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key2", Name: "aaa", Brand: "Brand2", Cal100: 4, Prot100: 5, Fat100: 6, Carb100: 7, Comment: "Comment2",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key3", Name: "ccc", Brand: "Brand3", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "Comment3",
 		}))
 	})
 
 	r.Run("get list", func() {
-		lst, err := r.stg.GetFoodList(context.TODO())
+		lst, err := r.stg.GetFoodList(context.TODO(), 1)
 		r.NoError(err)
 		r.Equal([]Food{
 			{Key: "Key2", Name: "aaa", Brand: "Brand2", Cal100: 4, Prot100: 5, Fat100: 6, Carb100: 7, Comment: "Comment2"},
@@ -270,25 +272,25 @@ func (r *StorageSQLiteTestSuite) TestFoodList() {
 
 func (r *StorageSQLiteTestSuite) TestFindFood() {
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "kFind", Name: "bbb", Brand: "Brand1", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment1",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key2", Name: "nfind", Brand: "Brand2", Cal100: 4, Prot100: 5, Fat100: 6, Carb100: 7, Comment: "Comment2",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key3", Name: "ccc", Brand: "bfind", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "Comment3",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key4", Name: "ddd", Brand: "Brand3", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "cfind",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "едрус", Name: "Еда Русская", Brand: "Brand3", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "руСКом",
 		}))
 	})
 
 	r.Run("find by key", func() {
-		lst, err := r.stg.FindFood(context.TODO(), "kfind")
+		lst, err := r.stg.FindFood(context.TODO(), 1, "kfind", 0, 0)
 		r.NoError(err)
 		r.Equal([]Food{
 			{Key: "kFind", Name: "bbb", Brand: "Brand1", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment1"},
@@ -296,7 +298,7 @@ func (r *StorageSQLiteTestSuite) TestFindFood() {
 	})
 
 	r.Run("find by name", func() {
-		lst, err := r.stg.FindFood(context.TODO(), "Nfind")
+		lst, err := r.stg.FindFood(context.TODO(), 1, "Nfind", 0, 0)
 		r.NoError(err)
 		r.Equal([]Food{
 			{Key: "Key2", Name: "nfind", Brand: "Brand2", Cal100: 4, Prot100: 5, Fat100: 6, Carb100: 7, Comment: "Comment2"},
@@ -304,7 +306,7 @@ func (r *StorageSQLiteTestSuite) TestFindFood() {
 	})
 
 	r.Run("find by brand", func() {
-		lst, err := r.stg.FindFood(context.TODO(), "bfind")
+		lst, err := r.stg.FindFood(context.TODO(), 1, "bfind", 0, 0)
 		r.NoError(err)
 		r.Equal([]Food{
 			{Key: "Key3", Name: "ccc", Brand: "bfind", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "Comment3"},
@@ -312,7 +314,7 @@ func (r *StorageSQLiteTestSuite) TestFindFood() {
 	})
 
 	r.Run("find by comment", func() {
-		lst, err := r.stg.FindFood(context.TODO(), "cfind")
+		lst, err := r.stg.FindFood(context.TODO(), 1, "cfind", 0, 0)
 		r.NoError(err)
 		r.Equal([]Food{
 			{Key: "Key4", Name: "ddd", Brand: "Brand3", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "cfind"},
@@ -320,7 +322,7 @@ func (r *StorageSQLiteTestSuite) TestFindFood() {
 	})
 
 	r.Run("find all k", func() {
-		lst, err := r.stg.FindFood(context.TODO(), "k")
+		lst, err := r.stg.FindFood(context.TODO(), 1, "k", 0, 0)
 		r.NoError(err)
 		r.Equal([]Food{
 			{Key: "kFind", Name: "bbb", Brand: "Brand1", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment1"},
@@ -332,7 +334,7 @@ func (r *StorageSQLiteTestSuite) TestFindFood() {
 
 	r.Run("find non latin", func() {
 		for _, pattern := range []string{"рус", "ЕДА", "еДа", "сК"} {
-			lst, err := r.stg.FindFood(context.TODO(), pattern)
+			lst, err := r.stg.FindFood(context.TODO(), 1, pattern, 0, 0)
 			r.NoError(err)
 			r.Equal([]Food{
 				{Key: "едрус", Name: "Еда Русская", Brand: "Brand3", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "руСКом"},
@@ -341,59 +343,563 @@ func (r *StorageSQLiteTestSuite) TestFindFood() {
 	})
 }
 
+func (r *StorageSQLiteTestSuite) TestFindFoodWords() {
+	r.Run("add food", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "гречка", Name: "Гречка отварная", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "греч_сух", Name: "Крупа гречневая", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "other", Name: "aaa", Comment: "sugar free", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "sugar", Name: "zzz", Cal100: 1}))
+	})
+
+	r.Run("multi word", func() {
+		lst, err := r.stg.FindFood(context.TODO(), 1, "греч отвар", 0, 0)
+		r.NoError(err)
+		r.Equal([]string{"гречка"}, foodKeys(lst))
+
+		_, err = r.stg.FindFood(context.TODO(), 1, "греч sugar", 0, 0)
+		r.ErrorIs(err, ErrFoodEmptyList)
+	})
+
+	r.Run("limit and offset", func() {
+		lst, err := r.stg.FindFood(context.TODO(), 1, "", 2, 1)
+		r.NoError(err)
+		r.Equal([]string{"sugar", "гречка"}, foodKeys(lst))
+
+		lst, err = r.stg.FindFood(context.TODO(), 1, "", 0, 3)
+		r.NoError(err)
+		r.Equal([]string{"греч_сух"}, foodKeys(lst))
+
+		_, err = r.stg.FindFood(context.TODO(), 1, "", 0, 4)
+		r.ErrorIs(err, ErrFoodEmptyList)
+	})
+
+	r.Run("relevance", func() {
+		if !r.stg.foodFTS {
+			r.T().Skip("sqlite3 is built without FTS5")
+		}
+
+		lst, err := r.stg.FindFood(context.TODO(), 1, "sug", 0, 0)
+		r.NoError(err)
+		r.Equal([]string{"sugar", "other"}, foodKeys(lst))
+
+		lst, err = r.stg.FindFood(context.TODO(), 1, "sug", 1, 1)
+		r.NoError(err)
+		r.Equal([]string{"other"}, foodKeys(lst))
+
+		_, err = r.stg.FindFood(context.TODO(), 1, "sug", 1, 2)
+		r.ErrorIs(err, ErrFoodEmptyList)
+	})
+
+	r.Run("index follows changes", func() {
+		r.NoError(r.stg.RenameFood(context.TODO(), 1, "sugar", "candy"))
+		r.NoError(r.stg.SetFoodComment(context.TODO(), 1, "other", ""))
+		r.NoError(r.stg.DeleteFood(context.TODO(), 1, "греч_сух"))
+
+		lst, err := r.stg.FindFood(context.TODO(), 1, "candy", 0, 0)
+		r.NoError(err)
+		r.Equal([]string{"candy"}, foodKeys(lst))
+
+		_, err = r.stg.FindFood(context.TODO(), 1, "sugar", 0, 0)
+		r.ErrorIs(err, ErrFoodEmptyList)
+
+		_, err = r.stg.FindFood(context.TODO(), 1, "крупа", 0, 0)
+		r.ErrorIs(err, ErrFoodEmptyList)
+	})
+
+	r.Run("index rebuilt after build without fts", func() {
+		if !r.stg.foodFTS {
+			r.T().Skip("sqlite3 is built without FTS5")
+		}
+
+		// Build without FTS5 removes triggers and index is not updated.
+		db, err := sql.Open(_customDriverName, "file:"+r.dbFile)
+		r.Require().NoError(err)
+		defer db.Close()
+		for _, stmt := range _foodFTSDrop {
+			_, err = db.Exec(stmt)
+			r.Require().NoError(err)
+		}
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "candy_red", Name: "bbb", Cal100: 1}))
+
+		lst, err := r.stg.FindFood(context.TODO(), 1, "cand", 0, 0)
+		r.NoError(err)
+		r.Equal([]string{"candy"}, foodKeys(lst))
+
+		r.stg.Close()
+		r.stg, err = NewStorageSQLite(r.dbFile)
+		r.Require().NoError(err)
+
+		lst, err = r.stg.FindFood(context.TODO(), 1, "cand", 0, 0)
+		r.NoError(err)
+		r.ElementsMatch([]string{"candy", "candy_red"}, foodKeys(lst))
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestSuggestFood() {
+	r.Run("empty list", func() {
+		_, err := r.stg.SuggestFood(context.TODO(), 1, "grechka", SuggestLimit)
+		r.ErrorIs(err, ErrFoodEmptyList)
+	})
+
+	r.Run("add food", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "гречка", Name: "Гречка отварная", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "греч_сух", Name: "Крупа гречневая", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "milk", Name: "Молоко 2.5%", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 2, &Food{Key: "grechka2", Name: "Чужая гречка", Cal100: 1, Private: true}))
+	})
+
+	r.Run("suggest by transliterated key", func() {
+		lst, err := r.stg.SuggestFood(context.TODO(), 1, "grechka", SuggestLimit)
+		r.NoError(err)
+		r.Equal([]string{"гречка"}, foodKeys(lst))
+	})
+
+	r.Run("suggest by typo", func() {
+		lst, err := r.stg.SuggestFood(context.TODO(), 1, "гречкв", SuggestLimit)
+		r.NoError(err)
+		r.Equal([]string{"гречка"}, foodKeys(lst))
+
+		lst, err = r.stg.SuggestFood(context.TODO(), 1, "mlik", SuggestLimit)
+		r.NoError(err)
+		r.Equal([]string{"milk"}, foodKeys(lst))
+	})
+
+	r.Run("suggest by name", func() {
+		lst, err := r.stg.SuggestFood(context.TODO(), 1, "moloko", SuggestLimit)
+		r.NoError(err)
+		r.Equal([]string{"milk"}, foodKeys(lst))
+	})
+
+	r.Run("suggest by prefix", func() {
+		lst, err := r.stg.SuggestFood(context.TODO(), 1, "grech", SuggestLimit)
+		r.NoError(err)
+		r.Equal([]string{"греч_сух", "гречка"}, foodKeys(lst))
+
+		lst, err = r.stg.SuggestFood(context.TODO(), 1, "grech", 1)
+		r.NoError(err)
+		r.Equal([]string{"греч_сух"}, foodKeys(lst))
+	})
+
+	r.Run("nothing close", func() {
+		_, err := r.stg.SuggestFood(context.TODO(), 1, "apple", SuggestLimit)
+		r.ErrorIs(err, ErrFoodEmptyList)
+	})
+}
+
+func foodKeys(lst []Food) []string {
+	keys := make([]string, 0, len(lst))
+	for _, f := range lst {
+		keys = append(keys, f.Key)
+	}
+	return keys
+}
+
 func (r *StorageSQLiteTestSuite) TestDeleteFood() {
 	r.Run("delete not exists food", func() {
-		r.NoError(r.stg.DeleteFood(context.TODO(), "key"))
+		r.NoError(r.stg.DeleteFood(context.TODO(), 1, "key"))
 	})
 
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key1", Name: "bbb", Brand: "Brand1", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment1",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key2", Name: "aaa", Brand: "Brand2", Cal100: 4, Prot100: 5, Fat100: 6, Carb100: 7, Comment: "Comment2",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key3", Name: "ccc", Brand: "Brand3", Cal100: 8, Prot100: 9, Fat100: 10, Carb100: 11, Comment: "Comment3",
 		}))
 	})
 
 	r.Run("delete food", func() {
-		f, err := r.stg.GetFood(context.TODO(), "Key1")
+		f, err := r.stg.GetFood(context.TODO(), 1, "Key1")
 		r.NoError(err)
 		r.Equal("Key1", f.Key)
 
-		r.NoError(r.stg.DeleteFood(context.TODO(), "Key1"))
+		r.NoError(r.stg.DeleteFood(context.TODO(), 1, "Key1"))
+
+		_, err = r.stg.GetFood(context.TODO(), 1, "Key1")
+		r.ErrorIs(err, ErrFoodNotFound)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestRenameFood() {
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_b", Name: "bbb", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 2, &Food{Key: "food_a", Name: "private", Cal100: 1, Private: true}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 10, "food_b": 20}}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 2, &Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 30}}))
+	})
+
+	r.Run("rename errors", func() {
+		r.ErrorIs(r.stg.RenameFood(context.TODO(), 1, "food_x", "food_y"), ErrFoodNotFound)
+		r.ErrorIs(r.stg.RenameFood(context.TODO(), 1, "food_a", ""), ErrFoodInvalid)
+		r.ErrorIs(r.stg.RenameFood(context.TODO(), 1, "food_a", "food_b"), ErrFoodExists)
+	})
+
+	r.Run("rename global food", func() {
+		r.NoError(r.stg.RenameFood(context.TODO(), 1, "food_a", "food_c"))
+
+		_, err := r.stg.GetFood(context.TODO(), 1, "food_a")
+		r.ErrorIs(err, ErrFoodNotFound)
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal("food_c", rep[0].FoodKey)
+
+		bndl, err := r.stg.GetBundle(context.TODO(), 1, "bndl1")
+		r.NoError(err)
+		r.Equal(map[string]float64{"food_c": 10, "food_b": 20}, bndl.Data)
+
+		// User 2 bundle references private food and is not changed.
+		bndl, err = r.stg.GetBundle(context.TODO(), 2, "bndl1")
+		r.NoError(err)
+		r.Equal(map[string]float64{"food_a": 30}, bndl.Data)
+	})
+
+	r.Run("rename private food", func() {
+		r.ErrorIs(r.stg.RenameFood(context.TODO(), 2, "food_a", "food_b"), ErrFoodExists)
+		r.NoError(r.stg.RenameFood(context.TODO(), 2, "food_a", "food_p"))
+
+		bndl, err := r.stg.GetBundle(context.TODO(), 2, "bndl1")
+		r.NoError(err)
+		r.Equal(map[string]float64{"food_p": 30}, bndl.Data)
+	})
+}
 
-		_, err = r.stg.GetFood(context.TODO(), "Key1")
+func (r *StorageSQLiteTestSuite) TestMergeFoods() {
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_b", Name: "bbb", Cal100: 3, Prot100: 3, Fat100: 3, Carb100: 3}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_p", Name: "ppp", Cal100: 1, Private: true}))
+
+		for _, j := range []Journal{
+			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100},
+			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 100},
+			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodWeight: 50},
+		} {
+			r.NoError(r.stg.SetJournal(context.TODO(), 1, &j, JournalSetModeReplace))
+		}
+		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 200,
+		}, JournalSetModeReplace))
+
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 10, "food_b": 20}}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 2, &Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 30}}))
+	})
+
+	r.Run("merge errors", func() {
+		r.ErrorIs(r.stg.MergeFoods(context.TODO(), 1, "food_x", "food_b"), ErrFoodNotFound)
+		r.ErrorIs(r.stg.MergeFoods(context.TODO(), 1, "food_a", "food_x"), ErrFoodNotFound)
+		r.ErrorIs(r.stg.MergeFoods(context.TODO(), 1, "food_a", "food_a"), ErrFoodMerge)
+		r.ErrorIs(r.stg.MergeFoods(context.TODO(), 1, "food_a", "food_p"), ErrFoodMerge)
+	})
+
+	r.Run("merge", func() {
+		r.NoError(r.stg.MergeFoods(context.TODO(), 1, "food_a", "food_b"))
+
+		_, err := r.stg.GetFood(context.TODO(), 1, "food_a")
 		r.ErrorIs(err, ErrFoodNotFound)
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb",
+				FoodWeight: 200, Cal: 4, Prot: 4, Fat: 4, Carb: 4},
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb",
+				FoodWeight: 50, Cal: 0.5, Prot: 0.5, Fat: 0.5, Carb: 0.5},
+		}, rep)
+
+		rep, err = r.stg.GetJournalReport(context.TODO(), 2, T(1), T(1))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb",
+				FoodWeight: 200, Cal: 2, Prot: 2, Fat: 2, Carb: 2},
+		}, rep)
+
+		bndl, err := r.stg.GetBundle(context.TODO(), 1, "bndl1")
+		r.NoError(err)
+		r.Equal(map[string]float64{"food_b": 30}, bndl.Data)
+
+		bndl, err = r.stg.GetBundle(context.TODO(), 2, "bndl1")
+		r.NoError(err)
+		r.Equal(map[string]float64{"food_b": 30}, bndl.Data)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestFoodExtNutrients() {
+	r.Run("create invalid food", func() {
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{Key: "Key", Name: "Name", Fiber100: F(-1)}), ErrFoodInvalid)
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{Key: "Key", Name: "Name", Salt100: F(-1)}), ErrFoodInvalid)
+	})
+
+	r.Run("set food", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
+			Key: "food_a", Name: "aaa", Cal100: 100, Fiber100: F(2), Sugar100: F(4), SatFat100: F(6), Salt100: F(8),
+		}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_b", Name: "bbb", Cal100: 100, Fiber100: F(10)}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "food_a")
+		r.NoError(err)
+		r.Equal(&Food{
+			Key: "food_a", Name: "aaa", Cal100: 100, Fiber100: F(2), Sugar100: F(4), SatFat100: F(6), Salt100: F(8),
+		}, f)
+	})
+
+	r.Run("journal report", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 200,
+		}, JournalSetModeReplace))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(0), DayTime: D(0), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodWeight: 50, Cal: 50,
+				Fiber: F(1), Sugar: F(2), SatFat: F(3), Salt: F(4)},
+			{Timestamp: T(0), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodWeight: 200, Cal: 200,
+				Fiber: F(20)},
+		}, rep)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(0), TotalCal: 250,
+				TotalFiber: F(21), TotalSugar: F(2), TotalSatFat: F(3), TotalSalt: F(4),
+				SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+		}, stats)
+	})
+
+	r.Run("unknown nutrients are cleared", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 100, Fiber100: F(2)}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "food_a")
+		r.NoError(err)
+		r.Equal(&Food{Key: "food_a", Name: "aaa", Cal100: 100, Fiber100: F(2)}, f)
+
+		_, err = r.stg.RecalcJournal(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(0), TotalCal: 250, TotalFiber: F(21),
+				SugarUnknown: 2, SatFatUnknown: 2, SaltUnknown: 2},
+		}, stats)
+	})
+
+	r.Run("backup restore", func() {
+		backup, err := r.stg.Backup(context.TODO())
+		r.NoError(err)
+
+		r.NoError(r.stg.Restore(context.TODO(), backup, RestoreModeReplace))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "food_b")
+		r.NoError(err)
+		r.Equal(&Food{Key: "food_b", Name: "bbb", Cal100: 100, Fiber100: F(10)}, f)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(0), TotalCal: 250, TotalFiber: F(21),
+				SugarUnknown: 2, SatFatUnknown: 2, SaltUnknown: 2},
+		}, stats)
+	})
+
+	r.Run("recipe", func() {
+		r.NoError(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "rcp", Name: "rcp", Ingredients: map[string]float64{"food_a": 100, "food_b": 100}, CookedWeight: 100,
+		}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "rcp")
+		r.NoError(err)
+		r.Equal(&Food{Key: "rcp", Name: "rcp", Cal100: 200, Fiber100: F(12), Private: true}, f)
+	})
+
+	r.Run("recipe with partially known nutrient", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 100, Fiber100: F(2), Sugar100: F(4)}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "rcp")
+		r.NoError(err)
+		r.Equal(&Food{Key: "rcp", Name: "rcp", Cal100: 200, Fiber100: F(12), Private: true}, f)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestFoodPortions() {
+	r.Run("set portions", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
+			Key: "egg", Name: "egg", Cal100: 150, Portions: map[string]float64{"шт": 50},
+		}))
+
+		r.ErrorIs(r.stg.SetFoodPortions(context.TODO(), 1, "egg", map[string]float64{"шт": 0}), ErrFoodInvalid)
+		r.ErrorIs(r.stg.SetFoodPortions(context.TODO(), 1, "egg", map[string]float64{"": 1}), ErrFoodInvalid)
+		r.ErrorIs(r.stg.SetFoodPortions(context.TODO(), 1, "food_x", map[string]float64{"шт": 1}), ErrFoodNotFound)
+		r.NoError(r.stg.SetFoodPortions(context.TODO(), 1, "egg", map[string]float64{"шт": 60, "бол": 70}))
+
+		// Food update without portions keeps them.
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "egg", Name: "egg", Cal100: 100}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "egg")
+		r.NoError(err)
+		r.Equal(map[string]float64{"шт": 60, "бол": 70}, f.Portions)
+	})
+
+	r.Run("set journal", func() {
+		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "egg", Portion: "шт",
+		}, JournalSetModeReplace), ErrJournalInvalid)
+		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "egg", Portion: "ст.л", PortionCount: 1,
+		}, JournalSetModeReplace), ErrFoodPortionNotFound)
+
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "egg", Portion: "шт", PortionCount: 2,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "egg", Portion: "шт", PortionCount: 1,
+		}, JournalSetModeAdd))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(1), FoodKey: "egg", Portion: "шт", PortionCount: 1,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(1), FoodKey: "egg", FoodWeight: 40,
+		}, JournalSetModeAdd))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(0), DayTime: D(0), Meal: Meal(0), FoodKey: "egg", FoodName: "egg",
+				FoodWeight: 180, Portion: "шт", PortionCount: 3, Cal: 180},
+			{Timestamp: T(0), DayTime: D(0), Meal: Meal(1), FoodKey: "egg", FoodName: "egg",
+				FoodWeight: 100, Cal: 100},
+		}, rep)
+	})
+
+	r.Run("backup restore", func() {
+		backup, err := r.stg.Backup(context.TODO())
+		r.NoError(err)
+
+		r.NoError(r.stg.Restore(context.TODO(), backup, RestoreModeReplace))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "egg")
+		r.NoError(err)
+		r.Equal(map[string]float64{"шт": 60, "бол": 70}, f.Portions)
+
+		rep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(0), Meal(0))
+		r.NoError(err)
+		r.Equal([]JournalMealItem{
+			{Timestamp: T(0), DayTime: D(0), FoodKey: "egg", FoodName: "egg",
+				FoodWeight: 180, Portion: "шт", PortionCount: 3, Cal: 180},
+		}, rep.Items)
 	})
 }
 
 func (r *StorageSQLiteTestSuite) TestFoodSetComment() {
 	r.Run("set comment for not exists food", func() {
-		r.ErrorIs(r.stg.SetFoodComment(context.TODO(), "key", "comment"), ErrFoodNotFound)
+		r.ErrorIs(r.stg.SetFoodComment(context.TODO(), 1, "key", "comment"), ErrFoodNotFound)
 	})
 
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "Key1", Name: "bbb", Brand: "Brand1", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "",
 		}))
 	})
 
 	r.Run("check and set comment", func() {
-		f, err := r.stg.GetFood(context.TODO(), "Key1")
+		f, err := r.stg.GetFood(context.TODO(), 1, "Key1")
 		r.NoError(err)
 		r.Equal("", f.Comment)
 
-		r.NoError(r.stg.SetFoodComment(context.TODO(), "Key1", "FooBar"))
+		r.NoError(r.stg.SetFoodComment(context.TODO(), 1, "Key1", "FooBar"))
 
-		f, err = r.stg.GetFood(context.TODO(), "Key1")
+		f, err = r.stg.GetFood(context.TODO(), 1, "Key1")
 		r.NoError(err)
 		r.Equal("FooBar", f.Comment)
 	})
 }
 
+func (r *StorageSQLiteTestSuite) TestFoodPrivate() {
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "key", Name: "global", Cal100: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "key", Name: "private", Cal100: 2, Private: true}))
+		r.NoError(r.stg.SetFood(context.TODO(), 2, &Food{Key: "key2", Name: "private2", Cal100: 3, Private: true}))
+	})
+
+	r.Run("private food shadows global", func() {
+		f, err := r.stg.GetFood(context.TODO(), 1, "key")
+		r.NoError(err)
+		r.Equal(&Food{Key: "key", Name: "private", Cal100: 2, Private: true}, f)
+
+		f, err = r.stg.GetFood(context.TODO(), 2, "key")
+		r.NoError(err)
+		r.Equal(&Food{Key: "key", Name: "global", Cal100: 1}, f)
+	})
+
+	r.Run("private food not visible to other users", func() {
+		_, err := r.stg.GetFood(context.TODO(), 1, "key2")
+		r.ErrorIs(err, ErrFoodNotFound)
+
+		lst, err := r.stg.GetFoodList(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal([]Food{{Key: "key", Name: "private", Cal100: 2, Private: true}}, lst)
+
+		lst, err = r.stg.FindFood(context.TODO(), 2, "priv", 0, 0)
+		r.NoError(err)
+		r.Equal([]Food{{Key: "key2", Name: "private2", Cal100: 3, Private: true}}, lst)
+	})
+
+	r.Run("find pages resolved foods", func() {
+		for _, pattern := range []string{"", "key"} {
+			lst, err := r.stg.FindFood(context.TODO(), 1, pattern, 1, 0)
+			r.NoError(err)
+			r.Equal([]Food{{Key: "key", Name: "private", Cal100: 2, Private: true}}, lst)
+
+			_, err = r.stg.FindFood(context.TODO(), 1, pattern, 1, 1)
+			r.ErrorIs(err, ErrFoodEmptyList)
+		}
+
+		lst, err := r.stg.FindFood(context.TODO(), 2, "", 1, 1)
+		r.NoError(err)
+		r.Equal([]Food{{Key: "key2", Name: "private2", Cal100: 3, Private: true}}, lst)
+	})
+
+	r.Run("journal uses resolved food", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{Timestamp: T(1), Meal: 0, FoodKey: "key", FoodWeight: 100}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{Timestamp: T(1), Meal: 0, FoodKey: "key", FoodWeight: 100}, JournalSetModeReplace))
+		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{Timestamp: T(1), Meal: 0, FoodKey: "key2", FoodWeight: 100}, JournalSetModeReplace),
+			ErrJournalInvalidFood)
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal(2.0, rep[0].Cal)
+
+		rep, err = r.stg.GetJournalReport(context.TODO(), 2, T(1), T(1))
+		r.NoError(err)
+		r.Equal(1.0, rep[0].Cal)
+	})
+
+	r.Run("bundle uses resolved food", func() {
+		r.NoError(r.stg.SetBundle(context.TODO(), 2, &Bundle{Key: "b", Data: map[string]float64{"key2": 1}}))
+		r.ErrorIs(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "b", Data: map[string]float64{"key2": 1}}),
+			ErrBundleDepFoodNotFound)
+	})
+
+	r.Run("delete private food", func() {
+		r.NoError(r.stg.DeleteJournalMeal(context.TODO(), 1, T(1), 0))
+		r.NoError(r.stg.DeleteFood(context.TODO(), 1, "key"))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "key")
+		r.NoError(err)
+		r.Equal(&Food{Key: "key", Name: "global", Cal100: 1}, f)
+	})
+}
+
 //
 // Journal
 //
@@ -402,29 +908,32 @@ func (r *StorageSQLiteTestSuite) TestJournalCRUD() {
 	r.Run("set invalid journal", func() {
 		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(-1), FoodKey: "food", FoodWeight: 100,
-		}), ErrJournalInvalid)
+		}, JournalSetModeReplace), ErrJournalInvalid)
 		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(1), FoodKey: "", FoodWeight: 100,
-		}), ErrJournalInvalid)
+		}, JournalSetModeReplace), ErrJournalInvalid)
 		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(1), FoodKey: "food", FoodWeight: 0,
-		}), ErrJournalInvalid)
+		}, JournalSetModeReplace), ErrJournalInvalid)
+		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), DayTime: 24 * time.Hour, Meal: Meal(1), FoodKey: "food", FoodWeight: 100,
+		}, JournalSetModeReplace), ErrJournalInvalid)
 	})
 
 	r.Run("set journal with invalid food", func() {
 		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(0), FoodKey: "food", FoodWeight: 100,
-		}), ErrJournalInvalidFood)
+		}, JournalSetModeReplace), ErrJournalInvalidFood)
 	})
 
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_b", Name: "bbb", Brand: "brand b", Cal100: 5, Prot100: 6, Fat100: 7, Carb100: 8, Comment: "",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_c", Name: "ccc", Brand: "brand c", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: 1, Comment: "ccc",
 		}))
 	})
@@ -433,44 +942,44 @@ func (r *StorageSQLiteTestSuite) TestJournalCRUD() {
 		// user 1, timestamp 1
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 100,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodWeight: 200,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(2), FoodKey: "food_c", FoodWeight: 300,
-		}))
+		}, JournalSetModeReplace))
 
 		// user 1, timestamp 2
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(2), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 300,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(2), Meal: Meal(1), FoodKey: "food_a", FoodWeight: 200,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(2), Meal: Meal(1), FoodKey: "food_c", FoodWeight: 100,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(2), Meal: Meal(2), FoodKey: "food_c", FoodWeight: 400,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(2), Meal: Meal(2), FoodKey: "food_a", FoodWeight: 500,
-		}))
+		}, JournalSetModeReplace))
 
 		// user 2, timestamp 3
 		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{
 			Timestamp: T(3), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 300,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{
 			Timestamp: T(3), Meal: Meal(1), FoodKey: "food_a", FoodWeight: 200,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{
 			Timestamp: T(3), Meal: Meal(1), FoodKey: "food_c", FoodWeight: 100,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{
 			Timestamp: T(3), Meal: Meal(1), FoodKey: "food_b", FoodWeight: 400,
-		}))
+		}, JournalSetModeReplace))
 	})
 
 	r.Run("get empty report", func() {
@@ -488,37 +997,39 @@ func (r *StorageSQLiteTestSuite) TestJournalCRUD() {
 		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(2))
 		r.NoError(err)
 		r.Equal([]JournalReport{
-			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 100, Cal: 5, Prot: 6, Fat: 7, Carb: 8},
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 200, Cal: 2, Prot: 4, Fat: 6, Carb: 8},
-			{Timestamp: T(1), Meal: Meal(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 300, Cal: 3, Prot: 3, Fat: 3, Carb: 3},
-			{Timestamp: T(2), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 300, Cal: 15, Prot: 18, Fat: 21, Carb: 24},
-			{Timestamp: T(2), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 200, Cal: 2, Prot: 4, Fat: 6, Carb: 8},
-			{Timestamp: T(2), Meal: Meal(1), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(1), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 100, Cal: 1, Prot: 1, Fat: 1, Carb: 1},
-			{Timestamp: T(2), Meal: Meal(2), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(2), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 500, Cal: 5, Prot: 10, Fat: 15, Carb: 20},
-			{Timestamp: T(2), Meal: Meal(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 400, Cal: 4, Prot: 4, Fat: 4, Carb: 4},
 		}, rep)
 
 		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(1), T(2))
 		r.NoError(err)
 		r.Equal([]JournalStats{
-			{Timestamp: T(1), TotalCal: 10, TotalProt: 13, TotalFat: 16, TotalCarb: 19},
-			{Timestamp: T(2), TotalCal: 27, TotalProt: 37, TotalFat: 47, TotalCarb: 57},
+			{Timestamp: T(1), TotalCal: 10, TotalProt: 13, TotalFat: 16, TotalCarb: 19,
+				FiberUnknown: 3, SugarUnknown: 3, SatFatUnknown: 3, SaltUnknown: 3},
+			{Timestamp: T(2), TotalCal: 27, TotalProt: 37, TotalFat: 47, TotalCarb: 57,
+				FiberUnknown: 5, SugarUnknown: 5, SatFatUnknown: 5, SaltUnknown: 5},
 		}, stats)
 
 		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(2), Meal(1))
 		r.NoError(err)
 		r.Equal([]JournalMealItem{
-			{Timestamp: T(2), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(2), DayTime: D(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 200, Cal: 2},
-			{Timestamp: T(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(2), DayTime: D(0), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 100, Cal: 1},
 		}, mealRep.Items)
 		r.Equal(float64(3), mealRep.ConsumedMealCal)
@@ -534,33 +1045,33 @@ func (r *StorageSQLiteTestSuite) TestJournalCRUD() {
 		rep, err := r.stg.GetJournalReport(context.TODO(), 2, T(1), T(3))
 		r.NoError(err)
 		r.Equal([]JournalReport{
-			{Timestamp: T(3), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(3), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 300, Cal: 15, Prot: 18, Fat: 21, Carb: 24},
-			{Timestamp: T(3), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(3), DayTime: D(0), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 200, Cal: 2, Prot: 4, Fat: 6, Carb: 8},
-			{Timestamp: T(3), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(3), DayTime: D(0), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 400, Cal: 20, Prot: 24, Fat: 28, Carb: 32},
-			{Timestamp: T(3), Meal: Meal(1), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(3), DayTime: D(0), Meal: Meal(1), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 100, Cal: 1, Prot: 1, Fat: 1, Carb: 1},
 		}, rep)
 	})
 
 	r.Run("update and delete for user 1", func() {
 		r.NoError(r.stg.DeleteJournal(context.TODO(), 1, T(1), Meal(0), "food_b"))
-		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodWeight: 300}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodWeight: 300}, JournalSetModeReplace))
 
 		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
 		r.NoError(err)
 		r.Equal([]JournalReport{
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 300, Cal: 3, Prot: 6, Fat: 9, Carb: 12},
-			{Timestamp: T(1), Meal: Meal(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(2), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 300, Cal: 3, Prot: 3, Fat: 3, Carb: 3},
 		}, rep)
 	})
 
 	r.Run("try delete used food", func() {
-		r.ErrorIs(r.stg.DeleteFood(context.TODO(), "food_a"), ErrFoodIsUsed)
+		r.ErrorIs(r.stg.DeleteFood(context.TODO(), 1, "food_a"), ErrFoodIsUsed)
 	})
 
 	r.Run("delete meal for day", func() {
@@ -575,15 +1086,259 @@ func (r *StorageSQLiteTestSuite) TestJournalCRUD() {
 	})
 }
 
+func (r *StorageSQLiteTestSuite) TestJournalDayTime() {
+	r.Run("add food", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
+			Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4,
+		}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
+			Key: "food_b", Name: "bbb", Brand: "brand b", Cal100: 5, Prot100: 6, Fat100: 7, Carb100: 8,
+		}))
+	})
+
+	r.Run("set same food with different time", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), DayTime: 10 * time.Hour, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), DayTime: 9*time.Hour + 30*time.Minute, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 200,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 100,
+		}, JournalSetModeReplace))
+		// Same time overwrites entry.
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), DayTime: 10 * time.Hour, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 300,
+		}, JournalSetModeReplace))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+				FoodWeight: 100, Cal: 5, Prot: 6, Fat: 7, Carb: 8},
+			{Timestamp: T(1), DayTime: D(9*time.Hour + 30*time.Minute), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 200, Cal: 2, Prot: 4, Fat: 6, Carb: 8},
+			{Timestamp: T(1), DayTime: D(10 * time.Hour), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 300, Cal: 3, Prot: 6, Fat: 9, Carb: 12},
+		}, rep)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(1), TotalCal: 10, TotalProt: 16, TotalFat: 22, TotalCarb: 28,
+				FiberUnknown: 3, SugarUnknown: 3, SatFatUnknown: 3, SaltUnknown: 3},
+		}, stats)
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(1), Meal(0))
+		r.NoError(err)
+		r.Equal(3, len(mealRep.Items))
+		r.Equal(float64(10), mealRep.ConsumedMealCal)
+	})
+
+	r.Run("copy keeps time", func() {
+		cnt, err := r.stg.CopyJournal(context.TODO(), 1, T(1), Meal(0), T(2), Meal(1))
+		r.NoError(err)
+		r.Equal(3, cnt)
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(2), Meal(1))
+		r.NoError(err)
+		r.Equal([]*time.Duration{D(0), D(9*time.Hour + 30*time.Minute), D(10 * time.Hour)}, []*time.Duration{
+			mealRep.Items[0].DayTime, mealRep.Items[1].DayTime, mealRep.Items[2].DayTime,
+		})
+	})
+
+	r.Run("delete entry by time", func() {
+		r.NoError(r.stg.DeleteJournalEntry(context.TODO(), 1, T(1), D(10*time.Hour), Meal(0), "food_a"))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(1), Meal(0))
+		r.NoError(err)
+		r.Equal(2, len(mealRep.Items))
+	})
+
+	r.Run("delete all entries of food", func() {
+		r.NoError(r.stg.DeleteJournal(context.TODO(), 1, T(2), Meal(1), "food_a"))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(2), Meal(1))
+		r.NoError(err)
+		r.Equal(1, len(mealRep.Items))
+		r.Equal("food_b", mealRep.Items[0].FoodKey)
+	})
+
+	r.Run("entry without time", func() {
+		backup := &Backup{
+			Version: BackupVersion,
+			Journal: []JournalBackup{{UserID: 1, Date: "1970-01-01", Meal: 2, FoodKey: "food_a", FoodWeight: 100}},
+		}
+		// Restore twice to check that entry is replaced.
+		r.NoError(r.stg.Restore(context.TODO(), backup, RestoreModeMerge))
+		r.NoError(r.stg.Restore(context.TODO(), backup, RestoreModeMerge))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(2), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeReplace))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(0), Meal(2))
+		r.NoError(err)
+		r.Equal(2, len(mealRep.Items))
+		r.Nil(mealRep.Items[0].DayTime)
+		r.Equal(D(0), mealRep.Items[1].DayTime)
+
+		r.NoError(r.stg.DeleteJournalEntry(context.TODO(), 1, T(0), nil, Meal(2), "food_a"))
+
+		mealRep, err = r.stg.GetJournalMealReport(context.TODO(), 1, T(0), Meal(2))
+		r.NoError(err)
+		r.Equal(1, len(mealRep.Items))
+		r.Equal(D(0), mealRep.Items[0].DayTime)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestJournalAdd() {
+	r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1}))
+
+	r.Run("add to missing entry creates it", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+		}, JournalSetModeAdd))
+	})
+
+	r.Run("add to existing entry", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeAdd))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(1), Meal(0))
+		r.NoError(err)
+		r.Equal(1, len(mealRep.Items))
+		r.Equal(float64(150), mealRep.Items[0].FoodWeight)
+	})
+
+	r.Run("add after food change keeps logged nutrition", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 5, Fiber100: F(2)}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeAdd))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal(1, len(rep))
+		r.Equal(float64(200), rep[0].FoodWeight)
+		r.InDelta(4, rep[0].Cal, 1e-9)
+		r.Nil(rep[0].Fiber)
+	})
+
+	r.Run("replace existing entry", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeReplace))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(1), Meal(0))
+		r.NoError(err)
+		r.Equal(float64(50), mealRep.Items[0].FoodWeight)
+	})
+
+	r.Run("add to entry logged at other time", func() {
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(2), DayTime: 8 * time.Hour, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(2), DayTime: 10 * time.Hour, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 20,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(2), DayTime: 13 * time.Hour, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeAdd))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(2), T(2))
+		r.NoError(err)
+		r.Equal(2, len(rep))
+		r.Equal(D(8*time.Hour), rep[0].DayTime)
+		r.Equal(float64(100), rep[0].FoodWeight)
+		r.Equal(D(10*time.Hour), rep[1].DayTime)
+		r.Equal(float64(70), rep[1].FoodWeight)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestJournalNutritionSnapshot() {
+	r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4}))
+	r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+		Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+	}, JournalSetModeReplace))
+	r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+		Timestamp: T(2), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+	}, JournalSetModeReplace))
+
+	r.Run("food change does not affect reports", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 10, Prot100: 20, Fat100: 30, Carb100: 40}))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(1))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa",
+				FoodWeight: 100, Cal: 1, Prot: 2, Fat: 3, Carb: 4},
+		}, rep)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(1), T(2))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(1), TotalCal: 1, TotalProt: 2, TotalFat: 3, TotalCarb: 4,
+				FiberUnknown: 1, SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+			{Timestamp: T(2), TotalCal: 1, TotalProt: 2, TotalFat: 3, TotalCarb: 4,
+				FiberUnknown: 1, SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+		}, stats)
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(1), Meal(0))
+		r.NoError(err)
+		r.Equal(float64(1), mealRep.ConsumedMealCal)
+		r.Equal(float64(1), mealRep.ConsumedDayCal)
+	})
+
+	r.Run("recalc journal for range", func() {
+		cnt, err := r.stg.RecalcJournal(context.TODO(), 1, T(2), T(2))
+		r.NoError(err)
+		r.Equal(1, cnt)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(1), T(2))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(1), TotalCal: 1, TotalProt: 2, TotalFat: 3, TotalCarb: 4,
+				FiberUnknown: 1, SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+			{Timestamp: T(2), TotalCal: 10, TotalProt: 20, TotalFat: 30, TotalCarb: 40,
+				FiberUnknown: 1, SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+		}, stats)
+	})
+
+	r.Run("recalc for other user", func() {
+		cnt, err := r.stg.RecalcJournal(context.TODO(), 2, T(1), T(2))
+		r.NoError(err)
+		r.Equal(0, cnt)
+	})
+
+	r.Run("fill missing snapshot on open", func() {
+		_, err := r.stg.db.Journal.Update().ClearCal100().ClearProt100().ClearFat100().ClearCarb100().Save(context.TODO())
+		r.NoError(err)
+		r.NoError(r.stg.Close())
+
+		r.stg, err = NewStorageSQLite(r.dbFile)
+		r.NoError(err)
+
+		stats, err := r.stg.GetJournalStats(context.TODO(), 1, T(1), T(2))
+		r.NoError(err)
+		r.Equal([]JournalStats{
+			{Timestamp: T(1), TotalCal: 10, TotalProt: 20, TotalFat: 30, TotalCarb: 40,
+				FiberUnknown: 1, SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+			{Timestamp: T(2), TotalCal: 10, TotalProt: 20, TotalFat: 30, TotalCarb: 40,
+				FiberUnknown: 1, SugarUnknown: 1, SatFatUnknown: 1, SaltUnknown: 1},
+		}, stats)
+	})
+}
+
 func (r *StorageSQLiteTestSuite) TestJournalCopy() {
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_b", Name: "bbb", Brand: "brand b", Cal100: 5, Prot100: 6, Fat100: 7, Carb100: 8, Comment: "",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_c", Name: "ccc", Brand: "brand c", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: 1, Comment: "ccc",
 		}))
 	})
@@ -591,13 +1346,13 @@ func (r *StorageSQLiteTestSuite) TestJournalCopy() {
 	r.Run("set initial journal", func() {
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 100,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 200,
-		}))
+		}, JournalSetModeReplace))
 		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
 			Timestamp: T(2), Meal: Meal(0), FoodKey: "food_c", FoodWeight: 300,
-		}))
+		}, JournalSetModeReplace))
 	})
 
 	r.Run("try copy when dest no empty", func() {
@@ -613,11 +1368,11 @@ func (r *StorageSQLiteTestSuite) TestJournalCopy() {
 		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(2), T(2))
 		r.NoError(err)
 		r.Equal([]JournalReport{
-			{Timestamp: T(2), Meal: Meal(0), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(0), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 300, Cal: 3, Prot: 3, Fat: 3, Carb: 3},
-			{Timestamp: T(2), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 200, Cal: 2, Prot: 4, Fat: 6, Carb: 8},
-			{Timestamp: T(2), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 100, Cal: 5, Prot: 6, Fat: 7, Carb: 8},
 		}, rep)
 	})
@@ -635,13 +1390,13 @@ func (r *StorageSQLiteTestSuite) TestJournalCopy() {
 
 func (r *StorageSQLiteTestSuite) TestBundleCRUD() {
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_b", Name: "bbb", Brand: "brand b", Cal100: 5, Prot100: 6, Fat100: 7, Carb100: 8, Comment: "",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_c", Name: "ccc", Brand: "brand c", Cal100: 1, Prot100: 1, Fat100: 1, Carb100: 1, Comment: "ccc",
 		}))
 	})
@@ -769,53 +1524,175 @@ func (r *StorageSQLiteTestSuite) TestBundleCRUD() {
 		}))
 	})
 
-	r.Run("get bundle", func() {
-		bndl, err := r.stg.GetBundle(context.TODO(), 1, "bndl2")
+	r.Run("get bundle", func() {
+		bndl, err := r.stg.GetBundle(context.TODO(), 1, "bndl2")
+		r.NoError(err)
+		r.Equal(bndl, &Bundle{
+			Key: "bndl2",
+			Data: map[string]float64{
+				"bndl1":  0,
+				"food_a": 20,
+				"food_b": 30,
+			},
+		})
+	})
+
+	r.Run("try delete when bundle is used", func() {
+		r.ErrorIs(r.stg.DeleteBundle(context.TODO(), 1, "bndl1"), ErrBundleIsUsed)
+	})
+
+	r.Run("try delete food used in bundle", func() {
+		r.ErrorIs(r.stg.DeleteFood(context.TODO(), 1, "food_a"), ErrFoodIsUsed)
+	})
+
+	r.Run("delete bundles success", func() {
+		r.NoError(r.stg.DeleteBundle(context.TODO(), 1, "bndl2"))
+		r.NoError(r.stg.DeleteBundle(context.TODO(), 1, "bndl1"))
+	})
+
+	r.Run("empty bundle list", func() {
+		_, err := r.stg.GetBundleList(context.TODO(), 1)
+		r.ErrorIs(err, ErrBundleEmptyList)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestRenameBundle() {
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 10}}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndl2", Data: map[string]float64{"bndl1": 0, "food_a": 20}}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 2, &Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 30}}))
+	})
+
+	r.Run("rename errors", func() {
+		r.ErrorIs(r.stg.RenameBundle(context.TODO(), 1, "bndl_x", "bndl_y"), ErrBundleNotFound)
+		r.ErrorIs(r.stg.RenameBundle(context.TODO(), 1, "bndl1", ""), ErrBundleInvalid)
+		r.ErrorIs(r.stg.RenameBundle(context.TODO(), 1, "bndl1", "bndl2"), ErrBundleExists)
+	})
+
+	r.Run("rename bundle", func() {
+		r.NoError(r.stg.RenameBundle(context.TODO(), 1, "bndl1", "bndl3"))
+
+		_, err := r.stg.GetBundle(context.TODO(), 1, "bndl1")
+		r.ErrorIs(err, ErrBundleNotFound)
+
+		bndl, err := r.stg.GetBundle(context.TODO(), 1, "bndl2")
+		r.NoError(err)
+		r.Equal(map[string]float64{"bndl3": 0, "food_a": 20}, bndl.Data)
+
+		bndl, err = r.stg.GetBundle(context.TODO(), 2, "bndl1")
+		r.NoError(err)
+		r.Equal(map[string]float64{"food_a": 30}, bndl.Data)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestSuggestBundle() {
+	r.Run("empty list", func() {
+		_, err := r.stg.SuggestBundle(context.TODO(), 1, "zavtrak", SuggestLimit)
+		r.ErrorIs(err, ErrBundleEmptyList)
+	})
+
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "завтрак", Data: map[string]float64{"food_a": 10}}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "ужин", Data: map[string]float64{"food_a": 10}}))
+	})
+
+	r.Run("suggest bundle", func() {
+		lst, err := r.stg.SuggestBundle(context.TODO(), 1, "zavtrak", SuggestLimit)
+		r.NoError(err)
+		r.Len(lst, 1)
+		r.Equal("завтрак", lst[0].Key)
+
+		_, err = r.stg.SuggestBundle(context.TODO(), 1, "obed", SuggestLimit)
+		r.ErrorIs(err, ErrBundleEmptyList)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestBundleCycle() {
+	r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Cal100: 1}))
+	r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndlA", Data: map[string]float64{"food_a": 10}}))
+	r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndlB", Data: map[string]float64{"bndlA": 0}}))
+	r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndlC", Data: map[string]float64{"bndlB": 0}}))
+
+	r.Run("direct cycle", func() {
+		r.ErrorIs(r.stg.SetBundle(context.TODO(), 1, &Bundle{
+			Key: "bndlA", Data: map[string]float64{"bndlB": 0},
+		}), ErrBundleDepRecursive)
+	})
+
+	r.Run("transitive cycle", func() {
+		r.ErrorIs(r.stg.SetBundle(context.TODO(), 1, &Bundle{
+			Key: "bndlA", Data: map[string]float64{"food_a": 10, "bndlC": 0},
+		}), ErrBundleDepRecursive)
+	})
+
+	r.Run("diamond is not cycle", func() {
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{
+			Key: "bndlD", Data: map[string]float64{"bndlA": 0, "bndlB": 0, "bndlC": 0},
+		}))
+	})
+
+	r.Run("expansion guard", func() {
+		// Cycle saved bypassing validation.
+		_, err := r.stg.db.Bundle.Update().
+			Where(bundle.Key("bndlA")).
+			SetData(map[string]float64{"bndlC": 0}).
+			Save(context.TODO())
 		r.NoError(err)
-		r.Equal(bndl, &Bundle{
-			Key: "bndl2",
-			Data: map[string]float64{
-				"bndl1":  0,
-				"food_a": 20,
-				"food_b": 30,
-			},
-		})
-	})
 
-	r.Run("try delete when bundle is used", func() {
-		r.ErrorIs(r.stg.DeleteBundle(context.TODO(), 1, "bndl1"), ErrBundleIsUsed)
-	})
+		r.ErrorIs(r.stg.SetJournalBundle(context.TODO(), 1, T(1), 0, Meal(0), "bndlC", 1, JournalSetModeReplace), ErrBundleDepRecursive)
 
-	r.Run("try delete food used in bundle", func() {
-		r.ErrorIs(r.stg.DeleteFood(context.TODO(), "food_a"), ErrFoodIsUsed)
+		_, err = r.stg.GetBundleTree(context.TODO(), 1, "bndlC")
+		r.ErrorIs(err, ErrBundleDepRecursive)
 	})
+}
 
-	r.Run("delete bundles success", func() {
-		r.NoError(r.stg.DeleteBundle(context.TODO(), 1, "bndl2"))
-		r.NoError(r.stg.DeleteBundle(context.TODO(), 1, "bndl1"))
+func (r *StorageSQLiteTestSuite) TestBundleTree() {
+	r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4}))
+	r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_b", Name: "bbb", Cal100: 10, Prot100: 20, Fat100: 30, Carb100: 40}))
+	r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndlA", Data: map[string]float64{"food_a": 100, "food_b": 50}}))
+	r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndlB", Data: map[string]float64{"bndlA": 0, "food_a": 200}}))
+
+	r.Run("unknown bundle", func() {
+		_, err := r.stg.GetBundleTree(context.TODO(), 1, "bndlX")
+		r.ErrorIs(err, ErrBundleNotFound)
 	})
 
-	r.Run("empty bundle list", func() {
-		_, err := r.stg.GetBundleList(context.TODO(), 1)
-		r.ErrorIs(err, ErrBundleEmptyList)
+	r.Run("get tree", func() {
+		tree, err := r.stg.GetBundleTree(context.TODO(), 1, "bndlB")
+		r.NoError(err)
+		r.Equal(&BundleNode{
+			Key: "bndlB", IsBundle: true, Weight: 350, Cal: 8, Prot: 16, Fat: 24, Carb: 32,
+			Children: []BundleNode{
+				{
+					Key: "bndlA", IsBundle: true, Weight: 150, Cal: 6, Prot: 12, Fat: 18, Carb: 24,
+					Children: []BundleNode{
+						{Key: "food_a", Name: "aaa", Brand: "brand a", Weight: 100, Cal: 1, Prot: 2, Fat: 3, Carb: 4},
+						{Key: "food_b", Name: "bbb", Weight: 50, Cal: 5, Prot: 10, Fat: 15, Carb: 20},
+					},
+				},
+				{Key: "food_a", Name: "aaa", Brand: "brand a", Weight: 200, Cal: 2, Prot: 4, Fat: 6, Carb: 8},
+			},
+		}, tree)
 	})
 }
 
 func (r *StorageSQLiteTestSuite) TestSetJournalBundle() {
 	r.Run("add food", func() {
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_b", Name: "bbb", Brand: "brand b", Cal100: 5, Prot100: 6, Fat100: 7, Carb100: 8, Comment: "",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_c", Name: "ccc", Brand: "brand c", Cal100: 9, Prot100: 10, Fat100: 11, Carb100: 12, Comment: "ccc",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_d", Name: "ddd", Brand: "brand d", Cal100: 13, Prot100: 14, Fat100: 15, Carb100: 16, Comment: "ccc",
 		}))
-		r.NoError(r.stg.SetFood(context.TODO(), &Food{
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
 			Key: "food_e", Name: "eee", Brand: "brand e", Cal100: 17, Prot100: 18, Fat100: 19, Carb100: 20, Comment: "ccc",
 		}))
 	})
@@ -881,43 +1758,243 @@ func (r *StorageSQLiteTestSuite) TestSetJournalBundle() {
 	})
 
 	r.Run("set journal bundle", func() {
-		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(1), Meal(0), "bndl5"))
-		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(1), Meal(1), "bndlC"))
+		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(1), 0, Meal(0), "bndl5", 1, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(1), 0, Meal(1), "bndlC", 1, JournalSetModeReplace))
 	})
 
 	r.Run("check journal", func() {
 		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(1), T(2))
 		r.NoError(err)
 		r.Equal([]JournalReport{
-			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 100, Cal: 1, Prot: 2, Fat: 3, Carb: 4},
-			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 200, Cal: 10, Prot: 12, Fat: 14, Carb: 16},
-			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 300, Cal: 27, Prot: 30, Fat: 33, Carb: 36},
-			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_d", FoodName: "ddd", FoodBrand: "brand d",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_d", FoodName: "ddd", FoodBrand: "brand d",
 				FoodWeight: 400, Cal: 52, Prot: 56, Fat: 60, Carb: 64},
-			{Timestamp: T(1), Meal: Meal(0), FoodKey: "food_e", FoodName: "eee", FoodBrand: "brand e",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(0), FoodKey: "food_e", FoodName: "eee", FoodBrand: "brand e",
 				FoodWeight: 500, Cal: 85, Prot: 90, Fat: 95, Carb: 100},
 			//
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
 				FoodWeight: 100, Cal: 1, Prot: 2, Fat: 3, Carb: 4},
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
 				FoodWeight: 200, Cal: 10, Prot: 12, Fat: 14, Carb: 16},
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_c", FoodName: "ccc", FoodBrand: "brand c",
 				FoodWeight: 300, Cal: 27, Prot: 30, Fat: 33, Carb: 36},
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_d", FoodName: "ddd", FoodBrand: "brand d",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_d", FoodName: "ddd", FoodBrand: "brand d",
 				FoodWeight: 400, Cal: 52, Prot: 56, Fat: 60, Carb: 64},
-			{Timestamp: T(1), Meal: Meal(1), FoodKey: "food_e", FoodName: "eee", FoodBrand: "brand e",
+			{Timestamp: T(1), DayTime: D(0), Meal: Meal(1), FoodKey: "food_e", FoodName: "eee", FoodBrand: "brand e",
 				FoodWeight: 500, Cal: 85, Prot: 90, Fat: 95, Carb: 100},
 		}, rep)
 	})
+	r.Run("add journal bundle", func() {
+		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(1), 0, Meal(0), "bndl5", 1, JournalSetModeAdd))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(1), Meal(0))
+		r.NoError(err)
+		weights := make([]float64, 0, len(mealRep.Items))
+		for _, item := range mealRep.Items {
+			weights = append(weights, item.FoodWeight)
+		}
+		r.Equal([]float64{200, 400, 600, 800, 1000}, weights)
+	})
+
+	r.Run("set scaled journal bundle", func() {
+		r.ErrorIs(r.stg.SetJournalBundle(context.TODO(), 1, T(2), 0, Meal(0), "bndlA", 0, JournalSetModeReplace), ErrJournalInvalid)
+		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(2), 0, Meal(0), "bndlA", 0.5, JournalSetModeReplace))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(2), T(2))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 50, Cal: 0.5, Prot: 1, Fat: 1.5, Carb: 2},
+			{Timestamp: T(2), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+				FoodWeight: 100, Cal: 5, Prot: 6, Fat: 7, Carb: 8},
+		}, rep)
+	})
+
+	r.Run("same food through several paths is summed", func() {
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{
+			Key: "bndlD",
+			Data: map[string]float64{
+				"food_a": 50,
+				"bndl1":  0,
+				"bndlA":  0,
+			},
+		}))
+		r.NoError(r.stg.SetJournalBundle(context.TODO(), 1, T(3), 0, Meal(0), "bndlD", 2, JournalSetModeReplace))
+
+		mealRep, err := r.stg.GetJournalMealReport(context.TODO(), 1, T(3), Meal(0))
+		r.NoError(err)
+		r.Equal(2, len(mealRep.Items))
+		r.Equal("food_a", mealRep.Items[0].FoodKey)
+		r.Equal(float64(500), mealRep.Items[0].FoodWeight)
+		r.Equal("food_b", mealRep.Items[1].FoodKey)
+		r.Equal(float64(400), mealRep.Items[1].FoodWeight)
+	})
 }
 
 //
 // Activity
 //
 
+func (r *StorageSQLiteTestSuite) TestRecipeCRUD() {
+	r.Run("check empty list", func() {
+		_, err := r.stg.GetRecipeList(context.TODO(), 1)
+		r.ErrorIs(err, ErrRecipeEmptyList)
+	})
+
+	r.Run("add foods", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "beet", Name: "beet", Cal100: 40, Prot100: 2, Carb100: 10}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "water", Name: "water"}))
+	})
+
+	r.Run("set invalid recipe", func() {
+		for _, rcp := range []Recipe{
+			{Key: "", Name: "borsch", Ingredients: map[string]float64{"beet": 600}, CookedWeight: 1200},
+			{Key: "borsch", Name: "", Ingredients: map[string]float64{"beet": 600}, CookedWeight: 1200},
+			{Key: "borsch", Name: "borsch", CookedWeight: 1200},
+			{Key: "borsch", Name: "borsch", Ingredients: map[string]float64{"beet": 0}, CookedWeight: 1200},
+			{Key: "borsch", Name: "borsch", Ingredients: map[string]float64{"beet": 600}, CookedWeight: 500, Tare: 500},
+		} {
+			r.ErrorIs(r.stg.SetRecipe(context.TODO(), 1, &rcp), ErrRecipeInvalid)
+		}
+	})
+
+	r.Run("set recipe errors", func() {
+		r.ErrorIs(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "borsch", Name: "borsch", Ingredients: map[string]float64{"meat": 100}, CookedWeight: 100,
+		}), ErrRecipeIngredientNotFound)
+		r.ErrorIs(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "water", Name: "water", Ingredients: map[string]float64{"beet": 100}, CookedWeight: 100,
+		}), ErrFoodExists)
+	})
+
+	r.Run("set recipe", func() {
+		r.NoError(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key:          "borsch",
+			Name:         "Borsch",
+			Ingredients:  map[string]float64{"beet": 600, "water": 1000},
+			CookedWeight: 1700,
+			Tare:         500,
+		}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "borsch")
+		r.NoError(err)
+		r.Equal(&Food{Key: "borsch", Name: "Borsch", Cal100: 20, Prot100: 1, Carb100: 5, Private: true}, f)
+
+		_, err = r.stg.GetFood(context.TODO(), 2, "borsch")
+		r.ErrorIs(err, ErrFoodNotFound)
+	})
+
+	r.Run("get recipe", func() {
+		rcp, err := r.stg.GetRecipe(context.TODO(), 1, "borsch")
+		r.NoError(err)
+		r.Equal(&Recipe{
+			Key:          "borsch",
+			Name:         "Borsch",
+			Ingredients:  map[string]float64{"beet": 600, "water": 1000},
+			CookedWeight: 1700,
+			Tare:         500,
+		}, rcp)
+
+		_, err = r.stg.GetRecipe(context.TODO(), 2, "borsch")
+		r.ErrorIs(err, ErrRecipeNotFound)
+
+		lst, err := r.stg.GetRecipeList(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal([]Recipe{*rcp}, lst)
+	})
+
+	r.Run("recipe food can't be changed as food", func() {
+		r.ErrorIs(r.stg.SetFood(context.TODO(), 1, &Food{Key: "borsch", Name: "borsch", Private: true}), ErrFoodIsRecipe)
+		r.ErrorIs(r.stg.DeleteFood(context.TODO(), 1, "borsch"), ErrFoodIsRecipe)
+		r.ErrorIs(r.stg.MergeFoods(context.TODO(), 1, "borsch", "beet"), ErrFoodMerge)
+		r.ErrorIs(r.stg.DeleteFood(context.TODO(), 1, "beet"), ErrFoodIsUsed)
+	})
+
+	r.Run("delete recipe", func() {
+		r.NoError(r.stg.DeleteRecipe(context.TODO(), 1, "borsch"))
+
+		_, err := r.stg.GetRecipe(context.TODO(), 1, "borsch")
+		r.ErrorIs(err, ErrRecipeNotFound)
+
+		// Recipe food is kept.
+		f, err := r.stg.GetFood(context.TODO(), 1, "borsch")
+		r.NoError(err)
+		r.Equal(20.0, f.Cal100)
+
+		r.NoError(r.stg.DeleteFood(context.TODO(), 1, "borsch"))
+		r.NoError(r.stg.DeleteFood(context.TODO(), 1, "beet"))
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestRecipeRecalc() {
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "beet", Name: "beet", Cal100: 40, Prot100: 2, Carb100: 10}))
+		r.NoError(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "borsch", Name: "borsch", Ingredients: map[string]float64{"beet": 600}, CookedWeight: 1200,
+		}))
+		r.NoError(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "soup", Name: "soup", Ingredients: map[string]float64{"borsch": 100}, CookedWeight: 50,
+		}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "soup")
+		r.NoError(err)
+		r.Equal(40.0, f.Cal100)
+	})
+
+	r.Run("recalc on ingredient change", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "beet", Name: "beet", Cal100: 80, Prot100: 2, Carb100: 10}))
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "borsch")
+		r.NoError(err)
+		r.Equal(40.0, f.Cal100)
+
+		f, err = r.stg.GetFood(context.TODO(), 1, "soup")
+		r.NoError(err)
+		r.Equal(80.0, f.Cal100)
+	})
+
+	r.Run("recursive recipe", func() {
+		r.ErrorIs(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "borsch", Name: "borsch", Ingredients: map[string]float64{"soup": 100}, CookedWeight: 100,
+		}), ErrRecipeIngredientRecursive)
+
+		rcp, err := r.stg.GetRecipe(context.TODO(), 1, "borsch")
+		r.NoError(err)
+		r.Equal(map[string]float64{"beet": 600}, rcp.Ingredients)
+	})
+
+	r.Run("rename ingredient", func() {
+		r.NoError(r.stg.RenameFood(context.TODO(), 1, "beet", "beetroot"))
+		r.NoError(r.stg.RenameFood(context.TODO(), 1, "borsch", "borsch2"))
+
+		rcp, err := r.stg.GetRecipe(context.TODO(), 1, "borsch2")
+		r.NoError(err)
+		r.Equal(map[string]float64{"beetroot": 600}, rcp.Ingredients)
+
+		rcp, err = r.stg.GetRecipe(context.TODO(), 1, "soup")
+		r.NoError(err)
+		r.Equal(map[string]float64{"borsch2": 100}, rcp.Ingredients)
+	})
+
+	r.Run("merge ingredient", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "beet2", Name: "beet2", Cal100: 20}))
+		r.NoError(r.stg.MergeFoods(context.TODO(), 1, "beetroot", "beet2"))
+
+		rcp, err := r.stg.GetRecipe(context.TODO(), 1, "borsch2")
+		r.NoError(err)
+		r.Equal(map[string]float64{"beet2": 600}, rcp.Ingredients)
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "soup")
+		r.NoError(err)
+		r.Equal(20.0, f.Cal100)
+	})
+}
+
 func (r *StorageSQLiteTestSuite) TestGetActivityList() {
 	r.Run("empty list", func() {
 		_, err := r.stg.GetActivityList(context.TODO(), 1, T(0), T(10))
@@ -1030,6 +2107,313 @@ func (r *StorageSQLiteTestSuite) TestActivityCRU() {
 	})
 }
 
+//
+// UserMeal
+//
+
+func (r *StorageSQLiteTestSuite) TestUserMealCRUD() {
+	r.Run("default meals", func() {
+		meals, err := r.stg.GetUserMeals(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal(DefaultUserMeals(), meals)
+
+		m, ok := meals.Resolve("обед")
+		r.True(ok)
+		r.Equal(Meal(2), m)
+
+		_, ok = meals.Resolve("обедд")
+		r.False(ok)
+	})
+
+	r.Run("set invalid meal", func() {
+		r.ErrorIs(r.stg.SetUserMeal(context.TODO(), 1, &UserMeal{Meal: -1, Name: "a"}), ErrUserMealInvalid)
+		r.ErrorIs(r.stg.SetUserMeal(context.TODO(), 1, &UserMeal{Meal: 1, Name: ""}), ErrUserMealInvalid)
+	})
+
+	r.Run("set meal keeps defaults", func() {
+		r.NoError(r.stg.SetUserMeal(context.TODO(), 1, &UserMeal{Meal: 2, Name: "Ланч", Aliases: []string{"л"}}))
+
+		meals, err := r.stg.GetUserMeals(context.TODO(), 1)
+		r.NoError(err)
+		r.Len(meals, 7)
+		r.Equal("Ланч", meals.Name(2))
+		r.Equal("Завтрак", meals.Name(0))
+
+		m, ok := meals.Resolve("Л")
+		r.True(ok)
+		r.Equal(Meal(2), m)
+
+		meals, err = r.stg.GetUserMeals(context.TODO(), 2)
+		r.NoError(err)
+		r.Equal(DefaultUserMeals(), meals)
+	})
+
+	r.Run("set meal with conflict", func() {
+		r.ErrorIs(r.stg.SetUserMeal(context.TODO(), 1, &UserMeal{Meal: 7, Name: "Ночь", Aliases: []string{"Л"}}), ErrUserMealConflict)
+		r.NoError(r.stg.SetUserMeal(context.TODO(), 1, &UserMeal{Meal: 7, Name: "Ночь"}))
+	})
+
+	r.Run("journal with unknown meal", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food", Name: "food"}))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{Key: "bndl", Data: map[string]float64{"food": 1}}))
+
+		r.ErrorIs(r.stg.SetJournal(context.TODO(), 1, &Journal{Timestamp: T(1), Meal: 8, FoodKey: "food", FoodWeight: 1}, JournalSetModeReplace), ErrUserMealNotFound)
+		r.ErrorIs(r.stg.SetJournal(context.TODO(), 2, &Journal{Timestamp: T(1), Meal: 7, FoodKey: "food", FoodWeight: 1}, JournalSetModeReplace), ErrUserMealNotFound)
+		r.ErrorIs(r.stg.SetJournalBundle(context.TODO(), 1, T(1), 0, Meal(8), "bndl", 1, JournalSetModeReplace), ErrUserMealNotFound)
+		_, err := r.stg.CopyJournal(context.TODO(), 1, T(1), Meal(0), T(2), Meal(8))
+		r.ErrorIs(err, ErrUserMealNotFound)
+	})
+
+	r.Run("delete meal", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food", Name: "food"}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{Timestamp: T(1), Meal: 7, FoodKey: "food", FoodWeight: 1}, JournalSetModeReplace))
+
+		r.ErrorIs(r.stg.DeleteUserMeal(context.TODO(), 1, 7), ErrUserMealIsUsed)
+		r.ErrorIs(r.stg.DeleteUserMeal(context.TODO(), 1, 8), ErrUserMealNotFound)
+		r.NoError(r.stg.DeleteUserMeal(context.TODO(), 1, 6))
+
+		meals, err := r.stg.GetUserMeals(context.TODO(), 1)
+		r.NoError(err)
+		r.Len(meals, 7)
+		_, ok := meals.Resolve("Перекус")
+		r.False(ok)
+	})
+
+	r.Run("delete last meal", func() {
+		for i := 0; i < 6; i++ {
+			r.NoError(r.stg.DeleteUserMeal(context.TODO(), 2, Meal(i)))
+		}
+		r.ErrorIs(r.stg.DeleteUserMeal(context.TODO(), 2, 6), ErrUserMealIsLast)
+	})
+}
+
+//
+// Backup
+//
+
+func (r *StorageSQLiteTestSuite) TestBackupRestore() {
+	var backup *Backup
+
+	r.Run("add data", func() {
+		r.NoError(r.stg.SetWeight(context.TODO(), 1, &Weight{Timestamp: T(0), Value: 1}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
+			Key: "food_a", Name: "aaa", Brand: "brand a", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Comment: "Comment",
+		}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 100,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), DayTime: 8 * time.Hour, Meal: Meal(0), FoodKey: "food_a", FoodWeight: 50,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetBundle(context.TODO(), 1, &Bundle{
+			Key: "bndl1", Data: map[string]float64{"food_a": 10},
+		}))
+		r.NoError(r.stg.SetUserSettings(context.TODO(), 1, &UserSettings{CalLimit: 100, DefaultActiveCal: 200}))
+		r.NoError(r.stg.SetActivity(context.TODO(), 1, &Activity{Timestamp: T(0), ActiveCal: 300}))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_p", Name: "private", Cal100: 1, Private: true}))
+		r.NoError(r.stg.SetUserMeal(context.TODO(), 1, &UserMeal{Meal: 1, Name: "Ланч", Aliases: []string{"л"}}))
+		r.NoError(r.stg.SetRecipe(context.TODO(), 1, &Recipe{
+			Key: "rcp", Name: "recipe", Ingredients: map[string]float64{"food_a": 100}, CookedWeight: 150, Tare: 50,
+		}))
+	})
+
+	r.Run("backup", func() {
+		var err error
+		backup, err = r.stg.Backup(context.TODO())
+		r.NoError(err)
+	})
+
+	r.Run("change data", func() {
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{
+			Key: "food_b", Name: "bbb", Brand: "brand b", Cal100: 5, Prot100: 6, Fat100: 7, Carb100: 8, Comment: "",
+		}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "food_a", FoodWeight: 200,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetJournal(context.TODO(), 1, &Journal{
+			Timestamp: T(86400), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 300,
+		}, JournalSetModeReplace))
+		r.NoError(r.stg.SetFood(context.TODO(), 1, &Food{Key: "food_c", Name: "ccc", Cal100: 1, Private: true}))
+		r.NoError(r.stg.SetWeight(context.TODO(), 2, &Weight{Timestamp: T(0), Value: 2}))
+		r.NoError(r.stg.SetJournal(context.TODO(), 2, &Journal{
+			Timestamp: T(0), Meal: Meal(0), FoodKey: "food_b", FoodWeight: 100,
+		}, JournalSetModeReplace))
+	})
+
+	r.Run("restore merge", func() {
+		r.NoError(r.stg.Restore(context.TODO(), backup, RestoreModeMerge))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(0), T(86400))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(0), DayTime: D(0), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 100, Cal: 1, Prot: 2, Fat: 3, Carb: 4},
+			{Timestamp: T(0), DayTime: D(8 * time.Hour), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 50, Cal: 0.5, Prot: 1, Fat: 1.5, Carb: 2},
+			{Timestamp: T(86400), DayTime: D(0), Meal: Meal(0), FoodKey: "food_b", FoodName: "bbb", FoodBrand: "brand b",
+				FoodWeight: 300, Cal: 15, Prot: 18, Fat: 21, Carb: 24},
+		}, rep)
+
+		stgs, err := r.stg.GetUserSettings(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal(&UserSettings{CalLimit: 100, DefaultActiveCal: 200}, stgs)
+	})
+
+	r.Run("restore replace", func() {
+		r.NoError(r.stg.Restore(context.TODO(), backup, RestoreModeReplace))
+
+		rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(0), T(86400))
+		r.NoError(err)
+		r.Equal([]JournalReport{
+			{Timestamp: T(0), DayTime: D(0), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 100, Cal: 1, Prot: 2, Fat: 3, Carb: 4},
+			{Timestamp: T(0), DayTime: D(8 * time.Hour), Meal: Meal(0), FoodKey: "food_a", FoodName: "aaa", FoodBrand: "brand a",
+				FoodWeight: 50, Cal: 0.5, Prot: 1, Fat: 1.5, Carb: 2},
+		}, rep)
+
+		_, err = r.stg.GetFood(context.TODO(), 1, "food_c")
+		r.ErrorIs(err, ErrFoodNotFound)
+
+		wl, err := r.stg.GetWeightList(context.TODO(), 1, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]Weight{{Timestamp: T(0), Value: 1}}, wl)
+
+		// Global food and data of users not in backup are kept.
+		_, err = r.stg.GetFood(context.TODO(), 1, "food_b")
+		r.NoError(err)
+
+		wl, err = r.stg.GetWeightList(context.TODO(), 2, T(0), T(0))
+		r.NoError(err)
+		r.Equal([]Weight{{Timestamp: T(0), Value: 2}}, wl)
+
+		rep, err = r.stg.GetJournalReport(context.TODO(), 2, T(0), T(0))
+		r.NoError(err)
+		r.Len(rep, 1)
+
+		bndl, err := r.stg.GetBundle(context.TODO(), 1, "bndl1")
+		r.NoError(err)
+		r.Equal(&Bundle{Key: "bndl1", Data: map[string]float64{"food_a": 10}}, bndl)
+
+		act, err := r.stg.GetActivity(context.TODO(), 1, T(0))
+		r.NoError(err)
+		r.Equal(&Activity{Timestamp: T(0), ActiveCal: 300}, act)
+
+		f, err := r.stg.GetFood(context.TODO(), 1, "food_p")
+		r.NoError(err)
+		r.Equal(&Food{Key: "food_p", Name: "private", Cal100: 1, Private: true}, f)
+
+		meals, err := r.stg.GetUserMeals(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal("Ланч", meals.Name(1))
+		r.Equal([]string{"л"}, meals[1].Aliases)
+
+		rcp, err := r.stg.GetRecipe(context.TODO(), 1, "rcp")
+		r.NoError(err)
+		r.Equal(&Recipe{
+			Key: "rcp", Name: "recipe", Ingredients: map[string]float64{"food_a": 100}, CookedWeight: 150, Tare: 50,
+		}, rcp)
+
+		f, err = r.stg.GetFood(context.TODO(), 1, "rcp")
+		r.NoError(err)
+		r.Equal(&Food{Key: "rcp", Name: "recipe", Cal100: 1, Prot100: 2, Fat100: 3, Carb100: 4, Private: true}, f)
+	})
+
+	r.Run("restore with unknown food", func() {
+		r.ErrorIs(r.stg.Restore(context.TODO(), &Backup{
+			Version: BackupVersion,
+			Journal: []JournalBackup{{UserID: 1, Date: "1970-01-01", Meal: 0, FoodKey: "food_x", FoodWeight: 1}},
+		}, RestoreModeMerge), ErrJournalInvalidFood)
+	})
+
+	r.Run("restore with unknown meal", func() {
+		r.ErrorIs(r.stg.Restore(context.TODO(), &Backup{
+			Version: BackupVersion,
+			Journal: []JournalBackup{{UserID: 1, Date: "1970-01-01", Meal: 100, FoodKey: "food_a", FoodWeight: 1}},
+		}, RestoreModeMerge), ErrUserMealNotFound)
+	})
+
+	r.Run("restore with meal of backup", func() {
+		r.NoError(r.stg.Restore(context.TODO(), &Backup{
+			Version:   BackupVersion,
+			Journal:   []JournalBackup{{UserID: 1, Date: "1970-01-01", Meal: 100, FoodKey: "food_a", FoodWeight: 1}},
+			UserMeals: []UserMealBackup{{UserID: 1, Meal: 100, Name: "meal 100"}},
+		}, RestoreModeMerge))
+	})
+
+	r.Run("restore with invalid version", func() {
+		r.ErrorIs(r.stg.Restore(context.TODO(), &Backup{Version: BackupVersion + 1}, RestoreModeMerge), ErrBackupInvalid)
+	})
+
+	r.Run("restore with invalid time", func() {
+		r.ErrorIs(r.stg.Restore(context.TODO(), &Backup{
+			Version: BackupVersion,
+			Journal: []JournalBackup{{UserID: 1, Date: "1970-01-01", DayTime: I(86400), Meal: 0, FoodKey: "food_a", FoodWeight: 1}},
+		}, RestoreModeMerge), ErrBackupInvalid)
+	})
+
+	r.Run("restore with invalid date", func() {
+		r.ErrorIs(r.stg.Restore(context.TODO(), &Backup{
+			Version: BackupVersion,
+			Weight:  []WeightBackup{{UserID: 1, Date: "01.01.1970", Value: 1}},
+		}, RestoreModeMerge), ErrBackupInvalid)
+	})
+}
+
+func (r *StorageSQLiteTestSuite) TestRestoreLegacyBackup() {
+	r.NoError(r.stg.SetUserSettings(context.TODO(), 1, &UserSettings{CalLimit: 100, DefaultActiveCal: 200}))
+	r.NoError(r.stg.SetActivity(context.TODO(), 1, &Activity{Timestamp: T(0), ActiveCal: 300}))
+
+	// Version 1 timestamps are shifted by +3h timezone.
+	r.NoError(r.stg.Restore(context.TODO(), &Backup{
+		Weight:       []WeightBackup{{UserID: 1, Timestamp: -10800000, Value: 70}},
+		Food:         []FoodBackup{{Key: "food_a", Name: "aaa", Cal100: 1}},
+		Journal:      []JournalBackup{{UserID: 1, Timestamp: -10800000, Meal: 0, FoodKey: "food_a", FoodWeight: 100}},
+		UserSettings: []UserSettingsBackup{{UserID: 1, CalLimit: 500}},
+	}, RestoreModeReplace))
+
+	wl, err := r.stg.GetWeightList(context.TODO(), 1, T(0), T(0))
+	r.NoError(err)
+	r.Equal([]Weight{{Timestamp: T(0), Value: 70}}, wl)
+
+	rep, err := r.stg.GetJournalReport(context.TODO(), 1, T(0), T(0))
+	r.NoError(err)
+	r.Len(rep, 1)
+
+	stgs, err := r.stg.GetUserSettings(context.TODO(), 1)
+	r.NoError(err)
+	r.Equal(&UserSettings{CalLimit: 500}, stgs)
+
+	act, err := r.stg.GetActivity(context.TODO(), 1, T(0))
+	r.NoError(err)
+	r.Equal(&Activity{Timestamp: T(0), ActiveCal: 300}, act)
+}
+
+func (r *StorageSQLiteTestSuite) TestRestoreUserSettings() {
+	r.NoError(r.stg.SetUserSettings(context.TODO(), 1, &UserSettings{CalLimit: 100, DefaultActiveCal: 200}))
+
+	r.Run("version 1 keeps default active cal", func() {
+		r.NoError(r.stg.Restore(context.TODO(), &Backup{
+			Version:      1,
+			UserSettings: []UserSettingsBackup{{UserID: 1, CalLimit: 500}},
+		}, RestoreModeMerge))
+
+		stgs, err := r.stg.GetUserSettings(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal(&UserSettings{CalLimit: 500, DefaultActiveCal: 200}, stgs)
+	})
+
+	r.Run("zero default active cal is restored", func() {
+		r.NoError(r.stg.Restore(context.TODO(), &Backup{
+			Version:      BackupVersion,
+			UserSettings: []UserSettingsBackup{{UserID: 1, CalLimit: 600}},
+		}, RestoreModeMerge))
+
+		stgs, err := r.stg.GetUserSettings(context.TODO(), 1)
+		r.NoError(err)
+		r.Equal(&UserSettings{CalLimit: 600}, stgs)
+	})
+}
+
 //
 // Suite setup
 //
@@ -1038,6 +2422,62 @@ func T(sec int) time.Time {
 	return time.Date(1970, 1, 1, 0, 0, sec, 0, time.UTC)
 }
 
+func F(v float64) *float64 {
+	return &v
+}
+
+func D(v time.Duration) *time.Duration {
+	return &v
+}
+
+func I(v int64) *int64 {
+	return &v
+}
+
+//
+// Batch
+//
+
+func (r *StorageSQLiteTestSuite) TestBatch() {
+	r.Run("commit batch", func() {
+		r.NoError(r.stg.Batch(context.TODO(), func(stg Storage) error {
+			if err := stg.SetWeight(context.TODO(), 1, &Weight{Timestamp: time.Unix(1, 0), Value: 80}); err != nil {
+				return err
+			}
+			return stg.SetWeight(context.TODO(), 1, &Weight{Timestamp: time.Unix(2, 0), Value: 81})
+		}))
+
+		lst, err := r.stg.GetWeightList(context.TODO(), 1, time.Unix(1, 0), time.Unix(2, 0))
+		r.NoError(err)
+		r.Len(lst, 2)
+	})
+
+	r.Run("rollback batch", func() {
+		err := r.stg.Batch(context.TODO(), func(stg Storage) error {
+			if err := stg.SetWeight(context.TODO(), 1, &Weight{Timestamp: time.Unix(3, 0), Value: 82}); err != nil {
+				return err
+			}
+			return stg.SetWeight(context.TODO(), 1, &Weight{Timestamp: time.Unix(4, 0), Value: -1})
+		})
+		r.ErrorIs(err, ErrWeightInvalid)
+
+		_, err = r.stg.GetWeightList(context.TODO(), 1, time.Unix(3, 0), time.Unix(4, 0))
+		r.ErrorIs(err, ErrWeightEmptyList)
+	})
+
+	r.Run("nested batch", func() {
+		r.NoError(r.stg.Batch(context.TODO(), func(stg Storage) error {
+			return stg.Batch(context.TODO(), func(stg Storage) error {
+				return stg.SetWeight(context.TODO(), 1, &Weight{Timestamp: time.Unix(5, 0), Value: 83})
+			})
+		}))
+
+		w, err := r.stg.GetWeightList(context.TODO(), 1, time.Unix(5, 0), time.Unix(5, 0))
+		r.NoError(err)
+		r.Equal([]Weight{{Timestamp: time.Unix(5, 0).UTC(), Value: 83}}, w)
+	})
+}
+
 func (r *StorageSQLiteTestSuite) SetupTest() {
 	var err error
 
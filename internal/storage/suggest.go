@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SuggestLimit is default count of suggested keys.
+const SuggestLimit = 5
+
+var translitTable = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "h", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// suggestNormalize returns lower case transliterated string with letters
+// and digits only, so "Гречка_отв" and "grechka otv" are equal.
+func suggestNormalize(s string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(s) {
+		if t, ok := translitTable[c]; ok {
+			sb.WriteString(t)
+			continue
+		}
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// editDistance returns edit distance between strings, transposition of
+// adjacent letters is single edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+// suggestScore returns distance between pattern and closest of key, name and
+// name words, -1 if they are too far. Prefix match has zero distance.
+func suggestScore(pattern, key, name string) int {
+	p := suggestNormalize(pattern)
+	if p == "" {
+		return -1
+	}
+
+	candidates := append([]string{key, name}, strings.Fields(name)...)
+
+	best := -1
+	for _, c := range candidates {
+		nc := suggestNormalize(c)
+		if nc == "" {
+			continue
+		}
+
+		d := editDistance(p, nc)
+		if utf8.RuneCountInString(p) >= 3 && strings.HasPrefix(nc, p) {
+			d = 0
+		}
+		if best == -1 || d < best {
+			best = d
+		}
+	}
+
+	if best > max(1, utf8.RuneCountInString(p)/3) {
+		return -1
+	}
+
+	return best
+}
+
+type suggestItem struct {
+	idx   int
+	key   string
+	score int
+}
+
+// suggestRank returns indexes of closest items ordered by score and key.
+func suggestRank(pattern string, limit int, n int, keyName func(i int) (string, string)) []int {
+	items := make([]suggestItem, 0)
+	for i := 0; i < n; i++ {
+		key, name := keyName(i)
+		if score := suggestScore(pattern, key, name); score >= 0 {
+			items = append(items, suggestItem{idx: i, key: key, score: score})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].score != items[j].score {
+			return items[i].score < items[j].score
+		}
+		return items[i].key < items[j].key
+	})
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	res := make([]int, 0, len(items))
+	for _, it := range items {
+		res = append(res, it.idx)
+	}
+	return res
+}